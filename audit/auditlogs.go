@@ -2,6 +2,7 @@ package audit
 
 import (
 	"context"
+	"iter"
 	"strconv"
 	"strings"
 
@@ -72,6 +73,34 @@ func (c *Client) ListAuditLogs(ctx context.Context, listRequest *ListAuditLogsRe
 	return auditLogs, nil
 }
 
+// AllAuditLogs returns an iterator over every audit log matching listRequest, fetching
+// pages on demand. listRequest.Limit is used as the page size and listRequest.Page as
+// the first page, unless overridden by opts.
+func (c *Client) AllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) iter.Seq2[AuditLog, error] {
+	base := ListAuditLogsRequest{}
+	if listRequest != nil {
+		base = *listRequest
+	}
+	pagerOpts := []client.PagerOption{client.WithPageSize(base.Limit), client.WithStartPage(base.Page)}
+	pagerOpts = append(pagerOpts, opts...)
+
+	return client.Paginate(ctx, func(ctx context.Context, page client.PageRequest) (*client.Page[AuditLog], error) {
+		pageRequest := base
+		pageRequest.Page = page.Page
+		pageRequest.Limit = page.PageSize
+		result, err := c.ListAuditLogs(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+		return &client.Page[AuditLog]{Items: result.Items, TotalPages: result.TotalPages}, nil
+	}, pagerOpts...)
+}
+
+// ListAllAuditLogs fetches every page of audit logs matching listRequest.
+func (c *Client) ListAllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) ([]AuditLog, error) {
+	return client.Collect(c.AllAuditLogs(ctx, listRequest, opts...))
+}
+
 type ListAuditLogsRequest struct {
 	Page   int `json:"page,omitempty"`
 	Limit  int `json:"limit,omitempty"`
@@ -0,0 +1,67 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package audit
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllAuditLogsFunc, if set, handles calls to AllAuditLogs.
+	AllAuditLogsFunc func(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) iter.Seq2[AuditLog, error]
+	// ListAllAuditLogsFunc, if set, handles calls to ListAllAuditLogs.
+	ListAllAuditLogsFunc func(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) ([]AuditLog, error)
+	// ListAuditLogsFunc, if set, handles calls to ListAuditLogs.
+	ListAuditLogsFunc func(ctx context.Context, listRequest *ListAuditLogsRequest) (*PagedResult[AuditLog], error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllAuditLogs records the call and invokes AllAuditLogsFunc if set.
+func (f *Fake) AllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) (r0 iter.Seq2[AuditLog, error]) {
+	f.Record("AllAuditLogs", ctx, listRequest, opts)
+	if f.AllAuditLogsFunc != nil {
+		return f.AllAuditLogsFunc(ctx, listRequest, opts...)
+	}
+	r0 = func(yield func(AuditLog, error) bool) {
+		if f.Err != nil {
+			var zero AuditLog
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// ListAllAuditLogs records the call and invokes ListAllAuditLogsFunc if set.
+func (f *Fake) ListAllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) (r0 []AuditLog, r1 error) {
+	f.Record("ListAllAuditLogs", ctx, listRequest, opts)
+	if f.ListAllAuditLogsFunc != nil {
+		return f.ListAllAuditLogsFunc(ctx, listRequest, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListAuditLogs records the call and invokes ListAuditLogsFunc if set.
+func (f *Fake) ListAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest) (r0 *PagedResult[AuditLog], r1 error) {
+	f.Record("ListAuditLogs", ctx, listRequest)
+	if f.ListAuditLogsFunc != nil {
+		return f.ListAuditLogsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,28 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package audit
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllAuditLogs returns an iterator over every audit log matching listRequest, fetching
+	// pages on demand. listRequest.Limit is used as the page size and listRequest.Page as
+	// the first page, unless overridden by opts.
+	AllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) iter.Seq2[AuditLog, error]
+
+	// ListAllAuditLogs fetches every page of audit logs matching listRequest.
+	ListAllAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest, opts ...client.PagerOption) ([]AuditLog, error)
+
+	// ListAuditLogs lists all audit logs for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListAuditLogs(ctx context.Context, listRequest *ListAuditLogsRequest) (*PagedResult[AuditLog], error)
+}
+
+var _ Interface = (*Client)(nil)
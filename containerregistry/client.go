@@ -4,12 +4,16 @@ import (
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
 
+//go:generate go run ../internal/cmd/genservice
+
 type Client struct {
 	client.Client
 }
 
 func New(c client.Client, opts ...client.Option) (*Client, error) {
-	c.WithOptions(opts...)
+	if err := client.ApplyOptions(c, opts...); err != nil {
+		return nil, err
+	}
 	return &Client{c}, nil
 }
 
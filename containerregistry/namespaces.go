@@ -3,6 +3,7 @@ package containerregistry
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -31,6 +32,13 @@ func (c *Client) ListContainerRegistryNamespaces(ctx context.Context, listReques
 	return namespaces, nil
 }
 
+// AllContainerRegistryNamespaces returns an iterator over the results of ListContainerRegistryNamespaces.
+func (c *Client) AllContainerRegistryNamespaces(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) iter.Seq2[ContainerRegistryNamespace, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]ContainerRegistryNamespace, error) {
+		return c.ListContainerRegistryNamespaces(ctx, listRequest)
+	})
+}
+
 // GetContainerRegistryNamespace retrieves a specific container registry namespace by its identity.
 func (c *Client) GetContainerRegistryNamespace(ctx context.Context, namespaceIdentity string) (*ContainerRegistryNamespace, error) {
 	if namespaceIdentity == "" {
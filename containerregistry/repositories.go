@@ -3,6 +3,7 @@ package containerregistry
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -35,6 +36,13 @@ func (c *Client) ListContainerRegistryRepositories(ctx context.Context, namespac
 	return repositories, nil
 }
 
+// AllContainerRegistryRepositories returns an iterator over the results of ListContainerRegistryRepositories.
+func (c *Client) AllContainerRegistryRepositories(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) iter.Seq2[ContainerRegistryRepository, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]ContainerRegistryRepository, error) {
+		return c.ListContainerRegistryRepositories(ctx, namespaceIdentity, listRequest)
+	})
+}
+
 // GetContainerRegistryRepository retrieves a specific container registry repository by its identity.
 func (c *Client) GetContainerRegistryRepository(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (*ContainerRegistryRepository, error) {
 	if namespaceIdentity == "" {
@@ -0,0 +1,227 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package containerregistry
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllContainerRegistryNamespacesFunc, if set, handles calls to AllContainerRegistryNamespaces.
+	AllContainerRegistryNamespacesFunc func(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) iter.Seq2[ContainerRegistryNamespace, error]
+	// AllContainerRegistryRepositoriesFunc, if set, handles calls to AllContainerRegistryRepositories.
+	AllContainerRegistryRepositoriesFunc func(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) iter.Seq2[ContainerRegistryRepository, error]
+	// CreateContainerRegistryNamespaceFunc, if set, handles calls to CreateContainerRegistryNamespace.
+	CreateContainerRegistryNamespaceFunc func(ctx context.Context, create CreateContainerRegistryNamespaceRequest) (*ContainerRegistryNamespace, error)
+	// CreateNamespaceConfigurationFunc, if set, handles calls to CreateNamespaceConfiguration.
+	CreateNamespaceConfigurationFunc func(ctx context.Context, namespaceIdentity string, create CreateNamespaceConfigurationRequest) (*ContainerRegistryNamespaceConfiguration, error)
+	// DeleteContainerRegistryNamespaceFunc, if set, handles calls to DeleteContainerRegistryNamespace.
+	DeleteContainerRegistryNamespaceFunc func(ctx context.Context, namespaceIdentity string) error
+	// DeleteContainerRegistryRepositoryArtifactFunc, if set, handles calls to DeleteContainerRegistryRepositoryArtifact.
+	DeleteContainerRegistryRepositoryArtifactFunc func(ctx context.Context, namespaceIdentity string, repositoryIdentity string) error
+	// DeleteContainerRegistryRepositoryWithAllArtifactsFunc, if set, handles calls to DeleteContainerRegistryRepositoryWithAllArtifacts.
+	DeleteContainerRegistryRepositoryWithAllArtifactsFunc func(ctx context.Context, namespaceIdentity string, repositoryIdentity string) error
+	// DeleteNamespaceConfigurationFunc, if set, handles calls to DeleteNamespaceConfiguration.
+	DeleteNamespaceConfigurationFunc func(ctx context.Context, namespaceIdentity string) error
+	// GetContainerRegistryNamespaceFunc, if set, handles calls to GetContainerRegistryNamespace.
+	GetContainerRegistryNamespaceFunc func(ctx context.Context, namespaceIdentity string) (*ContainerRegistryNamespace, error)
+	// GetContainerRegistryRepositoryFunc, if set, handles calls to GetContainerRegistryRepository.
+	GetContainerRegistryRepositoryFunc func(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (*ContainerRegistryRepository, error)
+	// GetNamespaceConfigurationFunc, if set, handles calls to GetNamespaceConfiguration.
+	GetNamespaceConfigurationFunc func(ctx context.Context, namespaceIdentity string) (*ContainerRegistryNamespaceConfiguration, error)
+	// ListContainerRegistryNamespacesFunc, if set, handles calls to ListContainerRegistryNamespaces.
+	ListContainerRegistryNamespacesFunc func(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) ([]ContainerRegistryNamespace, error)
+	// ListContainerRegistryRepositoriesFunc, if set, handles calls to ListContainerRegistryRepositories.
+	ListContainerRegistryRepositoriesFunc func(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) ([]ContainerRegistryRepository, error)
+	// RunRetentionPolicyFunc, if set, handles calls to RunRetentionPolicy.
+	RunRetentionPolicyFunc func(ctx context.Context, namespaceIdentity string) error
+	// UpdateContainerRegistryNamespaceFunc, if set, handles calls to UpdateContainerRegistryNamespace.
+	UpdateContainerRegistryNamespaceFunc func(ctx context.Context, namespaceIdentity string, update UpdateContainerRegistryNamespaceRequest) (*ContainerRegistryNamespace, error)
+	// UpdateNamespaceConfigurationFunc, if set, handles calls to UpdateNamespaceConfiguration.
+	UpdateNamespaceConfigurationFunc func(ctx context.Context, namespaceIdentity string, update UpdateNamespaceConfigurationRequest) (*ContainerRegistryNamespaceConfiguration, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllContainerRegistryNamespaces records the call and invokes AllContainerRegistryNamespacesFunc if set.
+func (f *Fake) AllContainerRegistryNamespaces(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) (r0 iter.Seq2[ContainerRegistryNamespace, error]) {
+	f.Record("AllContainerRegistryNamespaces", ctx, listRequest)
+	if f.AllContainerRegistryNamespacesFunc != nil {
+		return f.AllContainerRegistryNamespacesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(ContainerRegistryNamespace, error) bool) {
+		if f.Err != nil {
+			var zero ContainerRegistryNamespace
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllContainerRegistryRepositories records the call and invokes AllContainerRegistryRepositoriesFunc if set.
+func (f *Fake) AllContainerRegistryRepositories(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) (r0 iter.Seq2[ContainerRegistryRepository, error]) {
+	f.Record("AllContainerRegistryRepositories", ctx, namespaceIdentity, listRequest)
+	if f.AllContainerRegistryRepositoriesFunc != nil {
+		return f.AllContainerRegistryRepositoriesFunc(ctx, namespaceIdentity, listRequest)
+	}
+	r0 = func(yield func(ContainerRegistryRepository, error) bool) {
+		if f.Err != nil {
+			var zero ContainerRegistryRepository
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateContainerRegistryNamespace records the call and invokes CreateContainerRegistryNamespaceFunc if set.
+func (f *Fake) CreateContainerRegistryNamespace(ctx context.Context, create CreateContainerRegistryNamespaceRequest) (r0 *ContainerRegistryNamespace, r1 error) {
+	f.Record("CreateContainerRegistryNamespace", ctx, create)
+	if f.CreateContainerRegistryNamespaceFunc != nil {
+		return f.CreateContainerRegistryNamespaceFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateNamespaceConfiguration records the call and invokes CreateNamespaceConfigurationFunc if set.
+func (f *Fake) CreateNamespaceConfiguration(ctx context.Context, namespaceIdentity string, create CreateNamespaceConfigurationRequest) (r0 *ContainerRegistryNamespaceConfiguration, r1 error) {
+	f.Record("CreateNamespaceConfiguration", ctx, namespaceIdentity, create)
+	if f.CreateNamespaceConfigurationFunc != nil {
+		return f.CreateNamespaceConfigurationFunc(ctx, namespaceIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteContainerRegistryNamespace records the call and invokes DeleteContainerRegistryNamespaceFunc if set.
+func (f *Fake) DeleteContainerRegistryNamespace(ctx context.Context, namespaceIdentity string) (r0 error) {
+	f.Record("DeleteContainerRegistryNamespace", ctx, namespaceIdentity)
+	if f.DeleteContainerRegistryNamespaceFunc != nil {
+		return f.DeleteContainerRegistryNamespaceFunc(ctx, namespaceIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteContainerRegistryRepositoryArtifact records the call and invokes DeleteContainerRegistryRepositoryArtifactFunc if set.
+func (f *Fake) DeleteContainerRegistryRepositoryArtifact(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (r0 error) {
+	f.Record("DeleteContainerRegistryRepositoryArtifact", ctx, namespaceIdentity, repositoryIdentity)
+	if f.DeleteContainerRegistryRepositoryArtifactFunc != nil {
+		return f.DeleteContainerRegistryRepositoryArtifactFunc(ctx, namespaceIdentity, repositoryIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteContainerRegistryRepositoryWithAllArtifacts records the call and invokes DeleteContainerRegistryRepositoryWithAllArtifactsFunc if set.
+func (f *Fake) DeleteContainerRegistryRepositoryWithAllArtifacts(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (r0 error) {
+	f.Record("DeleteContainerRegistryRepositoryWithAllArtifacts", ctx, namespaceIdentity, repositoryIdentity)
+	if f.DeleteContainerRegistryRepositoryWithAllArtifactsFunc != nil {
+		return f.DeleteContainerRegistryRepositoryWithAllArtifactsFunc(ctx, namespaceIdentity, repositoryIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteNamespaceConfiguration records the call and invokes DeleteNamespaceConfigurationFunc if set.
+func (f *Fake) DeleteNamespaceConfiguration(ctx context.Context, namespaceIdentity string) (r0 error) {
+	f.Record("DeleteNamespaceConfiguration", ctx, namespaceIdentity)
+	if f.DeleteNamespaceConfigurationFunc != nil {
+		return f.DeleteNamespaceConfigurationFunc(ctx, namespaceIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetContainerRegistryNamespace records the call and invokes GetContainerRegistryNamespaceFunc if set.
+func (f *Fake) GetContainerRegistryNamespace(ctx context.Context, namespaceIdentity string) (r0 *ContainerRegistryNamespace, r1 error) {
+	f.Record("GetContainerRegistryNamespace", ctx, namespaceIdentity)
+	if f.GetContainerRegistryNamespaceFunc != nil {
+		return f.GetContainerRegistryNamespaceFunc(ctx, namespaceIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetContainerRegistryRepository records the call and invokes GetContainerRegistryRepositoryFunc if set.
+func (f *Fake) GetContainerRegistryRepository(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (r0 *ContainerRegistryRepository, r1 error) {
+	f.Record("GetContainerRegistryRepository", ctx, namespaceIdentity, repositoryIdentity)
+	if f.GetContainerRegistryRepositoryFunc != nil {
+		return f.GetContainerRegistryRepositoryFunc(ctx, namespaceIdentity, repositoryIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetNamespaceConfiguration records the call and invokes GetNamespaceConfigurationFunc if set.
+func (f *Fake) GetNamespaceConfiguration(ctx context.Context, namespaceIdentity string) (r0 *ContainerRegistryNamespaceConfiguration, r1 error) {
+	f.Record("GetNamespaceConfiguration", ctx, namespaceIdentity)
+	if f.GetNamespaceConfigurationFunc != nil {
+		return f.GetNamespaceConfigurationFunc(ctx, namespaceIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListContainerRegistryNamespaces records the call and invokes ListContainerRegistryNamespacesFunc if set.
+func (f *Fake) ListContainerRegistryNamespaces(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) (r0 []ContainerRegistryNamespace, r1 error) {
+	f.Record("ListContainerRegistryNamespaces", ctx, listRequest)
+	if f.ListContainerRegistryNamespacesFunc != nil {
+		return f.ListContainerRegistryNamespacesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListContainerRegistryRepositories records the call and invokes ListContainerRegistryRepositoriesFunc if set.
+func (f *Fake) ListContainerRegistryRepositories(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) (r0 []ContainerRegistryRepository, r1 error) {
+	f.Record("ListContainerRegistryRepositories", ctx, namespaceIdentity, listRequest)
+	if f.ListContainerRegistryRepositoriesFunc != nil {
+		return f.ListContainerRegistryRepositoriesFunc(ctx, namespaceIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// RunRetentionPolicy records the call and invokes RunRetentionPolicyFunc if set.
+func (f *Fake) RunRetentionPolicy(ctx context.Context, namespaceIdentity string) (r0 error) {
+	f.Record("RunRetentionPolicy", ctx, namespaceIdentity)
+	if f.RunRetentionPolicyFunc != nil {
+		return f.RunRetentionPolicyFunc(ctx, namespaceIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// UpdateContainerRegistryNamespace records the call and invokes UpdateContainerRegistryNamespaceFunc if set.
+func (f *Fake) UpdateContainerRegistryNamespace(ctx context.Context, namespaceIdentity string, update UpdateContainerRegistryNamespaceRequest) (r0 *ContainerRegistryNamespace, r1 error) {
+	f.Record("UpdateContainerRegistryNamespace", ctx, namespaceIdentity, update)
+	if f.UpdateContainerRegistryNamespaceFunc != nil {
+		return f.UpdateContainerRegistryNamespaceFunc(ctx, namespaceIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateNamespaceConfiguration records the call and invokes UpdateNamespaceConfigurationFunc if set.
+func (f *Fake) UpdateNamespaceConfiguration(ctx context.Context, namespaceIdentity string, update UpdateNamespaceConfigurationRequest) (r0 *ContainerRegistryNamespaceConfiguration, r1 error) {
+	f.Record("UpdateNamespaceConfiguration", ctx, namespaceIdentity, update)
+	if f.UpdateNamespaceConfigurationFunc != nil {
+		return f.UpdateNamespaceConfigurationFunc(ctx, namespaceIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
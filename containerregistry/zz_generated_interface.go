@@ -0,0 +1,62 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package containerregistry
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllContainerRegistryNamespaces returns an iterator over the results of ListContainerRegistryNamespaces.
+	AllContainerRegistryNamespaces(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) iter.Seq2[ContainerRegistryNamespace, error]
+
+	// AllContainerRegistryRepositories returns an iterator over the results of ListContainerRegistryRepositories.
+	AllContainerRegistryRepositories(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) iter.Seq2[ContainerRegistryRepository, error]
+
+	// CreateContainerRegistryNamespace creates a new container registry namespace.
+	CreateContainerRegistryNamespace(ctx context.Context, create CreateContainerRegistryNamespaceRequest) (*ContainerRegistryNamespace, error)
+
+	// CreateNamespaceConfiguration creates a configuration for a container registry namespace.
+	CreateNamespaceConfiguration(ctx context.Context, namespaceIdentity string, create CreateNamespaceConfigurationRequest) (*ContainerRegistryNamespaceConfiguration, error)
+
+	// DeleteContainerRegistryNamespace deletes a specific container registry namespace by its identity.
+	DeleteContainerRegistryNamespace(ctx context.Context, namespaceIdentity string) error
+
+	// DeleteContainerRegistryRepositoryArtifact deletes artifacts from a container registry repository.
+	DeleteContainerRegistryRepositoryArtifact(ctx context.Context, namespaceIdentity string, repositoryIdentity string) error
+
+	// DeleteContainerRegistryRepositoryWithAllArtifacts deletes a container registry repository and all its artifacts.
+	DeleteContainerRegistryRepositoryWithAllArtifacts(ctx context.Context, namespaceIdentity string, repositoryIdentity string) error
+
+	// DeleteNamespaceConfiguration deletes the configuration for a container registry namespace.
+	DeleteNamespaceConfiguration(ctx context.Context, namespaceIdentity string) error
+
+	// GetContainerRegistryNamespace retrieves a specific container registry namespace by its identity.
+	GetContainerRegistryNamespace(ctx context.Context, namespaceIdentity string) (*ContainerRegistryNamespace, error)
+
+	// GetContainerRegistryRepository retrieves a specific container registry repository by its identity.
+	GetContainerRegistryRepository(ctx context.Context, namespaceIdentity string, repositoryIdentity string) (*ContainerRegistryRepository, error)
+
+	// GetNamespaceConfiguration retrieves the configuration for a container registry namespace.
+	GetNamespaceConfiguration(ctx context.Context, namespaceIdentity string) (*ContainerRegistryNamespaceConfiguration, error)
+
+	// ListContainerRegistryNamespaces lists all container registry namespaces for the organisation.
+	ListContainerRegistryNamespaces(ctx context.Context, listRequest *ListContainerRegistryNamespacesRequest) ([]ContainerRegistryNamespace, error)
+
+	// ListContainerRegistryRepositories lists all repositories for a specific container registry namespace.
+	ListContainerRegistryRepositories(ctx context.Context, namespaceIdentity string, listRequest *ListContainerRegistryRepositoriesRequest) ([]ContainerRegistryRepository, error)
+
+	// RunRetentionPolicy runs the retention policy for a container registry namespace.
+	RunRetentionPolicy(ctx context.Context, namespaceIdentity string) error
+
+	// UpdateContainerRegistryNamespace updates an existing container registry namespace.
+	UpdateContainerRegistryNamespace(ctx context.Context, namespaceIdentity string, update UpdateContainerRegistryNamespaceRequest) (*ContainerRegistryNamespace, error)
+
+	// UpdateNamespaceConfiguration updates the configuration for a container registry namespace.
+	UpdateNamespaceConfiguration(ctx context.Context, namespaceIdentity string, update UpdateNamespaceConfigurationRequest) (*ContainerRegistryNamespaceConfiguration, error)
+}
+
+var _ Interface = (*Client)(nil)
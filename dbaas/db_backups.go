@@ -3,9 +3,11 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -39,6 +41,13 @@ func (c *Client) ListDbBackupsForDbCluster(ctx context.Context, dbClusterIdentit
 	return backups, nil
 }
 
+// AllDbBackupsForDbCluster returns an iterator over the results of ListDbBackupsForDbCluster.
+func (c *Client) AllDbBackupsForDbCluster(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterBackup, error) {
+		return c.ListDbBackupsForDbCluster(ctx, dbClusterIdentity, listRequest)
+	})
+}
+
 // CreateDbBackup creates a new backup for a database cluster.
 func (c *Client) CreateDbBackup(ctx context.Context, dbClusterIdentity string, create CreateDbClusterBackupRequest) (*DbClusterBackup, error) {
 	if dbClusterIdentity == "" {
@@ -83,6 +92,13 @@ func (c *Client) ListDbBackupsForOrganisation(ctx context.Context, listRequest *
 	return backups, nil
 }
 
+// AllDbBackupsForOrganisation returns an iterator over the results of ListDbBackupsForOrganisation.
+func (c *Client) AllDbBackupsForOrganisation(ctx context.Context, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterBackup, error) {
+		return c.ListDbBackupsForOrganisation(ctx, listRequest)
+	})
+}
+
 // GetDbBackup retrieves a specific backup by its identity.
 func (c *Client) GetDbBackup(ctx context.Context, backupIdentity string) (*DbClusterBackup, error) {
 	if backupIdentity == "" {
@@ -115,6 +131,37 @@ func (c *Client) DeleteDbBackup(ctx context.Context, backupIdentity string) erro
 	return c.Check(resp)
 }
 
+// WaitUntilDbBackupReady waits until the backup is ready.
+// It returns an error if the backup failed or is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbBackupReady(ctx context.Context, backupIdentity string, opts ...wait.Option) (*DbClusterBackup, error) {
+	w := wait.UntilStatus("backup "+backupIdentity, c.dbBackupGetter(backupIdentity), dbBackupStatus, string(ObjectStatusReady),
+		string(ObjectStatusFailed), string(ObjectStatusDeleting), string(ObjectStatusDeleted))
+	backup, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// WaitUntilDbBackupDeleted waits until the backup is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbBackupDeleted(ctx context.Context, backupIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("backup "+backupIdentity, c.dbBackupGetter(backupIdentity), dbBackupStatus,
+		string(ObjectStatusDeleting), string(ObjectStatusDeleted), string(ObjectStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) dbBackupGetter(identity string) func(context.Context) (*DbClusterBackup, error) {
+	return func(ctx context.Context) (*DbClusterBackup, error) {
+		return c.GetDbBackup(ctx, identity)
+	}
+}
+
+func dbBackupStatus(backup *DbClusterBackup) string {
+	return string(backup.Status)
+}
+
 // CancelDeleteDbBackup cancels the deletion of a backup.
 func (c *Client) CancelDeleteDbBackup(ctx context.Context, backupIdentity string) error {
 	if backupIdentity == "" {
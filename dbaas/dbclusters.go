@@ -3,9 +3,11 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -36,6 +38,13 @@ func (c *Client) ListDbClusters(ctx context.Context, listRequest *ListDbClusters
 	return dbClusters, nil
 }
 
+// AllDbClusters returns an iterator over the results of ListDbClusters.
+func (c *Client) AllDbClusters(ctx context.Context, listRequest *ListDbClustersRequest) iter.Seq2[DbCluster, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbCluster, error) {
+		return c.ListDbClusters(ctx, listRequest)
+	})
+}
+
 // GetDbCluster retrieves a specific dbCluster by its identity.
 func (c *Client) GetDbCluster(ctx context.Context, dbClusterIdentity string) (*DbCluster, error) {
 	if dbClusterIdentity == "" {
@@ -115,6 +124,44 @@ func (c *Client) DeleteDbCluster(ctx context.Context, dbClusterIdentity string)
 	return nil
 }
 
+// WaitUntilDbClusterReady waits until the dbCluster is ready.
+// It returns an error if the dbCluster failed or is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbClusterReady(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) (*DbCluster, error) {
+	return c.WaitUntilDbClusterIsStatus(ctx, dbClusterIdentity, DbClusterStatusReady, opts...)
+}
+
+// WaitUntilDbClusterIsStatus waits until the dbCluster is in a specific status.
+// It returns an error if the dbCluster failed or is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbClusterIsStatus(ctx context.Context, dbClusterIdentity string, status DbClusterStatus, opts ...wait.Option) (*DbCluster, error) {
+	w := wait.UntilStatus("dbCluster "+dbClusterIdentity, c.dbClusterGetter(dbClusterIdentity), dbClusterStatus, string(status),
+		string(DbClusterStatusFailed), string(DbClusterStatusDeleting), string(DbClusterStatusDeleted))
+	dbCluster, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return dbCluster, nil
+}
+
+// WaitUntilDbClusterDeleted waits until the dbCluster is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbClusterDeleted(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("dbCluster "+dbClusterIdentity, c.dbClusterGetter(dbClusterIdentity), dbClusterStatus,
+		string(DbClusterStatusDeleting), string(DbClusterStatusDeleted), string(DbClusterStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) dbClusterGetter(identity string) func(context.Context) (*DbCluster, error) {
+	return func(ctx context.Context) (*DbCluster, error) {
+		return c.GetDbCluster(ctx, identity)
+	}
+}
+
+func dbClusterStatus(dbCluster *DbCluster) string {
+	return string(dbCluster.Status)
+}
+
 // GetUpgradableVersionsForCluster retrieves the list of upgradeable versions for a specific dbCluster.
 func (c *Client) GetUpgradableVersionsForCluster(ctx context.Context, dbClusterIdentity string) ([]DbClusterEngineVersion, error) {
 	if dbClusterIdentity == "" {
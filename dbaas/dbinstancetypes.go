@@ -3,6 +3,7 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -73,6 +74,13 @@ func (c *Client) ListDatabaseInstanceTypes(ctx context.Context, listRequest *Lis
 	return databaseInstanceTypes, nil
 }
 
+// AllDatabaseInstanceTypes returns an iterator over the results of ListDatabaseInstanceTypes.
+func (c *Client) AllDatabaseInstanceTypes(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) iter.Seq2[DatabaseInstanceType, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DatabaseInstanceType, error) {
+		return c.ListDatabaseInstanceTypes(ctx, listRequest)
+	})
+}
+
 // GetDatabaseInstanceType retrieves a specific DatabaseInstanceType by its identity.
 // The identity is the unique identifier for the DatabaseInstanceType.
 func (c *Client) GetDatabaseInstanceType(ctx context.Context, identity string) (*DatabaseInstanceType, error) {
@@ -105,3 +113,10 @@ func (c *Client) ListDatabaseInstanceTypeCategories(ctx context.Context) ([]Data
 
 	return databaseInstanceTypeCategories, nil
 }
+
+// AllDatabaseInstanceTypeCategories returns an iterator over the results of ListDatabaseInstanceTypeCategories.
+func (c *Client) AllDatabaseInstanceTypeCategories(ctx context.Context) iter.Seq2[DatabaseInstanceTypeCategory, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DatabaseInstanceTypeCategory, error) {
+		return c.ListDatabaseInstanceTypeCategories(ctx)
+	})
+}
@@ -3,9 +3,11 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -40,6 +42,13 @@ func (c *Client) ListDbObjectStores(ctx context.Context, listRequest *ListDbObje
 	return stores, nil
 }
 
+// AllDbObjectStores returns an iterator over the results of ListDbObjectStores.
+func (c *Client) AllDbObjectStores(ctx context.Context, listRequest *ListDbObjectStoresRequest) iter.Seq2[DbObjectStore, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbObjectStore, error) {
+		return c.ListDbObjectStores(ctx, listRequest)
+	})
+}
+
 // GetDbObjectStore returns a DB object store by identity.
 func (c *Client) GetDbObjectStore(ctx context.Context, identity string) (*DbObjectStore, error) {
 	if identity == "" {
@@ -113,3 +122,34 @@ func (c *Client) DeleteDbObjectStore(ctx context.Context, identity string) error
 	}
 	return c.Check(resp)
 }
+
+// WaitUntilDbObjectStoreReady waits until the object store is ready.
+// It returns an error if the object store failed or is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbObjectStoreReady(ctx context.Context, identity string, opts ...wait.Option) (*DbObjectStore, error) {
+	w := wait.UntilStatus("object store "+identity, c.dbObjectStoreGetter(identity), dbObjectStoreStatus, string(ObjectStatusReady),
+		string(ObjectStatusFailed), string(ObjectStatusDeleting), string(ObjectStatusDeleted))
+	objectStore, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return objectStore, nil
+}
+
+// WaitUntilDbObjectStoreDeleted waits until the object store is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilDbObjectStoreDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("object store "+identity, c.dbObjectStoreGetter(identity), dbObjectStoreStatus,
+		string(ObjectStatusDeleting), string(ObjectStatusDeleted), string(ObjectStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) dbObjectStoreGetter(identity string) func(context.Context) (*DbObjectStore, error) {
+	return func(ctx context.Context) (*DbObjectStore, error) {
+		return c.GetDbObjectStore(ctx, identity)
+	}
+}
+
+func dbObjectStoreStatus(objectStore *DbObjectStore) string {
+	return string(objectStore.Status)
+}
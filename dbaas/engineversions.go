@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -48,6 +49,13 @@ func (c *Client) ListEngineVersions(ctx context.Context, engine DbClusterDatabas
 	return nil, fmt.Errorf("engine version not found for engine %s", engine)
 }
 
+// AllEngineVersions returns an iterator over the results of ListEngineVersions.
+func (c *Client) AllEngineVersions(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) iter.Seq2[DbClusterEngineVersion, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterEngineVersion, error) {
+		return c.ListEngineVersions(ctx, engine, listRequest)
+	})
+}
+
 // ListEngineVersionsRequest is the request for the ListEngineVersions function.
 type ListEngineVersionsRequest struct {
 	Filters []filters.Filter
@@ -3,6 +3,7 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -40,6 +41,13 @@ func (c *Client) ListDbBackupSchedules(ctx context.Context, dbClusterIdentity st
 	return backupSchedules, nil
 }
 
+// AllDbBackupSchedules returns an iterator over the results of ListDbBackupSchedules.
+func (c *Client) AllDbBackupSchedules(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) iter.Seq2[DbClusterBackupSchedule, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterBackupSchedule, error) {
+		return c.ListDbBackupSchedules(ctx, dbClusterIdentity, listRequest)
+	})
+}
+
 // CreateDbBackupSchedule creates a new DBaaS Cluster backup schedule for a database cluster.
 func (c *Client) CreateDbBackupSchedule(ctx context.Context, dbClusterIdentity string, create CreateDbBackupScheduleRequest) (*DbClusterBackupSchedule, error) {
 	if dbClusterIdentity == "" {
@@ -148,3 +156,10 @@ func (c *Client) ListDbBackupSchedulesForOrganisation(ctx context.Context) ([]Db
 	}
 	return backupSchedules, nil
 }
+
+// AllDbBackupSchedulesForOrganisation returns an iterator over the results of ListDbBackupSchedulesForOrganisation.
+func (c *Client) AllDbBackupSchedulesForOrganisation(ctx context.Context) iter.Seq2[DbClusterBackupSchedule, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterBackupSchedule, error) {
+		return c.ListDbBackupSchedulesForOrganisation(ctx)
+	})
+}
@@ -3,6 +3,7 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -33,6 +34,13 @@ func (c *Client) ListPgDatabases(ctx context.Context, dbClusterIdentity string,
 	return databases, nil
 }
 
+// AllPgDatabases returns an iterator over the results of ListPgDatabases.
+func (c *Client) AllPgDatabases(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) iter.Seq2[DbClusterPostgresDatabase, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterPostgresDatabase, error) {
+		return c.ListPgDatabases(ctx, dbClusterIdentity, listRequest)
+	})
+}
+
 type ListPgDatabasesRequest struct {
 	Filters []filters.Filter
 }
@@ -3,6 +3,7 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -40,6 +41,13 @@ func (c *Client) ListDbGrants(ctx context.Context, dbClusterIdentity string, lis
 	return grants, nil
 }
 
+// AllDbGrants returns an iterator over the results of ListDbGrants.
+func (c *Client) AllDbGrants(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) iter.Seq2[DbClusterPostgresGrant, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterPostgresGrant, error) {
+		return c.ListDbGrants(ctx, dbClusterIdentity, listRequest)
+	})
+}
+
 // CreatePgGrant creates a new PostgreSQL grant for a role on a database in a database cluster.
 func (c *Client) CreatePgGrant(ctx context.Context, dbClusterIdentity string, create CreatePgGrantRequest) (*DbClusterPostgresGrant, error) {
 	if dbClusterIdentity == "" {
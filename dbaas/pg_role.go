@@ -3,6 +3,7 @@ package dbaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -39,6 +40,13 @@ func (c *Client) ListPgRoles(ctx context.Context, dbClusterIdentity string, list
 	return roles, nil
 }
 
+// AllPgRoles returns an iterator over the results of ListPgRoles.
+func (c *Client) AllPgRoles(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) iter.Seq2[DbClusterPostgresRole, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DbClusterPostgresRole, error) {
+		return c.ListPgRoles(ctx, dbClusterIdentity, listRequest)
+	})
+}
+
 // CreatePgRole creates a new PostgreSQL role in a database cluster.
 func (c *Client) CreatePgRole(ctx context.Context, dbClusterIdentity string, create CreatePgRoleRequest) (*DbClusterPostgresRole, error) {
 	if dbClusterIdentity == "" {
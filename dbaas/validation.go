@@ -0,0 +1,183 @@
+package dbaas
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+var (
+	autoUpgradePolicies = []DbClusterAutoUpgradePolicy{
+		DbClusterAutoUpgradePolicyNone,
+		DbClusterAutoUpgradePolicyLatestVersion,
+		DbClusterAutoUpgradePolicyLatestStable,
+		DbClusterAutoUpgradePolicyLatestPatch,
+		DbClusterAutoUpgradePolicyLatestMinor,
+		DbClusterAutoUpgradePolicyLatestMajor,
+	}
+	retentionPolicy = regexp.MustCompile(`^[1-9][0-9]*d$`)
+)
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDbClusterRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("subnetIdentity", r.SubnetIdentity)
+	validate.OneOf(&f, "engine", r.Engine, DbClusterDatabaseEnginePostgres)
+	f.Check(r.Replicas >= 0, "replicas", "must not be negative")
+	validateMaintenance(&f, r.AutoUpgradePolicy, r.MaintenanceDay, r.MaintenanceStartAt)
+	if r.RestoreRecoveryTarget != nil {
+		f.Check(r.RestoreFromBackupIdentity != nil, "restoreRecoveryTarget", "requires restoreFromBackupIdentity")
+		f.Nested("restoreRecoveryTarget", r.RestoreRecoveryTarget.Validate())
+	}
+	if r.InitialDbBackupSchedule != nil {
+		f.Nested("initialDbBackupSchedule", r.InitialDbBackupSchedule.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateDbClusterRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Check(r.Replicas >= 0, "replicas", "must not be negative")
+	validateMaintenance(&f, r.AutoUpgradePolicy, r.MaintenanceDay, r.MaintenanceStartAt)
+	return f.Err()
+}
+
+func validateMaintenance(f *validate.Fields, policy *DbClusterAutoUpgradePolicy, day, startAt *uint) {
+	if policy != nil {
+		validate.OneOf(f, "autoUpgradePolicy", *policy, autoUpgradePolicies...)
+	}
+	if day != nil {
+		validate.Range(f, "maintenanceDay", *day, 0, 6)
+	}
+	if startAt != nil {
+		validate.Range(f, "maintenanceStartAt", *startAt, 0, 23)
+	}
+}
+
+// Validate checks the fields of the recovery target before it is sent.
+func (t RestoreRecoveryTarget) Validate() error {
+	var f validate.Fields
+	f.Check(t.TargetTime == nil || t.TargetLSN == nil, "targetLSN", "must not be set together with targetTime")
+	if t.TargetTime != nil {
+		_, err := time.Parse(time.RFC3339, *t.TargetTime)
+		f.Check(err == nil, "targetTime", "must be an RFC 3339 timestamp")
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreatePgDatabaseRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("owner", r.Owner)
+	validateDatabase(&f, r.ConnectionLimit, r.Extensions)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdatePgDatabaseRequest) Validate() error {
+	var f validate.Fields
+	validateDatabase(&f, r.ConnectionLimit, r.Extensions)
+	return f.Err()
+}
+
+func validateDatabase(f *validate.Fields, connectionLimit *int, extensions *PgDatabaseExtensions) {
+	if connectionLimit != nil {
+		f.Check(*connectionLimit >= -1, "connectionLimit", "must be -1 or more")
+	}
+	if extensions != nil {
+		for i, extension := range extensions.Extensions {
+			f.Required(validate.Index("extensions.extensions", i)+".name", extension.Name)
+		}
+	}
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreatePgRoleRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Check(r.ConnectionLimit >= -1, "connectionLimit", "must be -1 or more")
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdatePgRoleRequest) Validate() error {
+	var f validate.Fields
+	f.Check(r.ConnectionLimit >= -1, "connectionLimit", "must be -1 or more")
+	if r.Password != nil {
+		f.Required("password", *r.Password)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDbBackupScheduleRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("schedule", r.Schedule)
+	f.Required("retentionPolicy", r.RetentionPolicy)
+	validate.OneOf(&f, "method", r.Method, DbClusterBackupScheduleMethodSnapshot, DbClusterBackupScheduleMethodBarman)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateDbBackupScheduleRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("schedule", r.Schedule)
+	f.Required("retentionPolicy", r.RetentionPolicy)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDbClusterBackupRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	if r.RetentionPolicy != nil {
+		f.Required("retentionPolicy", *r.RetentionPolicy)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreatePgGrantRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("roleName", r.RoleName)
+	f.Required("databaseName", r.DatabaseName)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdatePgGrantRequest) Validate() error {
+	var f validate.Fields
+	f.Check(r.Read != nil || r.Write != nil, "read", "one of read or write is required")
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDbObjectStoreRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("region", r.Region)
+	validateRetentionPolicy(&f, r.RetentionPolicy)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateDbObjectStoreRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	validateRetentionPolicy(&f, r.RetentionPolicy)
+	return f.Err()
+}
+
+func validateRetentionPolicy(f *validate.Fields, policy string) {
+	if policy != "" {
+		f.Check(retentionPolicy.MatchString(policy), "retentionPolicy", `must be a number of days, such as "30d"`)
+	}
+}
@@ -0,0 +1,88 @@
+package dbaas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	sunday, midnight, late := uint(0), uint(0), uint(24)
+	policy := DbClusterAutoUpgradePolicy("weekly")
+	target := "yesterday"
+	restoreAt := "2026-01-01T00:00:00Z"
+	backup := "backup-1"
+
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid cluster",
+			req: CreateDbClusterRequest{
+				Name:               "db",
+				SubnetIdentity:     "subnet-1",
+				Engine:             DbClusterDatabaseEnginePostgres,
+				MaintenanceDay:     &sunday,
+				MaintenanceStartAt: &midnight,
+			},
+		},
+		{
+			name: "cluster reports every invalid field",
+			req: CreateDbClusterRequest{
+				Engine:                  "mysql",
+				Replicas:                -1,
+				AutoUpgradePolicy:       &policy,
+				MaintenanceStartAt:      &late,
+				RestoreRecoveryTarget:   &RestoreRecoveryTarget{TargetTime: &target},
+				InitialDbBackupSchedule: &CreateDbBackupScheduleRequest{Name: "nightly", Schedule: "0 2 * * *", RetentionPolicy: "7d", Method: "dump"},
+			},
+			want: []string{
+				"name",
+				"subnetIdentity",
+				"engine",
+				"replicas",
+				"autoUpgradePolicy",
+				"maintenanceStartAt",
+				"restoreRecoveryTarget",
+				"restoreRecoveryTarget.targetTime",
+				"initialDbBackupSchedule.method",
+			},
+		},
+		{
+			name: "point in time recovery",
+			req: CreateDbClusterRequest{
+				Name:                      "db",
+				SubnetIdentity:            "subnet-1",
+				RestoreFromBackupIdentity: &backup,
+				RestoreRecoveryTarget:     &RestoreRecoveryTarget{TargetTime: &restoreAt},
+			},
+		},
+		{
+			name: "object store retention",
+			req:  CreateDbObjectStoreRequest{Name: "backups", Region: "nl-01", RetentionPolicy: "30 days"},
+			want: []string{"retentionPolicy"},
+		},
+		{
+			name: "grant",
+			req:  CreatePgGrantRequest{Name: "app", RoleName: "app"},
+			want: []string{"databaseName"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
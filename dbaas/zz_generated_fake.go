@@ -0,0 +1,818 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package dbaas
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllDatabaseInstanceTypeCategoriesFunc, if set, handles calls to AllDatabaseInstanceTypeCategories.
+	AllDatabaseInstanceTypeCategoriesFunc func(ctx context.Context) iter.Seq2[DatabaseInstanceTypeCategory, error]
+	// AllDatabaseInstanceTypesFunc, if set, handles calls to AllDatabaseInstanceTypes.
+	AllDatabaseInstanceTypesFunc func(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) iter.Seq2[DatabaseInstanceType, error]
+	// AllDbBackupSchedulesFunc, if set, handles calls to AllDbBackupSchedules.
+	AllDbBackupSchedulesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) iter.Seq2[DbClusterBackupSchedule, error]
+	// AllDbBackupSchedulesForOrganisationFunc, if set, handles calls to AllDbBackupSchedulesForOrganisation.
+	AllDbBackupSchedulesForOrganisationFunc func(ctx context.Context) iter.Seq2[DbClusterBackupSchedule, error]
+	// AllDbBackupsForDbClusterFunc, if set, handles calls to AllDbBackupsForDbCluster.
+	AllDbBackupsForDbClusterFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error]
+	// AllDbBackupsForOrganisationFunc, if set, handles calls to AllDbBackupsForOrganisation.
+	AllDbBackupsForOrganisationFunc func(ctx context.Context, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error]
+	// AllDbClustersFunc, if set, handles calls to AllDbClusters.
+	AllDbClustersFunc func(ctx context.Context, listRequest *ListDbClustersRequest) iter.Seq2[DbCluster, error]
+	// AllDbGrantsFunc, if set, handles calls to AllDbGrants.
+	AllDbGrantsFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) iter.Seq2[DbClusterPostgresGrant, error]
+	// AllDbObjectStoresFunc, if set, handles calls to AllDbObjectStores.
+	AllDbObjectStoresFunc func(ctx context.Context, listRequest *ListDbObjectStoresRequest) iter.Seq2[DbObjectStore, error]
+	// AllEngineVersionsFunc, if set, handles calls to AllEngineVersions.
+	AllEngineVersionsFunc func(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) iter.Seq2[DbClusterEngineVersion, error]
+	// AllPgDatabasesFunc, if set, handles calls to AllPgDatabases.
+	AllPgDatabasesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) iter.Seq2[DbClusterPostgresDatabase, error]
+	// AllPgRolesFunc, if set, handles calls to AllPgRoles.
+	AllPgRolesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) iter.Seq2[DbClusterPostgresRole, error]
+	// CancelDeleteDbBackupFunc, if set, handles calls to CancelDeleteDbBackup.
+	CancelDeleteDbBackupFunc func(ctx context.Context, backupIdentity string) error
+	// CancelDeletePgDatabaseFunc, if set, handles calls to CancelDeletePgDatabase.
+	CancelDeletePgDatabaseFunc func(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string) error
+	// CancelDeletePgRoleFunc, if set, handles calls to CancelDeletePgRole.
+	CancelDeletePgRoleFunc func(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) error
+	// CreateDbBackupFunc, if set, handles calls to CreateDbBackup.
+	CreateDbBackupFunc func(ctx context.Context, dbClusterIdentity string, create CreateDbClusterBackupRequest) (*DbClusterBackup, error)
+	// CreateDbBackupScheduleFunc, if set, handles calls to CreateDbBackupSchedule.
+	CreateDbBackupScheduleFunc func(ctx context.Context, dbClusterIdentity string, create CreateDbBackupScheduleRequest) (*DbClusterBackupSchedule, error)
+	// CreateDbClusterFunc, if set, handles calls to CreateDbCluster.
+	CreateDbClusterFunc func(ctx context.Context, create CreateDbClusterRequest) (*DbCluster, error)
+	// CreateDbObjectStoreFunc, if set, handles calls to CreateDbObjectStore.
+	CreateDbObjectStoreFunc func(ctx context.Context, create CreateDbObjectStoreRequest) (*DbObjectStore, error)
+	// CreatePgDatabaseFunc, if set, handles calls to CreatePgDatabase.
+	CreatePgDatabaseFunc func(ctx context.Context, dbClusterIdentity string, create CreatePgDatabaseRequest) (*DbClusterPostgresDatabase, error)
+	// CreatePgGrantFunc, if set, handles calls to CreatePgGrant.
+	CreatePgGrantFunc func(ctx context.Context, dbClusterIdentity string, create CreatePgGrantRequest) (*DbClusterPostgresGrant, error)
+	// CreatePgRoleFunc, if set, handles calls to CreatePgRole.
+	CreatePgRoleFunc func(ctx context.Context, dbClusterIdentity string, create CreatePgRoleRequest) (*DbClusterPostgresRole, error)
+	// DeleteDbBackupFunc, if set, handles calls to DeleteDbBackup.
+	DeleteDbBackupFunc func(ctx context.Context, backupIdentity string) error
+	// DeleteDbBackupScheduleFunc, if set, handles calls to DeleteDbBackupSchedule.
+	DeleteDbBackupScheduleFunc func(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) error
+	// DeleteDbClusterFunc, if set, handles calls to DeleteDbCluster.
+	DeleteDbClusterFunc func(ctx context.Context, dbClusterIdentity string) error
+	// DeleteDbObjectStoreFunc, if set, handles calls to DeleteDbObjectStore.
+	DeleteDbObjectStoreFunc func(ctx context.Context, identity string) error
+	// DeletePgDatabaseFunc, if set, handles calls to DeletePgDatabase.
+	DeletePgDatabaseFunc func(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, immediate bool) error
+	// DeletePgGrantFunc, if set, handles calls to DeletePgGrant.
+	DeletePgGrantFunc func(ctx context.Context, dbClusterIdentity string, grantIdentity string) error
+	// DeletePgRoleFunc, if set, handles calls to DeletePgRole.
+	DeletePgRoleFunc func(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) error
+	// GetDatabaseInstanceTypeFunc, if set, handles calls to GetDatabaseInstanceType.
+	GetDatabaseInstanceTypeFunc func(ctx context.Context, identity string) (*DatabaseInstanceType, error)
+	// GetDbBackupFunc, if set, handles calls to GetDbBackup.
+	GetDbBackupFunc func(ctx context.Context, backupIdentity string) (*DbClusterBackup, error)
+	// GetDbBackupScheduleFunc, if set, handles calls to GetDbBackupSchedule.
+	GetDbBackupScheduleFunc func(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) (*DbClusterBackupSchedule, error)
+	// GetDbClusterFunc, if set, handles calls to GetDbCluster.
+	GetDbClusterFunc func(ctx context.Context, dbClusterIdentity string) (*DbCluster, error)
+	// GetDbObjectStoreFunc, if set, handles calls to GetDbObjectStore.
+	GetDbObjectStoreFunc func(ctx context.Context, identity string) (*DbObjectStore, error)
+	// GetUpgradableVersionsForClusterFunc, if set, handles calls to GetUpgradableVersionsForCluster.
+	GetUpgradableVersionsForClusterFunc func(ctx context.Context, dbClusterIdentity string) ([]DbClusterEngineVersion, error)
+	// ListDatabaseEnginesFunc, if set, handles calls to ListDatabaseEngines.
+	ListDatabaseEnginesFunc func(ctx context.Context, listRequest *ListDatabaseEnginesRequest) (*ListDatabaseEnginesResponse, error)
+	// ListDatabaseInstanceTypeCategoriesFunc, if set, handles calls to ListDatabaseInstanceTypeCategories.
+	ListDatabaseInstanceTypeCategoriesFunc func(ctx context.Context) ([]DatabaseInstanceTypeCategory, error)
+	// ListDatabaseInstanceTypesFunc, if set, handles calls to ListDatabaseInstanceTypes.
+	ListDatabaseInstanceTypesFunc func(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) ([]DatabaseInstanceType, error)
+	// ListDbBackupSchedulesFunc, if set, handles calls to ListDbBackupSchedules.
+	ListDbBackupSchedulesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) ([]DbClusterBackupSchedule, error)
+	// ListDbBackupSchedulesForOrganisationFunc, if set, handles calls to ListDbBackupSchedulesForOrganisation.
+	ListDbBackupSchedulesForOrganisationFunc func(ctx context.Context) ([]DbClusterBackupSchedule, error)
+	// ListDbBackupsForDbClusterFunc, if set, handles calls to ListDbBackupsForDbCluster.
+	ListDbBackupsForDbClusterFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) ([]DbClusterBackup, error)
+	// ListDbBackupsForOrganisationFunc, if set, handles calls to ListDbBackupsForOrganisation.
+	ListDbBackupsForOrganisationFunc func(ctx context.Context, listRequest *ListDbBackupsRequest) ([]DbClusterBackup, error)
+	// ListDbClustersFunc, if set, handles calls to ListDbClusters.
+	ListDbClustersFunc func(ctx context.Context, listRequest *ListDbClustersRequest) ([]DbCluster, error)
+	// ListDbGrantsFunc, if set, handles calls to ListDbGrants.
+	ListDbGrantsFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) ([]DbClusterPostgresGrant, error)
+	// ListDbObjectStoresFunc, if set, handles calls to ListDbObjectStores.
+	ListDbObjectStoresFunc func(ctx context.Context, listRequest *ListDbObjectStoresRequest) ([]DbObjectStore, error)
+	// ListEngineVersionsFunc, if set, handles calls to ListEngineVersions.
+	ListEngineVersionsFunc func(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) ([]DbClusterEngineVersion, error)
+	// ListPgDatabasesFunc, if set, handles calls to ListPgDatabases.
+	ListPgDatabasesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) ([]DbClusterPostgresDatabase, error)
+	// ListPgRolesFunc, if set, handles calls to ListPgRoles.
+	ListPgRolesFunc func(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) ([]DbClusterPostgresRole, error)
+	// UpdateDbBackupScheduleFunc, if set, handles calls to UpdateDbBackupSchedule.
+	UpdateDbBackupScheduleFunc func(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string, update UpdateDbBackupScheduleRequest) (*DbClusterBackupSchedule, error)
+	// UpdateDbClusterFunc, if set, handles calls to UpdateDbCluster.
+	UpdateDbClusterFunc func(ctx context.Context, dbClusterIdentity string, update UpdateDbClusterRequest) (*DbCluster, error)
+	// UpdateDbObjectStoreFunc, if set, handles calls to UpdateDbObjectStore.
+	UpdateDbObjectStoreFunc func(ctx context.Context, identity string, update UpdateDbObjectStoreRequest) (*DbObjectStore, error)
+	// UpdatePgDatabaseFunc, if set, handles calls to UpdatePgDatabase.
+	UpdatePgDatabaseFunc func(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, update UpdatePgDatabaseRequest) (*DbClusterPostgresDatabase, error)
+	// UpdatePgGrantFunc, if set, handles calls to UpdatePgGrant.
+	UpdatePgGrantFunc func(ctx context.Context, dbClusterIdentity string, grantIdentity string, update UpdatePgGrantRequest) (*DbClusterPostgresGrant, error)
+	// UpdatePgRoleFunc, if set, handles calls to UpdatePgRole.
+	UpdatePgRoleFunc func(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string, update UpdatePgRoleRequest) (*DbClusterPostgresRole, error)
+	// WaitUntilDbBackupDeletedFunc, if set, handles calls to WaitUntilDbBackupDeleted.
+	WaitUntilDbBackupDeletedFunc func(ctx context.Context, backupIdentity string, opts ...wait.Option) error
+	// WaitUntilDbBackupReadyFunc, if set, handles calls to WaitUntilDbBackupReady.
+	WaitUntilDbBackupReadyFunc func(ctx context.Context, backupIdentity string, opts ...wait.Option) (*DbClusterBackup, error)
+	// WaitUntilDbClusterDeletedFunc, if set, handles calls to WaitUntilDbClusterDeleted.
+	WaitUntilDbClusterDeletedFunc func(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) error
+	// WaitUntilDbClusterIsStatusFunc, if set, handles calls to WaitUntilDbClusterIsStatus.
+	WaitUntilDbClusterIsStatusFunc func(ctx context.Context, dbClusterIdentity string, status DbClusterStatus, opts ...wait.Option) (*DbCluster, error)
+	// WaitUntilDbClusterReadyFunc, if set, handles calls to WaitUntilDbClusterReady.
+	WaitUntilDbClusterReadyFunc func(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) (*DbCluster, error)
+	// WaitUntilDbObjectStoreDeletedFunc, if set, handles calls to WaitUntilDbObjectStoreDeleted.
+	WaitUntilDbObjectStoreDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilDbObjectStoreReadyFunc, if set, handles calls to WaitUntilDbObjectStoreReady.
+	WaitUntilDbObjectStoreReadyFunc func(ctx context.Context, identity string, opts ...wait.Option) (*DbObjectStore, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllDatabaseInstanceTypeCategories records the call and invokes AllDatabaseInstanceTypeCategoriesFunc if set.
+func (f *Fake) AllDatabaseInstanceTypeCategories(ctx context.Context) (r0 iter.Seq2[DatabaseInstanceTypeCategory, error]) {
+	f.Record("AllDatabaseInstanceTypeCategories", ctx)
+	if f.AllDatabaseInstanceTypeCategoriesFunc != nil {
+		return f.AllDatabaseInstanceTypeCategoriesFunc(ctx)
+	}
+	r0 = func(yield func(DatabaseInstanceTypeCategory, error) bool) {
+		if f.Err != nil {
+			var zero DatabaseInstanceTypeCategory
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDatabaseInstanceTypes records the call and invokes AllDatabaseInstanceTypesFunc if set.
+func (f *Fake) AllDatabaseInstanceTypes(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) (r0 iter.Seq2[DatabaseInstanceType, error]) {
+	f.Record("AllDatabaseInstanceTypes", ctx, listRequest)
+	if f.AllDatabaseInstanceTypesFunc != nil {
+		return f.AllDatabaseInstanceTypesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(DatabaseInstanceType, error) bool) {
+		if f.Err != nil {
+			var zero DatabaseInstanceType
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbBackupSchedules records the call and invokes AllDbBackupSchedulesFunc if set.
+func (f *Fake) AllDbBackupSchedules(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) (r0 iter.Seq2[DbClusterBackupSchedule, error]) {
+	f.Record("AllDbBackupSchedules", ctx, dbClusterIdentity, listRequest)
+	if f.AllDbBackupSchedulesFunc != nil {
+		return f.AllDbBackupSchedulesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r0 = func(yield func(DbClusterBackupSchedule, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterBackupSchedule
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbBackupSchedulesForOrganisation records the call and invokes AllDbBackupSchedulesForOrganisationFunc if set.
+func (f *Fake) AllDbBackupSchedulesForOrganisation(ctx context.Context) (r0 iter.Seq2[DbClusterBackupSchedule, error]) {
+	f.Record("AllDbBackupSchedulesForOrganisation", ctx)
+	if f.AllDbBackupSchedulesForOrganisationFunc != nil {
+		return f.AllDbBackupSchedulesForOrganisationFunc(ctx)
+	}
+	r0 = func(yield func(DbClusterBackupSchedule, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterBackupSchedule
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbBackupsForDbCluster records the call and invokes AllDbBackupsForDbClusterFunc if set.
+func (f *Fake) AllDbBackupsForDbCluster(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) (r0 iter.Seq2[DbClusterBackup, error]) {
+	f.Record("AllDbBackupsForDbCluster", ctx, dbClusterIdentity, listRequest)
+	if f.AllDbBackupsForDbClusterFunc != nil {
+		return f.AllDbBackupsForDbClusterFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r0 = func(yield func(DbClusterBackup, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterBackup
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbBackupsForOrganisation records the call and invokes AllDbBackupsForOrganisationFunc if set.
+func (f *Fake) AllDbBackupsForOrganisation(ctx context.Context, listRequest *ListDbBackupsRequest) (r0 iter.Seq2[DbClusterBackup, error]) {
+	f.Record("AllDbBackupsForOrganisation", ctx, listRequest)
+	if f.AllDbBackupsForOrganisationFunc != nil {
+		return f.AllDbBackupsForOrganisationFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(DbClusterBackup, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterBackup
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbClusters records the call and invokes AllDbClustersFunc if set.
+func (f *Fake) AllDbClusters(ctx context.Context, listRequest *ListDbClustersRequest) (r0 iter.Seq2[DbCluster, error]) {
+	f.Record("AllDbClusters", ctx, listRequest)
+	if f.AllDbClustersFunc != nil {
+		return f.AllDbClustersFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(DbCluster, error) bool) {
+		if f.Err != nil {
+			var zero DbCluster
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbGrants records the call and invokes AllDbGrantsFunc if set.
+func (f *Fake) AllDbGrants(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) (r0 iter.Seq2[DbClusterPostgresGrant, error]) {
+	f.Record("AllDbGrants", ctx, dbClusterIdentity, listRequest)
+	if f.AllDbGrantsFunc != nil {
+		return f.AllDbGrantsFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r0 = func(yield func(DbClusterPostgresGrant, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterPostgresGrant
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllDbObjectStores records the call and invokes AllDbObjectStoresFunc if set.
+func (f *Fake) AllDbObjectStores(ctx context.Context, listRequest *ListDbObjectStoresRequest) (r0 iter.Seq2[DbObjectStore, error]) {
+	f.Record("AllDbObjectStores", ctx, listRequest)
+	if f.AllDbObjectStoresFunc != nil {
+		return f.AllDbObjectStoresFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(DbObjectStore, error) bool) {
+		if f.Err != nil {
+			var zero DbObjectStore
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllEngineVersions records the call and invokes AllEngineVersionsFunc if set.
+func (f *Fake) AllEngineVersions(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) (r0 iter.Seq2[DbClusterEngineVersion, error]) {
+	f.Record("AllEngineVersions", ctx, engine, listRequest)
+	if f.AllEngineVersionsFunc != nil {
+		return f.AllEngineVersionsFunc(ctx, engine, listRequest)
+	}
+	r0 = func(yield func(DbClusterEngineVersion, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterEngineVersion
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllPgDatabases records the call and invokes AllPgDatabasesFunc if set.
+func (f *Fake) AllPgDatabases(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) (r0 iter.Seq2[DbClusterPostgresDatabase, error]) {
+	f.Record("AllPgDatabases", ctx, dbClusterIdentity, listRequest)
+	if f.AllPgDatabasesFunc != nil {
+		return f.AllPgDatabasesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r0 = func(yield func(DbClusterPostgresDatabase, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterPostgresDatabase
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllPgRoles records the call and invokes AllPgRolesFunc if set.
+func (f *Fake) AllPgRoles(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) (r0 iter.Seq2[DbClusterPostgresRole, error]) {
+	f.Record("AllPgRoles", ctx, dbClusterIdentity, listRequest)
+	if f.AllPgRolesFunc != nil {
+		return f.AllPgRolesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r0 = func(yield func(DbClusterPostgresRole, error) bool) {
+		if f.Err != nil {
+			var zero DbClusterPostgresRole
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CancelDeleteDbBackup records the call and invokes CancelDeleteDbBackupFunc if set.
+func (f *Fake) CancelDeleteDbBackup(ctx context.Context, backupIdentity string) (r0 error) {
+	f.Record("CancelDeleteDbBackup", ctx, backupIdentity)
+	if f.CancelDeleteDbBackupFunc != nil {
+		return f.CancelDeleteDbBackupFunc(ctx, backupIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// CancelDeletePgDatabase records the call and invokes CancelDeletePgDatabaseFunc if set.
+func (f *Fake) CancelDeletePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string) (r0 error) {
+	f.Record("CancelDeletePgDatabase", ctx, dbClusterIdentity, postgresDatabaseIdentity)
+	if f.CancelDeletePgDatabaseFunc != nil {
+		return f.CancelDeletePgDatabaseFunc(ctx, dbClusterIdentity, postgresDatabaseIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// CancelDeletePgRole records the call and invokes CancelDeletePgRoleFunc if set.
+func (f *Fake) CancelDeletePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) (r0 error) {
+	f.Record("CancelDeletePgRole", ctx, dbClusterIdentity, postgresRoleIdentity)
+	if f.CancelDeletePgRoleFunc != nil {
+		return f.CancelDeletePgRoleFunc(ctx, dbClusterIdentity, postgresRoleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// CreateDbBackup records the call and invokes CreateDbBackupFunc if set.
+func (f *Fake) CreateDbBackup(ctx context.Context, dbClusterIdentity string, create CreateDbClusterBackupRequest) (r0 *DbClusterBackup, r1 error) {
+	f.Record("CreateDbBackup", ctx, dbClusterIdentity, create)
+	if f.CreateDbBackupFunc != nil {
+		return f.CreateDbBackupFunc(ctx, dbClusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateDbBackupSchedule records the call and invokes CreateDbBackupScheduleFunc if set.
+func (f *Fake) CreateDbBackupSchedule(ctx context.Context, dbClusterIdentity string, create CreateDbBackupScheduleRequest) (r0 *DbClusterBackupSchedule, r1 error) {
+	f.Record("CreateDbBackupSchedule", ctx, dbClusterIdentity, create)
+	if f.CreateDbBackupScheduleFunc != nil {
+		return f.CreateDbBackupScheduleFunc(ctx, dbClusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateDbCluster records the call and invokes CreateDbClusterFunc if set.
+func (f *Fake) CreateDbCluster(ctx context.Context, create CreateDbClusterRequest) (r0 *DbCluster, r1 error) {
+	f.Record("CreateDbCluster", ctx, create)
+	if f.CreateDbClusterFunc != nil {
+		return f.CreateDbClusterFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateDbObjectStore records the call and invokes CreateDbObjectStoreFunc if set.
+func (f *Fake) CreateDbObjectStore(ctx context.Context, create CreateDbObjectStoreRequest) (r0 *DbObjectStore, r1 error) {
+	f.Record("CreateDbObjectStore", ctx, create)
+	if f.CreateDbObjectStoreFunc != nil {
+		return f.CreateDbObjectStoreFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreatePgDatabase records the call and invokes CreatePgDatabaseFunc if set.
+func (f *Fake) CreatePgDatabase(ctx context.Context, dbClusterIdentity string, create CreatePgDatabaseRequest) (r0 *DbClusterPostgresDatabase, r1 error) {
+	f.Record("CreatePgDatabase", ctx, dbClusterIdentity, create)
+	if f.CreatePgDatabaseFunc != nil {
+		return f.CreatePgDatabaseFunc(ctx, dbClusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreatePgGrant records the call and invokes CreatePgGrantFunc if set.
+func (f *Fake) CreatePgGrant(ctx context.Context, dbClusterIdentity string, create CreatePgGrantRequest) (r0 *DbClusterPostgresGrant, r1 error) {
+	f.Record("CreatePgGrant", ctx, dbClusterIdentity, create)
+	if f.CreatePgGrantFunc != nil {
+		return f.CreatePgGrantFunc(ctx, dbClusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreatePgRole records the call and invokes CreatePgRoleFunc if set.
+func (f *Fake) CreatePgRole(ctx context.Context, dbClusterIdentity string, create CreatePgRoleRequest) (r0 *DbClusterPostgresRole, r1 error) {
+	f.Record("CreatePgRole", ctx, dbClusterIdentity, create)
+	if f.CreatePgRoleFunc != nil {
+		return f.CreatePgRoleFunc(ctx, dbClusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteDbBackup records the call and invokes DeleteDbBackupFunc if set.
+func (f *Fake) DeleteDbBackup(ctx context.Context, backupIdentity string) (r0 error) {
+	f.Record("DeleteDbBackup", ctx, backupIdentity)
+	if f.DeleteDbBackupFunc != nil {
+		return f.DeleteDbBackupFunc(ctx, backupIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteDbBackupSchedule records the call and invokes DeleteDbBackupScheduleFunc if set.
+func (f *Fake) DeleteDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) (r0 error) {
+	f.Record("DeleteDbBackupSchedule", ctx, dbClusterIdentity, backupScheduleIdentity)
+	if f.DeleteDbBackupScheduleFunc != nil {
+		return f.DeleteDbBackupScheduleFunc(ctx, dbClusterIdentity, backupScheduleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteDbCluster records the call and invokes DeleteDbClusterFunc if set.
+func (f *Fake) DeleteDbCluster(ctx context.Context, dbClusterIdentity string) (r0 error) {
+	f.Record("DeleteDbCluster", ctx, dbClusterIdentity)
+	if f.DeleteDbClusterFunc != nil {
+		return f.DeleteDbClusterFunc(ctx, dbClusterIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteDbObjectStore records the call and invokes DeleteDbObjectStoreFunc if set.
+func (f *Fake) DeleteDbObjectStore(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteDbObjectStore", ctx, identity)
+	if f.DeleteDbObjectStoreFunc != nil {
+		return f.DeleteDbObjectStoreFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeletePgDatabase records the call and invokes DeletePgDatabaseFunc if set.
+func (f *Fake) DeletePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, immediate bool) (r0 error) {
+	f.Record("DeletePgDatabase", ctx, dbClusterIdentity, postgresDatabaseIdentity, immediate)
+	if f.DeletePgDatabaseFunc != nil {
+		return f.DeletePgDatabaseFunc(ctx, dbClusterIdentity, postgresDatabaseIdentity, immediate)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeletePgGrant records the call and invokes DeletePgGrantFunc if set.
+func (f *Fake) DeletePgGrant(ctx context.Context, dbClusterIdentity string, grantIdentity string) (r0 error) {
+	f.Record("DeletePgGrant", ctx, dbClusterIdentity, grantIdentity)
+	if f.DeletePgGrantFunc != nil {
+		return f.DeletePgGrantFunc(ctx, dbClusterIdentity, grantIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeletePgRole records the call and invokes DeletePgRoleFunc if set.
+func (f *Fake) DeletePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) (r0 error) {
+	f.Record("DeletePgRole", ctx, dbClusterIdentity, postgresRoleIdentity)
+	if f.DeletePgRoleFunc != nil {
+		return f.DeletePgRoleFunc(ctx, dbClusterIdentity, postgresRoleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetDatabaseInstanceType records the call and invokes GetDatabaseInstanceTypeFunc if set.
+func (f *Fake) GetDatabaseInstanceType(ctx context.Context, identity string) (r0 *DatabaseInstanceType, r1 error) {
+	f.Record("GetDatabaseInstanceType", ctx, identity)
+	if f.GetDatabaseInstanceTypeFunc != nil {
+		return f.GetDatabaseInstanceTypeFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetDbBackup records the call and invokes GetDbBackupFunc if set.
+func (f *Fake) GetDbBackup(ctx context.Context, backupIdentity string) (r0 *DbClusterBackup, r1 error) {
+	f.Record("GetDbBackup", ctx, backupIdentity)
+	if f.GetDbBackupFunc != nil {
+		return f.GetDbBackupFunc(ctx, backupIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetDbBackupSchedule records the call and invokes GetDbBackupScheduleFunc if set.
+func (f *Fake) GetDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) (r0 *DbClusterBackupSchedule, r1 error) {
+	f.Record("GetDbBackupSchedule", ctx, dbClusterIdentity, backupScheduleIdentity)
+	if f.GetDbBackupScheduleFunc != nil {
+		return f.GetDbBackupScheduleFunc(ctx, dbClusterIdentity, backupScheduleIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetDbCluster records the call and invokes GetDbClusterFunc if set.
+func (f *Fake) GetDbCluster(ctx context.Context, dbClusterIdentity string) (r0 *DbCluster, r1 error) {
+	f.Record("GetDbCluster", ctx, dbClusterIdentity)
+	if f.GetDbClusterFunc != nil {
+		return f.GetDbClusterFunc(ctx, dbClusterIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetDbObjectStore records the call and invokes GetDbObjectStoreFunc if set.
+func (f *Fake) GetDbObjectStore(ctx context.Context, identity string) (r0 *DbObjectStore, r1 error) {
+	f.Record("GetDbObjectStore", ctx, identity)
+	if f.GetDbObjectStoreFunc != nil {
+		return f.GetDbObjectStoreFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetUpgradableVersionsForCluster records the call and invokes GetUpgradableVersionsForClusterFunc if set.
+func (f *Fake) GetUpgradableVersionsForCluster(ctx context.Context, dbClusterIdentity string) (r0 []DbClusterEngineVersion, r1 error) {
+	f.Record("GetUpgradableVersionsForCluster", ctx, dbClusterIdentity)
+	if f.GetUpgradableVersionsForClusterFunc != nil {
+		return f.GetUpgradableVersionsForClusterFunc(ctx, dbClusterIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDatabaseEngines records the call and invokes ListDatabaseEnginesFunc if set.
+func (f *Fake) ListDatabaseEngines(ctx context.Context, listRequest *ListDatabaseEnginesRequest) (r0 *ListDatabaseEnginesResponse, r1 error) {
+	f.Record("ListDatabaseEngines", ctx, listRequest)
+	if f.ListDatabaseEnginesFunc != nil {
+		return f.ListDatabaseEnginesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDatabaseInstanceTypeCategories records the call and invokes ListDatabaseInstanceTypeCategoriesFunc if set.
+func (f *Fake) ListDatabaseInstanceTypeCategories(ctx context.Context) (r0 []DatabaseInstanceTypeCategory, r1 error) {
+	f.Record("ListDatabaseInstanceTypeCategories", ctx)
+	if f.ListDatabaseInstanceTypeCategoriesFunc != nil {
+		return f.ListDatabaseInstanceTypeCategoriesFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDatabaseInstanceTypes records the call and invokes ListDatabaseInstanceTypesFunc if set.
+func (f *Fake) ListDatabaseInstanceTypes(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) (r0 []DatabaseInstanceType, r1 error) {
+	f.Record("ListDatabaseInstanceTypes", ctx, listRequest)
+	if f.ListDatabaseInstanceTypesFunc != nil {
+		return f.ListDatabaseInstanceTypesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbBackupSchedules records the call and invokes ListDbBackupSchedulesFunc if set.
+func (f *Fake) ListDbBackupSchedules(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) (r0 []DbClusterBackupSchedule, r1 error) {
+	f.Record("ListDbBackupSchedules", ctx, dbClusterIdentity, listRequest)
+	if f.ListDbBackupSchedulesFunc != nil {
+		return f.ListDbBackupSchedulesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbBackupSchedulesForOrganisation records the call and invokes ListDbBackupSchedulesForOrganisationFunc if set.
+func (f *Fake) ListDbBackupSchedulesForOrganisation(ctx context.Context) (r0 []DbClusterBackupSchedule, r1 error) {
+	f.Record("ListDbBackupSchedulesForOrganisation", ctx)
+	if f.ListDbBackupSchedulesForOrganisationFunc != nil {
+		return f.ListDbBackupSchedulesForOrganisationFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbBackupsForDbCluster records the call and invokes ListDbBackupsForDbClusterFunc if set.
+func (f *Fake) ListDbBackupsForDbCluster(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) (r0 []DbClusterBackup, r1 error) {
+	f.Record("ListDbBackupsForDbCluster", ctx, dbClusterIdentity, listRequest)
+	if f.ListDbBackupsForDbClusterFunc != nil {
+		return f.ListDbBackupsForDbClusterFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbBackupsForOrganisation records the call and invokes ListDbBackupsForOrganisationFunc if set.
+func (f *Fake) ListDbBackupsForOrganisation(ctx context.Context, listRequest *ListDbBackupsRequest) (r0 []DbClusterBackup, r1 error) {
+	f.Record("ListDbBackupsForOrganisation", ctx, listRequest)
+	if f.ListDbBackupsForOrganisationFunc != nil {
+		return f.ListDbBackupsForOrganisationFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbClusters records the call and invokes ListDbClustersFunc if set.
+func (f *Fake) ListDbClusters(ctx context.Context, listRequest *ListDbClustersRequest) (r0 []DbCluster, r1 error) {
+	f.Record("ListDbClusters", ctx, listRequest)
+	if f.ListDbClustersFunc != nil {
+		return f.ListDbClustersFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbGrants records the call and invokes ListDbGrantsFunc if set.
+func (f *Fake) ListDbGrants(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) (r0 []DbClusterPostgresGrant, r1 error) {
+	f.Record("ListDbGrants", ctx, dbClusterIdentity, listRequest)
+	if f.ListDbGrantsFunc != nil {
+		return f.ListDbGrantsFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListDbObjectStores records the call and invokes ListDbObjectStoresFunc if set.
+func (f *Fake) ListDbObjectStores(ctx context.Context, listRequest *ListDbObjectStoresRequest) (r0 []DbObjectStore, r1 error) {
+	f.Record("ListDbObjectStores", ctx, listRequest)
+	if f.ListDbObjectStoresFunc != nil {
+		return f.ListDbObjectStoresFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListEngineVersions records the call and invokes ListEngineVersionsFunc if set.
+func (f *Fake) ListEngineVersions(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) (r0 []DbClusterEngineVersion, r1 error) {
+	f.Record("ListEngineVersions", ctx, engine, listRequest)
+	if f.ListEngineVersionsFunc != nil {
+		return f.ListEngineVersionsFunc(ctx, engine, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListPgDatabases records the call and invokes ListPgDatabasesFunc if set.
+func (f *Fake) ListPgDatabases(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) (r0 []DbClusterPostgresDatabase, r1 error) {
+	f.Record("ListPgDatabases", ctx, dbClusterIdentity, listRequest)
+	if f.ListPgDatabasesFunc != nil {
+		return f.ListPgDatabasesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListPgRoles records the call and invokes ListPgRolesFunc if set.
+func (f *Fake) ListPgRoles(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) (r0 []DbClusterPostgresRole, r1 error) {
+	f.Record("ListPgRoles", ctx, dbClusterIdentity, listRequest)
+	if f.ListPgRolesFunc != nil {
+		return f.ListPgRolesFunc(ctx, dbClusterIdentity, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateDbBackupSchedule records the call and invokes UpdateDbBackupScheduleFunc if set.
+func (f *Fake) UpdateDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string, update UpdateDbBackupScheduleRequest) (r0 *DbClusterBackupSchedule, r1 error) {
+	f.Record("UpdateDbBackupSchedule", ctx, dbClusterIdentity, backupScheduleIdentity, update)
+	if f.UpdateDbBackupScheduleFunc != nil {
+		return f.UpdateDbBackupScheduleFunc(ctx, dbClusterIdentity, backupScheduleIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateDbCluster records the call and invokes UpdateDbClusterFunc if set.
+func (f *Fake) UpdateDbCluster(ctx context.Context, dbClusterIdentity string, update UpdateDbClusterRequest) (r0 *DbCluster, r1 error) {
+	f.Record("UpdateDbCluster", ctx, dbClusterIdentity, update)
+	if f.UpdateDbClusterFunc != nil {
+		return f.UpdateDbClusterFunc(ctx, dbClusterIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateDbObjectStore records the call and invokes UpdateDbObjectStoreFunc if set.
+func (f *Fake) UpdateDbObjectStore(ctx context.Context, identity string, update UpdateDbObjectStoreRequest) (r0 *DbObjectStore, r1 error) {
+	f.Record("UpdateDbObjectStore", ctx, identity, update)
+	if f.UpdateDbObjectStoreFunc != nil {
+		return f.UpdateDbObjectStoreFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdatePgDatabase records the call and invokes UpdatePgDatabaseFunc if set.
+func (f *Fake) UpdatePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, update UpdatePgDatabaseRequest) (r0 *DbClusterPostgresDatabase, r1 error) {
+	f.Record("UpdatePgDatabase", ctx, dbClusterIdentity, postgresDatabaseIdentity, update)
+	if f.UpdatePgDatabaseFunc != nil {
+		return f.UpdatePgDatabaseFunc(ctx, dbClusterIdentity, postgresDatabaseIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdatePgGrant records the call and invokes UpdatePgGrantFunc if set.
+func (f *Fake) UpdatePgGrant(ctx context.Context, dbClusterIdentity string, grantIdentity string, update UpdatePgGrantRequest) (r0 *DbClusterPostgresGrant, r1 error) {
+	f.Record("UpdatePgGrant", ctx, dbClusterIdentity, grantIdentity, update)
+	if f.UpdatePgGrantFunc != nil {
+		return f.UpdatePgGrantFunc(ctx, dbClusterIdentity, grantIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdatePgRole records the call and invokes UpdatePgRoleFunc if set.
+func (f *Fake) UpdatePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string, update UpdatePgRoleRequest) (r0 *DbClusterPostgresRole, r1 error) {
+	f.Record("UpdatePgRole", ctx, dbClusterIdentity, postgresRoleIdentity, update)
+	if f.UpdatePgRoleFunc != nil {
+		return f.UpdatePgRoleFunc(ctx, dbClusterIdentity, postgresRoleIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilDbBackupDeleted records the call and invokes WaitUntilDbBackupDeletedFunc if set.
+func (f *Fake) WaitUntilDbBackupDeleted(ctx context.Context, backupIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilDbBackupDeleted", ctx, backupIdentity, opts)
+	if f.WaitUntilDbBackupDeletedFunc != nil {
+		return f.WaitUntilDbBackupDeletedFunc(ctx, backupIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilDbBackupReady records the call and invokes WaitUntilDbBackupReadyFunc if set.
+func (f *Fake) WaitUntilDbBackupReady(ctx context.Context, backupIdentity string, opts ...wait.Option) (r0 *DbClusterBackup, r1 error) {
+	f.Record("WaitUntilDbBackupReady", ctx, backupIdentity, opts)
+	if f.WaitUntilDbBackupReadyFunc != nil {
+		return f.WaitUntilDbBackupReadyFunc(ctx, backupIdentity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilDbClusterDeleted records the call and invokes WaitUntilDbClusterDeletedFunc if set.
+func (f *Fake) WaitUntilDbClusterDeleted(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilDbClusterDeleted", ctx, dbClusterIdentity, opts)
+	if f.WaitUntilDbClusterDeletedFunc != nil {
+		return f.WaitUntilDbClusterDeletedFunc(ctx, dbClusterIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilDbClusterIsStatus records the call and invokes WaitUntilDbClusterIsStatusFunc if set.
+func (f *Fake) WaitUntilDbClusterIsStatus(ctx context.Context, dbClusterIdentity string, status DbClusterStatus, opts ...wait.Option) (r0 *DbCluster, r1 error) {
+	f.Record("WaitUntilDbClusterIsStatus", ctx, dbClusterIdentity, status, opts)
+	if f.WaitUntilDbClusterIsStatusFunc != nil {
+		return f.WaitUntilDbClusterIsStatusFunc(ctx, dbClusterIdentity, status, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilDbClusterReady records the call and invokes WaitUntilDbClusterReadyFunc if set.
+func (f *Fake) WaitUntilDbClusterReady(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) (r0 *DbCluster, r1 error) {
+	f.Record("WaitUntilDbClusterReady", ctx, dbClusterIdentity, opts)
+	if f.WaitUntilDbClusterReadyFunc != nil {
+		return f.WaitUntilDbClusterReadyFunc(ctx, dbClusterIdentity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilDbObjectStoreDeleted records the call and invokes WaitUntilDbObjectStoreDeletedFunc if set.
+func (f *Fake) WaitUntilDbObjectStoreDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilDbObjectStoreDeleted", ctx, identity, opts)
+	if f.WaitUntilDbObjectStoreDeletedFunc != nil {
+		return f.WaitUntilDbObjectStoreDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilDbObjectStoreReady records the call and invokes WaitUntilDbObjectStoreReadyFunc if set.
+func (f *Fake) WaitUntilDbObjectStoreReady(ctx context.Context, identity string, opts ...wait.Option) (r0 *DbObjectStore, r1 error) {
+	f.Record("WaitUntilDbObjectStoreReady", ctx, identity, opts)
+	if f.WaitUntilDbObjectStoreReadyFunc != nil {
+		return f.WaitUntilDbObjectStoreReadyFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
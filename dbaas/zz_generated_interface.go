@@ -0,0 +1,213 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package dbaas
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllDatabaseInstanceTypeCategories returns an iterator over the results of ListDatabaseInstanceTypeCategories.
+	AllDatabaseInstanceTypeCategories(ctx context.Context) iter.Seq2[DatabaseInstanceTypeCategory, error]
+
+	// AllDatabaseInstanceTypes returns an iterator over the results of ListDatabaseInstanceTypes.
+	AllDatabaseInstanceTypes(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) iter.Seq2[DatabaseInstanceType, error]
+
+	// AllDbBackupSchedules returns an iterator over the results of ListDbBackupSchedules.
+	AllDbBackupSchedules(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) iter.Seq2[DbClusterBackupSchedule, error]
+
+	// AllDbBackupSchedulesForOrganisation returns an iterator over the results of ListDbBackupSchedulesForOrganisation.
+	AllDbBackupSchedulesForOrganisation(ctx context.Context) iter.Seq2[DbClusterBackupSchedule, error]
+
+	// AllDbBackupsForDbCluster returns an iterator over the results of ListDbBackupsForDbCluster.
+	AllDbBackupsForDbCluster(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error]
+
+	// AllDbBackupsForOrganisation returns an iterator over the results of ListDbBackupsForOrganisation.
+	AllDbBackupsForOrganisation(ctx context.Context, listRequest *ListDbBackupsRequest) iter.Seq2[DbClusterBackup, error]
+
+	// AllDbClusters returns an iterator over the results of ListDbClusters.
+	AllDbClusters(ctx context.Context, listRequest *ListDbClustersRequest) iter.Seq2[DbCluster, error]
+
+	// AllDbGrants returns an iterator over the results of ListDbGrants.
+	AllDbGrants(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) iter.Seq2[DbClusterPostgresGrant, error]
+
+	// AllDbObjectStores returns an iterator over the results of ListDbObjectStores.
+	AllDbObjectStores(ctx context.Context, listRequest *ListDbObjectStoresRequest) iter.Seq2[DbObjectStore, error]
+
+	// AllEngineVersions returns an iterator over the results of ListEngineVersions.
+	AllEngineVersions(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) iter.Seq2[DbClusterEngineVersion, error]
+
+	// AllPgDatabases returns an iterator over the results of ListPgDatabases.
+	AllPgDatabases(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) iter.Seq2[DbClusterPostgresDatabase, error]
+
+	// AllPgRoles returns an iterator over the results of ListPgRoles.
+	AllPgRoles(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) iter.Seq2[DbClusterPostgresRole, error]
+
+	// CancelDeleteDbBackup cancels the deletion of a backup.
+	CancelDeleteDbBackup(ctx context.Context, backupIdentity string) error
+
+	// CancelDeletePgDatabase cancels the deletion of a PostgreSQL database from a database cluster.
+	CancelDeletePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string) error
+
+	// CancelDeletePgRole cancels the deletion of a PostgreSQL role from a database cluster.
+	CancelDeletePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) error
+
+	// CreateDbBackup creates a new backup for a database cluster.
+	CreateDbBackup(ctx context.Context, dbClusterIdentity string, create CreateDbClusterBackupRequest) (*DbClusterBackup, error)
+
+	// CreateDbBackupSchedule creates a new DBaaS Cluster backup schedule for a database cluster.
+	CreateDbBackupSchedule(ctx context.Context, dbClusterIdentity string, create CreateDbBackupScheduleRequest) (*DbClusterBackupSchedule, error)
+
+	// CreateDbCluster creates a new dbCluster.
+	CreateDbCluster(ctx context.Context, create CreateDbClusterRequest) (*DbCluster, error)
+
+	// CreateDbObjectStore creates a new DB object store.
+	CreateDbObjectStore(ctx context.Context, create CreateDbObjectStoreRequest) (*DbObjectStore, error)
+
+	// CreatePgDatabase creates a new PostgreSQL database in a database cluster.
+	CreatePgDatabase(ctx context.Context, dbClusterIdentity string, create CreatePgDatabaseRequest) (*DbClusterPostgresDatabase, error)
+
+	// CreatePgGrant creates a new PostgreSQL grant for a role on a database in a database cluster.
+	CreatePgGrant(ctx context.Context, dbClusterIdentity string, create CreatePgGrantRequest) (*DbClusterPostgresGrant, error)
+
+	// CreatePgRole creates a new PostgreSQL role in a database cluster.
+	CreatePgRole(ctx context.Context, dbClusterIdentity string, create CreatePgRoleRequest) (*DbClusterPostgresRole, error)
+
+	// DeleteDbBackup deletes a specific backup by its identity.
+	DeleteDbBackup(ctx context.Context, backupIdentity string) error
+
+	// DeleteDbBackupSchedule deletes a DBaaS Cluster backup schedule from a database cluster.
+	DeleteDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) error
+
+	// DeleteDbCluster deletes a specific dbCluster by its identity.
+	DeleteDbCluster(ctx context.Context, dbClusterIdentity string) error
+
+	// DeleteDbObjectStore deletes a DB object store by identity.
+	DeleteDbObjectStore(ctx context.Context, identity string) error
+
+	// DeletePgDatabase deletes a PostgreSQL database from a database cluster.
+	DeletePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, immediate bool) error
+
+	// DeletePgGrant deletes a PostgreSQL grant from a database cluster.
+	DeletePgGrant(ctx context.Context, dbClusterIdentity string, grantIdentity string) error
+
+	// DeletePgRole deletes a PostgreSQL role from a database cluster.
+	DeletePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string) error
+
+	// GetDatabaseInstanceType retrieves a specific DatabaseInstanceType by its identity.
+	// The identity is the unique identifier for the DatabaseInstanceType.
+	GetDatabaseInstanceType(ctx context.Context, identity string) (*DatabaseInstanceType, error)
+
+	// GetDbBackup retrieves a specific backup by its identity.
+	GetDbBackup(ctx context.Context, backupIdentity string) (*DbClusterBackup, error)
+
+	// GetDbBackupSchedule retrieves a specific DBaaS Cluster backup schedule for a database cluster.
+	GetDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string) (*DbClusterBackupSchedule, error)
+
+	// GetDbCluster retrieves a specific dbCluster by its identity.
+	GetDbCluster(ctx context.Context, dbClusterIdentity string) (*DbCluster, error)
+
+	// GetDbObjectStore returns a DB object store by identity.
+	GetDbObjectStore(ctx context.Context, identity string) (*DbObjectStore, error)
+
+	// GetUpgradableVersionsForCluster retrieves the list of upgradeable versions for a specific dbCluster.
+	GetUpgradableVersionsForCluster(ctx context.Context, dbClusterIdentity string) ([]DbClusterEngineVersion, error)
+
+	// ListDatabaseEngines lists all Database engines available for the organisation.
+	ListDatabaseEngines(ctx context.Context, listRequest *ListDatabaseEnginesRequest) (*ListDatabaseEnginesResponse, error)
+
+	// ListDatabaseInstanceTypeCategories lists all DatabaseInstanceTypeCategories for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListDatabaseInstanceTypeCategories(ctx context.Context) ([]DatabaseInstanceTypeCategory, error)
+
+	// ListDatabaseInstanceTypes lists all DatabaseInstanceTypes for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListDatabaseInstanceTypes(ctx context.Context, listRequest *ListDatabaseInstanceTypesRequest) ([]DatabaseInstanceType, error)
+
+	// ListDbBackupSchedules lists all DBaaS Cluster backup schedules for a database cluster.
+	ListDbBackupSchedules(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupSchedulesRequest) ([]DbClusterBackupSchedule, error)
+
+	// ListDbBackupSchedulesForOrganisation lists all DBaaS Cluster backup schedules for the organisation.
+	ListDbBackupSchedulesForOrganisation(ctx context.Context) ([]DbClusterBackupSchedule, error)
+
+	// ListDbBackupsForDbCluster lists all backups for a specific database cluster.
+	ListDbBackupsForDbCluster(ctx context.Context, dbClusterIdentity string, listRequest *ListDbBackupsRequest) ([]DbClusterBackup, error)
+
+	// ListDbBackupsForOrganisation lists all backups for the organisation.
+	ListDbBackupsForOrganisation(ctx context.Context, listRequest *ListDbBackupsRequest) ([]DbClusterBackup, error)
+
+	// ListDbClusters lists all dbClusters for a given organisation.
+	ListDbClusters(ctx context.Context, listRequest *ListDbClustersRequest) ([]DbCluster, error)
+
+	// ListDbGrants lists all DBaaS Cluster grants for a database cluster.
+	ListDbGrants(ctx context.Context, dbClusterIdentity string, listRequest *ListDbGrantsRequest) ([]DbClusterPostgresGrant, error)
+
+	// ListDbObjectStores lists all DB object stores for the organisation.
+	ListDbObjectStores(ctx context.Context, listRequest *ListDbObjectStoresRequest) ([]DbObjectStore, error)
+
+	// ListEngineVersions lists all engine versions for a given organisation.
+	ListEngineVersions(ctx context.Context, engine DbClusterDatabaseEngine, listRequest *ListEngineVersionsRequest) ([]DbClusterEngineVersion, error)
+
+	// PostgreSQL Database Operations
+	ListPgDatabases(ctx context.Context, dbClusterIdentity string, listRequest *ListPgDatabasesRequest) ([]DbClusterPostgresDatabase, error)
+
+	// ListPgRoles lists all PostgreSQL roles for a database cluster.
+	ListPgRoles(ctx context.Context, dbClusterIdentity string, listRequest *ListPgRolesRequest) ([]DbClusterPostgresRole, error)
+
+	// UpdateDbBackupSchedule updates an existing DBaaS Cluster backup schedule for a database cluster.
+	UpdateDbBackupSchedule(ctx context.Context, dbClusterIdentity string, backupScheduleIdentity string, update UpdateDbBackupScheduleRequest) (*DbClusterBackupSchedule, error)
+
+	// UpdateDbCluster updates an existing dbCluster.
+	UpdateDbCluster(ctx context.Context, dbClusterIdentity string, update UpdateDbClusterRequest) (*DbCluster, error)
+
+	// UpdateDbObjectStore updates an existing DB object store.
+	UpdateDbObjectStore(ctx context.Context, identity string, update UpdateDbObjectStoreRequest) (*DbObjectStore, error)
+
+	// UpdatePgDatabase updates an existing PostgreSQL database in a database cluster.
+	UpdatePgDatabase(ctx context.Context, dbClusterIdentity string, postgresDatabaseIdentity string, update UpdatePgDatabaseRequest) (*DbClusterPostgresDatabase, error)
+
+	// UpdatePgGrant updates an existing PostgreSQL grant for a role on a database in a database cluster.
+	UpdatePgGrant(ctx context.Context, dbClusterIdentity string, grantIdentity string, update UpdatePgGrantRequest) (*DbClusterPostgresGrant, error)
+
+	// UpdatePgRole updates an existing PostgreSQL role in a database cluster.
+	UpdatePgRole(ctx context.Context, dbClusterIdentity string, postgresRoleIdentity string, update UpdatePgRoleRequest) (*DbClusterPostgresRole, error)
+
+	// WaitUntilDbBackupDeleted waits until the backup is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbBackupDeleted(ctx context.Context, backupIdentity string, opts ...wait.Option) error
+
+	// WaitUntilDbBackupReady waits until the backup is ready.
+	// It returns an error if the backup failed or is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbBackupReady(ctx context.Context, backupIdentity string, opts ...wait.Option) (*DbClusterBackup, error)
+
+	// WaitUntilDbClusterDeleted waits until the dbCluster is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbClusterDeleted(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) error
+
+	// WaitUntilDbClusterIsStatus waits until the dbCluster is in a specific status.
+	// It returns an error if the dbCluster failed or is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbClusterIsStatus(ctx context.Context, dbClusterIdentity string, status DbClusterStatus, opts ...wait.Option) (*DbCluster, error)
+
+	// WaitUntilDbClusterReady waits until the dbCluster is ready.
+	// It returns an error if the dbCluster failed or is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbClusterReady(ctx context.Context, dbClusterIdentity string, opts ...wait.Option) (*DbCluster, error)
+
+	// WaitUntilDbObjectStoreDeleted waits until the object store is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbObjectStoreDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilDbObjectStoreReady waits until the object store is ready.
+	// It returns an error if the object store failed or is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilDbObjectStoreReady(ctx context.Context, identity string, opts ...wait.Option) (*DbObjectStore, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -2,6 +2,7 @@ package dns
 
 import (
 	"context"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -28,6 +29,13 @@ func (c *Client) ListRecords(ctx context.Context, zoneIdentity string, req *List
 	return records, nil
 }
 
+// AllRecords returns an iterator over the results of ListRecords.
+func (c *Client) AllRecords(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) iter.Seq2[DnsRecord, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DnsRecord, error) {
+		return c.ListRecords(ctx, zoneIdentity, req)
+	})
+}
+
 // CreateRecord creates a DNS record in a zone.
 func (c *Client) CreateRecord(ctx context.Context, zoneIdentity string, create CreateDnsRecordRequest) (*DnsRecord, error) {
 	var record DnsRecord
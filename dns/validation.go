@@ -0,0 +1,74 @@
+package dns
+
+import (
+	"net/netip"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+var recordTypes = []DnsRecordType{
+	DnsRecordTypeTXT,
+	DnsRecordTypeA,
+	DnsRecordTypeCNAME,
+	DnsRecordTypeCAA,
+	DnsRecordTypeAAAA,
+	DnsRecordTypeMX,
+	DnsRecordTypeNS,
+	DnsRecordTypeSRV,
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDnsZoneRequest) Validate() error {
+	var f validate.Fields
+	f.Required("zoneName", r.ZoneName)
+	f.Hostname("zoneName", r.ZoneName)
+	return f.Err()
+}
+
+// Validate implements client.Validator. All fields of the request are optional.
+func (r UpdateDnsZoneRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateDnsRecordRequest) Validate() error {
+	var f validate.Fields
+	f.Required("type", string(r.Type))
+	validate.OneOf(&f, "type", r.Type, recordTypes...)
+	validateRecord(&f, r.Type, r.TTL, r.Values)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent. The record type cannot
+// be changed, so values are only checked to be present.
+func (r UpdateDnsRecordRequest) Validate() error {
+	var f validate.Fields
+	validateRecord(&f, "", r.TTL, r.Values)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r SetDnssecRequest) Validate() error {
+	var f validate.Fields
+	f.Required("region", r.Region)
+	return f.Err()
+}
+
+func validateRecord(f *validate.Fields, recordType DnsRecordType, ttl int, values []string) {
+	f.Check(ttl >= 0, "ttl", "must not be negative")
+	f.Check(len(values) > 0, "values", "at least one value is required")
+	for i, value := range values {
+		field := validate.Index("values", i)
+		f.Required(field, value)
+		switch recordType {
+		case DnsRecordTypeA:
+			addr, err := netip.ParseAddr(value)
+			f.Check(err == nil && addr.Is4(), field, "must be an IPv4 address")
+		case DnsRecordTypeAAAA:
+			addr, err := netip.ParseAddr(value)
+			f.Check(err == nil && addr.Is6() && !addr.Is4In6(), field, "must be an IPv6 address")
+		case DnsRecordTypeCNAME, DnsRecordTypeNS:
+			f.Hostname(field, value)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid zone",
+			req:  CreateDnsZoneRequest{ZoneName: "example.com"},
+		},
+		{
+			name: "invalid zone name",
+			req:  CreateDnsZoneRequest{ZoneName: "example..com"},
+			want: []string{"zoneName"},
+		},
+		{
+			name: "valid records",
+			req:  CreateDnsRecordRequest{Name: "www", Type: DnsRecordTypeA, TTL: 300, Values: []string{"192.0.2.1", "192.0.2.2"}},
+		},
+		{
+			name: "values must match the record type",
+			req:  CreateDnsRecordRequest{Name: "www", Type: DnsRecordTypeAAAA, Values: []string{"192.0.2.1", "2001:db8::1"}},
+			want: []string{"values[0]"},
+		},
+		{
+			name: "cname target",
+			req:  CreateDnsRecordRequest{Name: "www", Type: DnsRecordTypeCNAME, Values: []string{"web host"}},
+			want: []string{"values[0]"},
+		},
+		{
+			name: "missing type and values",
+			req:  CreateDnsRecordRequest{Name: "www", TTL: -1},
+			want: []string{"type", "ttl", "values"},
+		},
+		{
+			name: "update values",
+			req:  UpdateDnsRecordRequest{Values: []string{""}},
+			want: []string{"values[0]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package dns
 
 import (
 	"context"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -28,6 +29,13 @@ func (c *Client) ListZones(ctx context.Context, req *ListZonesRequest) ([]DnsZon
 	return zones, nil
 }
 
+// AllZones returns an iterator over the results of ListZones.
+func (c *Client) AllZones(ctx context.Context, req *ListZonesRequest) iter.Seq2[DnsZone, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]DnsZone, error) {
+		return c.ListZones(ctx, req)
+	})
+}
+
 // CreateZone creates a DNS zone.
 func (c *Client) CreateZone(ctx context.Context, create CreateDnsZoneRequest) (*DnsZone, error) {
 	var zone DnsZone
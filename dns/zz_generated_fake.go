@@ -0,0 +1,239 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package dns
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllRecordsFunc, if set, handles calls to AllRecords.
+	AllRecordsFunc func(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) iter.Seq2[DnsRecord, error]
+	// AllZonesFunc, if set, handles calls to AllZones.
+	AllZonesFunc func(ctx context.Context, req *ListZonesRequest) iter.Seq2[DnsZone, error]
+	// CreateRecordFunc, if set, handles calls to CreateRecord.
+	CreateRecordFunc func(ctx context.Context, zoneIdentity string, create CreateDnsRecordRequest) (*DnsRecord, error)
+	// CreateZoneFunc, if set, handles calls to CreateZone.
+	CreateZoneFunc func(ctx context.Context, create CreateDnsZoneRequest) (*DnsZone, error)
+	// DeleteDnssecFunc, if set, handles calls to DeleteDnssec.
+	DeleteDnssecFunc func(ctx context.Context, zoneIdentity string) error
+	// DeleteRecordFunc, if set, handles calls to DeleteRecord.
+	DeleteRecordFunc func(ctx context.Context, zoneIdentity string, recordIdentity string) error
+	// DeleteZoneFunc, if set, handles calls to DeleteZone.
+	DeleteZoneFunc func(ctx context.Context, zoneIdentity string) error
+	// ExportZoneFileFunc, if set, handles calls to ExportZoneFile.
+	ExportZoneFileFunc func(ctx context.Context, zoneIdentity string) (*ExportDnsZoneFileResponse, error)
+	// GetDnssecFunc, if set, handles calls to GetDnssec.
+	GetDnssecFunc func(ctx context.Context, zoneIdentity string) (*DnsZoneDnssecStatus, error)
+	// GetRecordFunc, if set, handles calls to GetRecord.
+	GetRecordFunc func(ctx context.Context, zoneIdentity string, recordIdentity string) (*DnsRecord, error)
+	// GetZoneFunc, if set, handles calls to GetZone.
+	GetZoneFunc func(ctx context.Context, zoneIdentity string) (*DnsZone, error)
+	// ImportZoneFileFunc, if set, handles calls to ImportZoneFile.
+	ImportZoneFileFunc func(ctx context.Context, zoneIdentity string, importReq ImportDnsZoneFileRequest) (*ImportDnsZoneFileResponse, error)
+	// ListRecordsFunc, if set, handles calls to ListRecords.
+	ListRecordsFunc func(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) ([]DnsRecord, error)
+	// ListZonesFunc, if set, handles calls to ListZones.
+	ListZonesFunc func(ctx context.Context, req *ListZonesRequest) ([]DnsZone, error)
+	// SetDnssecFunc, if set, handles calls to SetDnssec.
+	SetDnssecFunc func(ctx context.Context, zoneIdentity string, set SetDnssecRequest) (*DnsZoneDnssecStatus, error)
+	// UpdateRecordFunc, if set, handles calls to UpdateRecord.
+	UpdateRecordFunc func(ctx context.Context, zoneIdentity string, recordIdentity string, update UpdateDnsRecordRequest) (*DnsRecord, error)
+	// UpdateZoneFunc, if set, handles calls to UpdateZone.
+	UpdateZoneFunc func(ctx context.Context, zoneIdentity string, update UpdateDnsZoneRequest) (*DnsZone, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllRecords records the call and invokes AllRecordsFunc if set.
+func (f *Fake) AllRecords(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) (r0 iter.Seq2[DnsRecord, error]) {
+	f.Record("AllRecords", ctx, zoneIdentity, req)
+	if f.AllRecordsFunc != nil {
+		return f.AllRecordsFunc(ctx, zoneIdentity, req)
+	}
+	r0 = func(yield func(DnsRecord, error) bool) {
+		if f.Err != nil {
+			var zero DnsRecord
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllZones records the call and invokes AllZonesFunc if set.
+func (f *Fake) AllZones(ctx context.Context, req *ListZonesRequest) (r0 iter.Seq2[DnsZone, error]) {
+	f.Record("AllZones", ctx, req)
+	if f.AllZonesFunc != nil {
+		return f.AllZonesFunc(ctx, req)
+	}
+	r0 = func(yield func(DnsZone, error) bool) {
+		if f.Err != nil {
+			var zero DnsZone
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateRecord records the call and invokes CreateRecordFunc if set.
+func (f *Fake) CreateRecord(ctx context.Context, zoneIdentity string, create CreateDnsRecordRequest) (r0 *DnsRecord, r1 error) {
+	f.Record("CreateRecord", ctx, zoneIdentity, create)
+	if f.CreateRecordFunc != nil {
+		return f.CreateRecordFunc(ctx, zoneIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateZone records the call and invokes CreateZoneFunc if set.
+func (f *Fake) CreateZone(ctx context.Context, create CreateDnsZoneRequest) (r0 *DnsZone, r1 error) {
+	f.Record("CreateZone", ctx, create)
+	if f.CreateZoneFunc != nil {
+		return f.CreateZoneFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteDnssec records the call and invokes DeleteDnssecFunc if set.
+func (f *Fake) DeleteDnssec(ctx context.Context, zoneIdentity string) (r0 error) {
+	f.Record("DeleteDnssec", ctx, zoneIdentity)
+	if f.DeleteDnssecFunc != nil {
+		return f.DeleteDnssecFunc(ctx, zoneIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteRecord records the call and invokes DeleteRecordFunc if set.
+func (f *Fake) DeleteRecord(ctx context.Context, zoneIdentity string, recordIdentity string) (r0 error) {
+	f.Record("DeleteRecord", ctx, zoneIdentity, recordIdentity)
+	if f.DeleteRecordFunc != nil {
+		return f.DeleteRecordFunc(ctx, zoneIdentity, recordIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteZone records the call and invokes DeleteZoneFunc if set.
+func (f *Fake) DeleteZone(ctx context.Context, zoneIdentity string) (r0 error) {
+	f.Record("DeleteZone", ctx, zoneIdentity)
+	if f.DeleteZoneFunc != nil {
+		return f.DeleteZoneFunc(ctx, zoneIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// ExportZoneFile records the call and invokes ExportZoneFileFunc if set.
+func (f *Fake) ExportZoneFile(ctx context.Context, zoneIdentity string) (r0 *ExportDnsZoneFileResponse, r1 error) {
+	f.Record("ExportZoneFile", ctx, zoneIdentity)
+	if f.ExportZoneFileFunc != nil {
+		return f.ExportZoneFileFunc(ctx, zoneIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetDnssec records the call and invokes GetDnssecFunc if set.
+func (f *Fake) GetDnssec(ctx context.Context, zoneIdentity string) (r0 *DnsZoneDnssecStatus, r1 error) {
+	f.Record("GetDnssec", ctx, zoneIdentity)
+	if f.GetDnssecFunc != nil {
+		return f.GetDnssecFunc(ctx, zoneIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetRecord records the call and invokes GetRecordFunc if set.
+func (f *Fake) GetRecord(ctx context.Context, zoneIdentity string, recordIdentity string) (r0 *DnsRecord, r1 error) {
+	f.Record("GetRecord", ctx, zoneIdentity, recordIdentity)
+	if f.GetRecordFunc != nil {
+		return f.GetRecordFunc(ctx, zoneIdentity, recordIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetZone records the call and invokes GetZoneFunc if set.
+func (f *Fake) GetZone(ctx context.Context, zoneIdentity string) (r0 *DnsZone, r1 error) {
+	f.Record("GetZone", ctx, zoneIdentity)
+	if f.GetZoneFunc != nil {
+		return f.GetZoneFunc(ctx, zoneIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ImportZoneFile records the call and invokes ImportZoneFileFunc if set.
+func (f *Fake) ImportZoneFile(ctx context.Context, zoneIdentity string, importReq ImportDnsZoneFileRequest) (r0 *ImportDnsZoneFileResponse, r1 error) {
+	f.Record("ImportZoneFile", ctx, zoneIdentity, importReq)
+	if f.ImportZoneFileFunc != nil {
+		return f.ImportZoneFileFunc(ctx, zoneIdentity, importReq)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListRecords records the call and invokes ListRecordsFunc if set.
+func (f *Fake) ListRecords(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) (r0 []DnsRecord, r1 error) {
+	f.Record("ListRecords", ctx, zoneIdentity, req)
+	if f.ListRecordsFunc != nil {
+		return f.ListRecordsFunc(ctx, zoneIdentity, req)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListZones records the call and invokes ListZonesFunc if set.
+func (f *Fake) ListZones(ctx context.Context, req *ListZonesRequest) (r0 []DnsZone, r1 error) {
+	f.Record("ListZones", ctx, req)
+	if f.ListZonesFunc != nil {
+		return f.ListZonesFunc(ctx, req)
+	}
+	r1 = f.Err
+	return
+}
+
+// SetDnssec records the call and invokes SetDnssecFunc if set.
+func (f *Fake) SetDnssec(ctx context.Context, zoneIdentity string, set SetDnssecRequest) (r0 *DnsZoneDnssecStatus, r1 error) {
+	f.Record("SetDnssec", ctx, zoneIdentity, set)
+	if f.SetDnssecFunc != nil {
+		return f.SetDnssecFunc(ctx, zoneIdentity, set)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateRecord records the call and invokes UpdateRecordFunc if set.
+func (f *Fake) UpdateRecord(ctx context.Context, zoneIdentity string, recordIdentity string, update UpdateDnsRecordRequest) (r0 *DnsRecord, r1 error) {
+	f.Record("UpdateRecord", ctx, zoneIdentity, recordIdentity, update)
+	if f.UpdateRecordFunc != nil {
+		return f.UpdateRecordFunc(ctx, zoneIdentity, recordIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateZone records the call and invokes UpdateZoneFunc if set.
+func (f *Fake) UpdateZone(ctx context.Context, zoneIdentity string, update UpdateDnsZoneRequest) (r0 *DnsZone, r1 error) {
+	f.Record("UpdateZone", ctx, zoneIdentity, update)
+	if f.UpdateZoneFunc != nil {
+		return f.UpdateZoneFunc(ctx, zoneIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,65 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package dns
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllRecords returns an iterator over the results of ListRecords.
+	AllRecords(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) iter.Seq2[DnsRecord, error]
+
+	// AllZones returns an iterator over the results of ListZones.
+	AllZones(ctx context.Context, req *ListZonesRequest) iter.Seq2[DnsZone, error]
+
+	// CreateRecord creates a DNS record in a zone.
+	CreateRecord(ctx context.Context, zoneIdentity string, create CreateDnsRecordRequest) (*DnsRecord, error)
+
+	// CreateZone creates a DNS zone.
+	CreateZone(ctx context.Context, create CreateDnsZoneRequest) (*DnsZone, error)
+
+	// DeleteDnssec disables DNSSEC signing for a zone.
+	DeleteDnssec(ctx context.Context, zoneIdentity string) error
+
+	// DeleteRecord deletes a DNS record.
+	DeleteRecord(ctx context.Context, zoneIdentity string, recordIdentity string) error
+
+	// DeleteZone deletes a DNS zone and all of its records.
+	DeleteZone(ctx context.Context, zoneIdentity string) error
+
+	// ExportZoneFile exports a zone as BIND-format text.
+	ExportZoneFile(ctx context.Context, zoneIdentity string) (*ExportDnsZoneFileResponse, error)
+
+	// GetDnssec returns DNSSEC status for a zone.
+	GetDnssec(ctx context.Context, zoneIdentity string) (*DnsZoneDnssecStatus, error)
+
+	// GetRecord retrieves a DNS record by identity.
+	GetRecord(ctx context.Context, zoneIdentity string, recordIdentity string) (*DnsRecord, error)
+
+	// GetZone retrieves a DNS zone by identity.
+	GetZone(ctx context.Context, zoneIdentity string) (*DnsZone, error)
+
+	// ImportZoneFile imports records from BIND-format zone file text.
+	ImportZoneFile(ctx context.Context, zoneIdentity string, importReq ImportDnsZoneFileRequest) (*ImportDnsZoneFileResponse, error)
+
+	// ListRecords lists DNS records in a zone.
+	ListRecords(ctx context.Context, zoneIdentity string, req *ListRecordsRequest) ([]DnsRecord, error)
+
+	// ListZones lists DNS zones for the organisation or project scope.
+	ListZones(ctx context.Context, req *ListZonesRequest) ([]DnsZone, error)
+
+	// SetDnssec enables or updates DNSSEC signing for a zone.
+	SetDnssec(ctx context.Context, zoneIdentity string, set SetDnssecRequest) (*DnsZoneDnssecStatus, error)
+
+	// UpdateRecord updates a DNS record TTL and values.
+	UpdateRecord(ctx context.Context, zoneIdentity string, recordIdentity string, update UpdateDnsRecordRequest) (*DnsRecord, error)
+
+	// UpdateZone updates zone metadata.
+	UpdateZone(ctx context.Context, zoneIdentity string, update UpdateDnsZoneRequest) (*DnsZone, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -0,0 +1,23 @@
+package iaas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestNewReturnsOptionErrors(t *testing.T) {
+	c, err := client.NewClient(client.WithBaseURL("https://api.thalassa.invalid"), client.WithAuthNone())
+	require.NoError(t, err)
+
+	svc, err := New(c, client.WithHTTPClient(nil))
+	assert.Error(t, err)
+	assert.Nil(t, svc)
+
+	svc, err = New(c, client.WithTimeout(0))
+	require.NoError(t, err)
+	assert.NotNil(t, svc)
+}
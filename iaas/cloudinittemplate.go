@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -48,6 +49,13 @@ func (c *Client) ListCloudInitTemplates(ctx context.Context) ([]CloudInitTemplat
 	return cloudInitTemplates, nil
 }
 
+// AllCloudInitTemplates returns an iterator over the results of ListCloudInitTemplates.
+func (c *Client) AllCloudInitTemplates(ctx context.Context) iter.Seq2[CloudInitTemplate, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]CloudInitTemplate, error) {
+		return c.ListCloudInitTemplates(ctx)
+	})
+}
+
 // GetCloudInitTemplate retrieves a specific cloud-init template by its unique identity.
 // The identity is a UUID that uniquely identifies the template in the system.
 func (c *Client) GetCloudInitTemplate(ctx context.Context, identity string) (*CloudInitTemplate, error) {
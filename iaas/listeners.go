@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -40,6 +41,13 @@ func (c *Client) ListListeners(ctx context.Context, listRequest *ListLoadbalance
 	return listeners, nil
 }
 
+// AllListeners returns an iterator over the results of ListListeners.
+func (c *Client) AllListeners(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) iter.Seq2[VpcLoadbalancerListener, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcLoadbalancerListener, error) {
+		return c.ListListeners(ctx, listRequest)
+	})
+}
+
 // GetListener retrieves a specific loadbalancer listener by its identity.
 func (c *Client) GetListener(ctx context.Context, getRequest GetLoadbalancerListenerRequest) (*VpcLoadbalancerListener, error) {
 	if getRequest.Loadbalancer == "" {
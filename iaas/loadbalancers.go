@@ -2,13 +2,12 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -39,6 +38,13 @@ func (c *Client) ListLoadbalancers(ctx context.Context, listRequest *ListLoadbal
 	return loadbalancers, nil
 }
 
+// AllLoadbalancers returns an iterator over the results of ListLoadbalancers.
+func (c *Client) AllLoadbalancers(ctx context.Context, listRequest *ListLoadbalancersRequest) iter.Seq2[VpcLoadbalancer, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcLoadbalancer, error) {
+		return c.ListLoadbalancers(ctx, listRequest)
+	})
+}
+
 // GetLoadbalancer retrieves a specific loadbalancer by its identity.
 func (c *Client) GetLoadbalancer(ctx context.Context, loadbalancerIdentity string) (*VpcLoadbalancer, error) {
 	if loadbalancerIdentity == "" {
@@ -120,73 +126,37 @@ func (c *Client) DeleteLoadbalancer(ctx context.Context, loadbalancerIdentity st
 
 // WaitUntilLoadbalancerIsReady waits until a loadbalancer is ready.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilLoadbalancerIsReady(ctx context.Context, loadbalancerIdentity string) error {
-	return c.WaitUntilLoadbalancerIsStatus(ctx, loadbalancerIdentity, "ready")
+func (c *Client) WaitUntilLoadbalancerIsReady(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilLoadbalancerIsStatus(ctx, loadbalancerIdentity, "ready", opts...)
 }
 
 // WaitUntilLoadbalancerIsStatus waits until a loadbalancer is in a specific status.
+// It returns an error if the loadbalancer fails instead.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilLoadbalancerIsStatus(ctx context.Context, loadbalancerIdentity string, status string) error {
-	loadbalancer, err := c.GetLoadbalancer(ctx, loadbalancerIdentity)
-	if err != nil {
-		return err
-	}
-	if strings.EqualFold(loadbalancer.Status, status) {
-		return nil
-	}
-	// wait until the loadbalancer is in the desired status
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-		}
-
-		loadbalancer, err = c.GetLoadbalancer(ctx, loadbalancerIdentity)
-		if err != nil {
-			return err
-		}
-		if strings.EqualFold(loadbalancer.Status, status) {
-			return nil
-		}
-	}
+func (c *Client) WaitUntilLoadbalancerIsStatus(ctx context.Context, loadbalancerIdentity string, status string, opts ...wait.Option) error {
+	_, err := wait.UntilStatus("loadbalancer "+loadbalancerIdentity, c.loadbalancerGetter(loadbalancerIdentity), loadbalancerStatus,
+		status, "failed").Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
 // WaitUntilLoadbalancerIsDeleted waits until a loadbalancer is deleted.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilLoadbalancerIsDeleted(ctx context.Context, loadbalancerIdentity string) error {
-	loadbalancer, err := c.GetLoadbalancer(ctx, loadbalancerIdentity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if strings.EqualFold(loadbalancer.Status, "deleted") {
-		return nil
-	}
-	if !strings.EqualFold(loadbalancer.Status, "deleting") {
-		return fmt.Errorf("loadbalancer %s is not being deleted (status: %s)", loadbalancerIdentity, loadbalancer.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			loadbalancer, err := c.GetLoadbalancer(ctx, loadbalancerIdentity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if strings.EqualFold(loadbalancer.Status, "deleted") {
-				return nil
-			}
-		}
+func (c *Client) WaitUntilLoadbalancerIsDeleted(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("loadbalancer "+loadbalancerIdentity, c.loadbalancerGetter(loadbalancerIdentity), loadbalancerStatus,
+		"deleting", "deleted").Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) loadbalancerGetter(identity string) func(context.Context) (*VpcLoadbalancer, error) {
+	return func(ctx context.Context) (*VpcLoadbalancer, error) {
+		return c.GetLoadbalancer(ctx, identity)
 	}
 }
 
+func loadbalancerStatus(loadbalancer *VpcLoadbalancer) string {
+	return loadbalancer.Status
+}
+
 type ListLoadbalancersRequest struct {
 	Filters []filters.Filter
 }
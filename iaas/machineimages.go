@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -41,6 +42,13 @@ func (c *Client) ListMachineImages(ctx context.Context, listRequest *ListMachine
 	return machineImages, nil
 }
 
+// AllMachineImages returns an iterator over the results of ListMachineImages.
+func (c *Client) AllMachineImages(ctx context.Context, listRequest *ListMachineImagesRequest) iter.Seq2[MachineImage, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]MachineImage, error) {
+		return c.ListMachineImages(ctx, listRequest)
+	})
+}
+
 // GetMachineImage retrieves a specific MachineImage by its identity.
 // The identity is the unique identifier for the MachineImage.
 func (c *Client) GetMachineImage(ctx context.Context, identity string) (*MachineImage, error) {
@@ -2,8 +2,8 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"iter"
 	"strings"
 	"time"
 
@@ -11,6 +11,7 @@ import (
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/base"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -40,6 +41,13 @@ func (c *Client) ListMachines(ctx context.Context, listRequest *ListMachinesRequ
 	return subnets, nil
 }
 
+// AllMachines returns an iterator over the results of ListMachines.
+func (c *Client) AllMachines(ctx context.Context, listRequest *ListMachinesRequest) iter.Seq2[Machine, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Machine, error) {
+		return c.ListMachines(ctx, listRequest)
+	})
+}
+
 // GetMachine retrieves a specific Machine by its identity.
 func (c *Client) GetMachine(ctx context.Context, identity string) (*Machine, error) {
 	var machine *Machine
@@ -143,15 +151,24 @@ func (c *Client) MachineRestart(ctx context.Context, identity string) error {
 // console
 // This creates a new console for the machine and returns a websocket connection to the console.
 func (c *Client) MachineConsole(ctx context.Context, identity string) (*websocket.Conn, error) {
+	// Get the websocket connection directly from the console endpoint
+	return c.DialWebsocket(ctx, c.machineConsoleURL(identity))
+}
+
+// MachineConsoleSession opens a console for the machine as a websocket session, which keeps
+// the connection alive and can reconnect when it drops. Use NetConn on the session to treat
+// the console as a stream.
+func (c *Client) MachineConsoleSession(ctx context.Context, identity string, opts client.WebsocketOptions) (*client.WebsocketSession, error) {
+	return c.DialWebsocketSession(ctx, c.machineConsoleURL(identity), opts)
+}
+
+func (c *Client) machineConsoleURL(identity string) string {
 	// The API endpoint for the console
 	consoleEndpoint := fmt.Sprintf("%s/%s/console", MachineEndpoint, identity)
 	endpoint := c.GetBaseURL() + consoleEndpoint
 	// convert to websocket
 	endpoint = strings.Replace(endpoint, "http://", "ws://", 1)
-	endpoint = strings.Replace(endpoint, "https://", "wss://", 1)
-
-	// Get the websocket connection directly from the console endpoint
-	return c.DialWebsocket(ctx, endpoint)
+	return strings.Replace(endpoint, "https://", "wss://", 1)
 }
 
 // WaitUntilMachineDeleted waits until the machine is deleted.
@@ -164,42 +181,47 @@ func (c *Client) MachineConsole(ctx context.Context, identity string) (*websocke
 //		log.Fatalf("Failed to wait for machine to be deleted: %v", err)
 //	}
 //	defer cancel()
-func (c *Client) WaitUntilMachineDeleted(ctx context.Context, identity string) error {
-	machine, err := c.GetMachine(ctx, identity)
+func (c *Client) WaitUntilMachineDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("machine "+identity, c.machineGetter(identity), machineState,
+		string(MachineStateDeleting), string(MachineStateDeleted)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+// WaitUntilMachineIsRunning waits until the machine is running.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilMachineIsRunning(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error) {
+	return c.WaitUntilMachineIsState(ctx, identity, MachineStateRunning, opts...)
+}
+
+// WaitUntilMachineIsStopped waits until the machine is stopped.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilMachineIsStopped(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error) {
+	return c.WaitUntilMachineIsState(ctx, identity, MachineStateStopped, opts...)
+}
+
+// WaitUntilMachineIsState waits until the machine is in a specific state.
+// It returns an error if the machine is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilMachineIsState(ctx context.Context, identity string, state MachineState, opts ...wait.Option) (*Machine, error) {
+	w := wait.UntilStatus("machine "+identity, c.machineGetter(identity), machineState, string(state),
+		string(MachineStateDeleting), string(MachineStateDeleted))
+	machine, err := w.Wait(ctx, waitOptions(opts)...)
 	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if machine.State == MachineStateDeleted {
-		return nil
-	}
-	if machine.State != MachineStateDeleting {
-		return fmt.Errorf("machine %s is not being deleted", identity)
+		return nil, err
 	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			machine, err := c.GetMachine(ctx, identity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if machine.State == MachineStateDeleted {
-				return nil
-			}
-			if machine.State != MachineStateDeleting {
-				return fmt.Errorf("machine %s is not being deleted", identity)
-			}
-		}
+	return machine, nil
+}
+
+func (c *Client) machineGetter(identity string) func(context.Context) (*Machine, error) {
+	return func(ctx context.Context) (*Machine, error) {
+		return c.GetMachine(ctx, identity)
 	}
 }
 
+func machineState(machine *Machine) string {
+	return string(machine.State)
+}
+
 type ListMachinesRequest struct {
 	Filters []filters.Filter
 }
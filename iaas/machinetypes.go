@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -41,6 +42,13 @@ func (c *Client) ListMachineTypes(ctx context.Context, listRequest *ListMachineT
 	return machineTypes, nil
 }
 
+// AllMachineTypes returns an iterator over the results of ListMachineTypes.
+func (c *Client) AllMachineTypes(ctx context.Context, listRequest *ListMachineTypesRequest) iter.Seq2[MachineType, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]MachineType, error) {
+		return c.ListMachineTypes(ctx, listRequest)
+	})
+}
+
 // GetMachineType retrieves a specific MachineType by its identity.
 // The identity is the unique identifier for the MachineType.
 func (c *Client) GetMachineType(ctx context.Context, identity string) (*MachineType, error) {
@@ -73,3 +81,10 @@ func (c *Client) ListMachineTypeCategories(ctx context.Context) ([]MachineTypeCa
 
 	return machineTypeCategories, nil
 }
+
+// AllMachineTypeCategories returns an iterator over the results of ListMachineTypeCategories.
+func (c *Client) AllMachineTypeCategories(ctx context.Context) iter.Seq2[MachineTypeCategory, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]MachineTypeCategory, error) {
+		return c.ListMachineTypeCategories(ctx)
+	})
+}
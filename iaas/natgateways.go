@@ -2,12 +2,12 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -42,6 +42,13 @@ func (c *Client) ListNatGateways(ctx context.Context, listRequest *ListNatGatewa
 	return subnets, nil
 }
 
+// AllNatGateways returns an iterator over the results of ListNatGateways.
+func (c *Client) AllNatGateways(ctx context.Context, listRequest *ListNatGatewaysRequest) iter.Seq2[VpcNatGateway, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcNatGateway, error) {
+		return c.ListNatGateways(ctx, listRequest)
+	})
+}
+
 // GetNatGateway retrieves a specific NatGateway by its identity.
 func (c *Client) GetNatGateway(ctx context.Context, identity string) (*VpcNatGateway, error) {
 	var subnet *VpcNatGateway
@@ -112,28 +119,28 @@ func (c *Client) DeleteNatGateway(ctx context.Context, identity string) error {
 //		log.Fatalf("Failed to wait for nat gateway to have an endpoint: %v", err)
 //	}
 //	defer cancel()
-func (c *Client) WaitUntilNatGatewayHasEndpoint(ctx context.Context, identity string) (*VpcNatGateway, error) {
-	natGateway, err := c.GetNatGateway(ctx, identity)
+func (c *Client) WaitUntilNatGatewayHasEndpoint(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error) {
+	w := wait.UntilStatus("nat gateway "+identity, c.natGatewayGetter(identity), natGatewayStatus, "", "failed", "deleting", "deleted")
+	w.Ready = func(natGateway *VpcNatGateway) bool {
+		return natGateway.EndpointIP != ""
+	}
+	natGateway, err := w.Wait(ctx, waitOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
-	if natGateway.EndpointIP != "" {
-		return natGateway, nil
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			natGateway, err := c.GetNatGateway(ctx, identity)
-			if err != nil {
-				return nil, err
-			}
-			if natGateway.EndpointIP != "" {
-				return natGateway, nil
-			}
-		}
+	return natGateway, nil
+}
+
+// WaitUntilNatGatewayReady waits until the nat gateway is ready.
+// It returns the nat gateway when it is ready or an error if the nat gateway fails, or is being deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilNatGatewayReady(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error) {
+	w := wait.UntilStatus("nat gateway "+identity, c.natGatewayGetter(identity), natGatewayStatus, "ready", "failed", "deleting", "deleted")
+	natGateway, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
 	}
+	return natGateway, nil
 }
 
 // WaitUntilNatGatewayDeleted waits until the nat gateway is deleted.
@@ -146,38 +153,18 @@ func (c *Client) WaitUntilNatGatewayHasEndpoint(ctx context.Context, identity st
 //		log.Fatalf("Failed to wait for nat gateway to be deleted: %v", err)
 //	}
 //	defer cancel()
-func (c *Client) WaitUntilNatGatewayDeleted(ctx context.Context, identity string) error {
-	natGateway, err := c.GetNatGateway(ctx, identity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if natGateway.Status == "deleted" {
-		return nil
-	}
-	if natGateway.Status != "deleting" {
-		return fmt.Errorf("nat gateway %s is not being deleted", identity)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			natGateway, err := c.GetNatGateway(ctx, identity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if natGateway.Status == "deleted" {
-				return nil
-			}
-			if natGateway.Status != "deleting" {
-				return fmt.Errorf("nat gateway %s is not being deleted", identity)
-			}
-		}
+func (c *Client) WaitUntilNatGatewayDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("nat gateway "+identity, c.natGatewayGetter(identity), natGatewayStatus, "deleting", "deleted").
+		Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) natGatewayGetter(identity string) func(context.Context) (*VpcNatGateway, error) {
+	return func(ctx context.Context) (*VpcNatGateway, error) {
+		return c.GetNatGateway(ctx, identity)
 	}
 }
+
+func natGatewayStatus(natGateway *VpcNatGateway) string {
+	return natGateway.Status
+}
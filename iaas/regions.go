@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -40,6 +41,13 @@ func (c *Client) ListRegions(ctx context.Context, listRequest *ListRegionsReques
 	return vpcs, nil
 }
 
+// AllRegions returns an iterator over the results of ListRegions.
+func (c *Client) AllRegions(ctx context.Context, listRequest *ListRegionsRequest) iter.Seq2[Region, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Region, error) {
+		return c.ListRegions(ctx, listRequest)
+	})
+}
+
 // GetRegion retrieves a specific Region by its identity.
 func (c *Client) GetRegion(ctx context.Context, identity string) (*Region, error) {
 	var vpc *Region
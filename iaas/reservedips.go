@@ -3,9 +3,11 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const ReservedIPEndpoint = "/v1/reserved-ips"
@@ -31,6 +33,13 @@ func (c *Client) ListReservedIPs(ctx context.Context, listRequest *ListReservedI
 	return out, nil
 }
 
+// AllReservedIPs returns an iterator over the results of ListReservedIPs.
+func (c *Client) AllReservedIPs(ctx context.Context, listRequest *ListReservedIPsRequest) iter.Seq2[ReservedIP, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]ReservedIP, error) {
+		return c.ListReservedIPs(ctx, listRequest)
+	})
+}
+
 // CreateReservedIP creates a reserved IP (201).
 func (c *Client) CreateReservedIP(ctx context.Context, create CreateReservedIpRequest) (*ReservedIP, error) {
 	if create.Name == "" {
@@ -100,6 +109,36 @@ func (c *Client) DeleteReservedIP(ctx context.Context, identity string) error {
 	return c.Check(resp)
 }
 
+// WaitUntilReservedIPIsStatus waits until a reserved IP is in a specific status.
+// It returns an error if the reserved IP fails instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilReservedIPIsStatus(ctx context.Context, identity string, status ReservedIpStatus, opts ...wait.Option) (*ReservedIP, error) {
+	w := wait.UntilStatus("reserved IP "+identity, c.reservedIPGetter(identity), reservedIPStatus, string(status), string(ReservedIpStatusFailed))
+	fip, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return fip, nil
+}
+
+// WaitUntilReservedIPIsDeleted waits until a reserved IP is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilReservedIPIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("reserved IP "+identity, c.reservedIPGetter(identity), reservedIPStatus,
+		string(ReservedIpStatusDeleting), string(ReservedIpStatusDeleted), string(ReservedIpStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) reservedIPGetter(identity string) func(context.Context) (*ReservedIP, error) {
+	return func(ctx context.Context) (*ReservedIP, error) {
+		return c.GetReservedIP(ctx, identity)
+	}
+}
+
+func reservedIPStatus(fip *ReservedIP) string {
+	return string(fip.Status)
+}
+
 // AssociateReservedIP attaches the reserved IP to a load balancer or NAT gateway.
 func (c *Client) AssociateReservedIP(ctx context.Context, identity string, body AssociateReservedIpRequest) (*ReservedIP, error) {
 	if identity == "" {
@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -40,6 +41,13 @@ func (c *Client) ListRouteTables(ctx context.Context, listRequest *ListRouteTabl
 	return routeTables, nil
 }
 
+// AllRouteTables returns an iterator over the results of ListRouteTables.
+func (c *Client) AllRouteTables(ctx context.Context, listRequest *ListRouteTablesRequest) iter.Seq2[RouteTable, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]RouteTable, error) {
+		return c.ListRouteTables(ctx, listRequest)
+	})
+}
+
 // GetRouteTable retrieves a specific RouteTable by its identity.
 func (c *Client) GetRouteTable(ctx context.Context, identity string) (*RouteTable, error) {
 	var routeTable *RouteTable
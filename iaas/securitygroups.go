@@ -3,11 +3,13 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/base"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -177,6 +179,13 @@ func (c *Client) ListSecurityGroups(ctx context.Context, listRequest *ListSecuri
 	return securityGroups, nil
 }
 
+// AllSecurityGroups returns an iterator over the results of ListSecurityGroups.
+func (c *Client) AllSecurityGroups(ctx context.Context, listRequest *ListSecurityGroupsRequest) iter.Seq2[SecurityGroup, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]SecurityGroup, error) {
+		return c.ListSecurityGroups(ctx, listRequest)
+	})
+}
+
 // GetSecurityGroup retrieves a specific security group by its identity.
 func (c *Client) GetSecurityGroup(ctx context.Context, identity string) (*SecurityGroup, error) {
 	var securityGroup *SecurityGroup
@@ -237,6 +246,40 @@ func (c *Client) DeleteSecurityGroup(ctx context.Context, identity string) error
 	return nil
 }
 
+// WaitUntilSecurityGroupIsReady waits until a security group is ready.
+// It returns an error if the security group enters the error status instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilSecurityGroupIsReady(ctx context.Context, identity string, opts ...wait.Option) (*SecurityGroup, error) {
+	w := wait.UntilStatus("security group "+identity, c.securityGroupGetter(identity), securityGroupStatus,
+		string(SecurityGroupStatusReady), string(SecurityGroupStatusError), string(SecurityGroupStatusDeleting))
+	w.Ready = func(securityGroup *SecurityGroup) bool {
+		return securityGroup.Status == SecurityGroupStatusReady || securityGroup.Status == SecurityGroupStatusActive
+	}
+	securityGroup, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return securityGroup, nil
+}
+
+// WaitUntilSecurityGroupIsDeleted waits until a security group is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilSecurityGroupIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("security group "+identity, c.securityGroupGetter(identity), securityGroupStatus,
+		string(SecurityGroupStatusDeleting), "deleted", string(SecurityGroupStatusError)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) securityGroupGetter(identity string) func(context.Context) (*SecurityGroup, error) {
+	return func(ctx context.Context) (*SecurityGroup, error) {
+		return c.GetSecurityGroup(ctx, identity)
+	}
+}
+
+func securityGroupStatus(securityGroup *SecurityGroup) string {
+	return string(securityGroup.Status)
+}
+
 // BatchUpdateSecurityGroupEgressRules updates the egress rules for a specific security group.
 func (c *Client) BatchUpdateSecurityGroupEgressRules(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) ([]SecurityGroupRule, error) {
 	rules := []SecurityGroupRule{}
@@ -2,13 +2,14 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/base"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -46,6 +47,13 @@ func (c *Client) ListSnapshots(ctx context.Context, listRequest *ListSnapshotsRe
 	return snapshots, nil
 }
 
+// AllSnapshots returns an iterator over the results of ListSnapshots.
+func (c *Client) AllSnapshots(ctx context.Context, listRequest *ListSnapshotsRequest) iter.Seq2[Snapshot, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Snapshot, error) {
+		return c.ListSnapshots(ctx, listRequest)
+	})
+}
+
 // GetSnapshot retrieves a specific snapshot by its identity.
 // The identity is the unique identifier for the snapshot.
 func (c *Client) GetSnapshot(ctx context.Context, identity string) (*Snapshot, error) {
@@ -116,73 +124,37 @@ func (c *Client) DeleteSnapshot(ctx context.Context, identity string) error {
 
 // WaitUntilSnapshotIsAvailable waits until a snapshot is available.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilSnapshotIsAvailable(ctx context.Context, snapshotIdentity string) error {
-	return c.WaitUntilSnapshotIsStatus(ctx, snapshotIdentity, SnapshotStatusAvailable)
+func (c *Client) WaitUntilSnapshotIsAvailable(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilSnapshotIsStatus(ctx, snapshotIdentity, SnapshotStatusAvailable, opts...)
 }
 
 // WaitUntilSnapshotIsStatus waits until a snapshot is in a specific status.
+// It returns an error if the snapshot fails instead.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilSnapshotIsStatus(ctx context.Context, snapshotIdentity string, status SnapshotStatus) error {
-	snapshot, err := c.GetSnapshot(ctx, snapshotIdentity)
-	if err != nil {
-		return err
-	}
-	if snapshot.Status == status {
-		return nil
-	}
-	// wait until the snapshot is in the desired status
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-		}
-
-		snapshot, err = c.GetSnapshot(ctx, snapshotIdentity)
-		if err != nil {
-			return err
-		}
-		if snapshot.Status == status {
-			return nil
-		}
-	}
+func (c *Client) WaitUntilSnapshotIsStatus(ctx context.Context, snapshotIdentity string, status SnapshotStatus, opts ...wait.Option) error {
+	_, err := wait.UntilStatus("snapshot "+snapshotIdentity, c.snapshotGetter(snapshotIdentity), snapshotStatus,
+		string(status), string(SnapshotStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
 // WaitUntilSnapshotIsDeleted waits until a snapshot is deleted.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilSnapshotIsDeleted(ctx context.Context, snapshotIdentity string) error {
-	snapshot, err := c.GetSnapshot(ctx, snapshotIdentity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if snapshot.Status == SnapshotStatusDeleted {
-		return nil
-	}
-	if snapshot.Status != SnapshotStatusDeleting {
-		return fmt.Errorf("snapshot %s is not being deleted (status: %s)", snapshotIdentity, snapshot.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			snapshot, err := c.GetSnapshot(ctx, snapshotIdentity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if snapshot.Status == SnapshotStatusDeleted {
-				return nil
-			}
-		}
+func (c *Client) WaitUntilSnapshotIsDeleted(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("snapshot "+snapshotIdentity, c.snapshotGetter(snapshotIdentity), snapshotStatus,
+		string(SnapshotStatusDeleting), string(SnapshotStatusDeleted), string(SnapshotStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) snapshotGetter(identity string) func(context.Context) (*Snapshot, error) {
+	return func(ctx context.Context) (*Snapshot, error) {
+		return c.GetSnapshot(ctx, identity)
 	}
 }
 
+func snapshotStatus(snapshot *Snapshot) string {
+	return string(snapshot.Status)
+}
+
 type ListSnapshotPoliciesRequest struct {
 	Filters []filters.Filter
 }
@@ -213,6 +185,13 @@ func (c *Client) ListSnapshotPolicies(ctx context.Context, listRequest *ListSnap
 	return policies, nil
 }
 
+// AllSnapshotPolicies returns an iterator over the results of ListSnapshotPolicies.
+func (c *Client) AllSnapshotPolicies(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) iter.Seq2[SnapshotPolicy, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]SnapshotPolicy, error) {
+		return c.ListSnapshotPolicies(ctx, listRequest)
+	})
+}
+
 // GetSnapshotPolicy retrieves a specific snapshot policy by its identity.
 // The identity is the unique identifier for the snapshot policy.
 func (c *Client) GetSnapshotPolicy(ctx context.Context, identity string) (*SnapshotPolicy, error) {
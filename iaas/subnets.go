@@ -2,12 +2,12 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -42,6 +42,13 @@ func (c *Client) ListSubnets(ctx context.Context, listRequest *ListSubnetsReques
 	return subnets, nil
 }
 
+// AllSubnets returns an iterator over the results of ListSubnets.
+func (c *Client) AllSubnets(ctx context.Context, listRequest *ListSubnetsRequest) iter.Seq2[Subnet, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Subnet, error) {
+		return c.ListSubnets(ctx, listRequest)
+	})
+}
+
 // GetSubnet retrieves a specific Subnet by its identity.
 // It returns an error if the subnet is not found.
 // Example: subnet, err := c.GetSubnet(ctx, "subnet-identity1234")
@@ -72,40 +79,10 @@ func (c *Client) GetSubnet(ctx context.Context, identity string) (*Subnet, error
 //		log.Fatalf("Failed to wait for subnet to be deleted: %v", err)
 //	}
 //	defer cancel()
-func (c *Client) WaitUntilSubnetDeleted(ctx context.Context, identity string) error {
-	subnet, err := c.GetSubnet(ctx, identity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if subnet.Status == SubnetStatusDeleted {
-		return nil
-	}
-	if subnet.Status != SubnetStatusDeleting {
-		return fmt.Errorf("subnet %s is not being deleted (status: %s)", identity, subnet.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			subnet, err := c.GetSubnet(ctx, identity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			switch subnet.Status {
-			case SubnetStatusFailed:
-				return fmt.Errorf("subnet %s failed to delete (status: %s)", identity, subnet.Status)
-			case SubnetStatusDeleted:
-				return nil
-			}
-		}
-	}
+func (c *Client) WaitUntilSubnetDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("subnet "+identity, c.subnetGetter(identity), subnetStatus,
+		string(SubnetStatusDeleting), string(SubnetStatusDeleted), string(SubnetStatusFailed)).Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
 // WaitUntilSubnetReady waits until the subnet is ready.
@@ -118,37 +95,26 @@ func (c *Client) WaitUntilSubnetDeleted(ctx context.Context, identity string) er
 //		log.Fatalf("Failed to wait for subnet to become ready: %v", err)
 //	}
 //	defer cancel()
-func (c *Client) WaitUntilSubnetReady(ctx context.Context, identity string) (*Subnet, error) {
-	subnet, err := c.GetSubnet(ctx, identity)
+func (c *Client) WaitUntilSubnetReady(ctx context.Context, identity string, opts ...wait.Option) (*Subnet, error) {
+	w := wait.UntilStatus("subnet "+identity, c.subnetGetter(identity), subnetStatus, string(SubnetStatusReady),
+		string(SubnetStatusFailed), string(SubnetStatusDeleting), string(SubnetStatusDeleted))
+	subnet, err := w.Wait(ctx, waitOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
-	if subnet.Status == SubnetStatusReady {
-		return subnet, nil
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			subnet, err := c.GetSubnet(ctx, identity)
-			if err != nil {
-				return nil, err
-			}
-			switch subnet.Status {
-			case SubnetStatusReady:
-				return subnet, nil
-			case SubnetStatusFailed:
-				return nil, fmt.Errorf("subnet %s failed to become ready", identity)
-			case SubnetStatusDeleting:
-				return nil, fmt.Errorf("subnet %s is being deleted", identity)
-			case SubnetStatusDeleted:
-				return nil, fmt.Errorf("subnet %s is deleted", identity)
-			}
-		}
+	return subnet, nil
+}
+
+func (c *Client) subnetGetter(identity string) func(context.Context) (*Subnet, error) {
+	return func(ctx context.Context) (*Subnet, error) {
+		return c.GetSubnet(ctx, identity)
 	}
 }
 
+func subnetStatus(subnet *Subnet) string {
+	return string(subnet.Status)
+}
+
 // CreateSubnet creates a new Subnet.
 func (c *Client) CreateSubnet(ctx context.Context, create CreateSubnet) (*Subnet, error) {
 	var subnet *Subnet
@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -38,6 +39,13 @@ func (c *Client) ListTargetGroups(ctx context.Context, listRequest *ListTargetGr
 	return targetGroups, nil
 }
 
+// AllTargetGroups returns an iterator over the results of ListTargetGroups.
+func (c *Client) AllTargetGroups(ctx context.Context, listRequest *ListTargetGroupsRequest) iter.Seq2[VpcLoadbalancerTargetGroup, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcLoadbalancerTargetGroup, error) {
+		return c.ListTargetGroups(ctx, listRequest)
+	})
+}
+
 // GetTargetGroup retrieves a specific loadbalancer target group by its identity.
 func (c *Client) GetTargetGroup(ctx context.Context, getRequest GetTargetGroupRequest) (*VpcLoadbalancerTargetGroup, error) {
 	if getRequest.Identity == "" {
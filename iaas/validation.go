@@ -0,0 +1,503 @@
+package iaas
+
+import (
+	"strings"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+var loadbalancerProtocols = []LoadbalancerProtocol{ProtocolTCP, ProtocolUDP, ProtocolHTTP, ProtocolHTTPS, ProtocolGRPC, ProtocolQUIC}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateCloudInitTemplateRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("content", r.Content)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateCloudInitTemplateRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("content", r.Content)
+	return f.Err()
+}
+
+// Validate checks the fields of the listener before it is sent.
+func (r CreateListener) Validate() error {
+	var f validate.Fields
+	validateListener(&f, r.Port, r.Protocol, r.AllowedSources)
+	return f.Err()
+}
+
+// Validate checks the fields of the listener before it is sent.
+func (r UpdateListener) Validate() error {
+	var f validate.Fields
+	validateListener(&f, r.Port, r.Protocol, r.AllowedSources)
+	return f.Err()
+}
+
+func validateListener(f *validate.Fields, port int, protocol LoadbalancerProtocol, allowedSources []string) {
+	validate.Range(f, "port", port, 1, 65535)
+	f.Required("protocol", string(protocol))
+	validate.OneOf(f, "protocol", protocol, loadbalancerProtocols...)
+	for i, source := range allowedSources {
+		f.IPOrCIDR(validate.Index("allowedSources", i), source)
+	}
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateLoadbalancer) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("subnet", r.Subnet)
+	for i, listener := range r.Listeners {
+		f.Nested(validate.Index("listeners", i), listener.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateLoadbalancer) Validate() error {
+	var f validate.Fields
+	if r.Subnet != nil {
+		f.Required("subnet", *r.Subnet)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the rule before it is sent.
+func (r SecurityGroupRule) Validate() error {
+	var f validate.Fields
+	validate.OneOf(&f, "ipVersion", r.IPVersion, SecurityGroupIPVersionIPv4, SecurityGroupIPVersionIPv6)
+	f.Required("protocol", string(r.Protocol))
+	validate.OneOf(&f, "protocol", r.Protocol, SecurityGroupRuleProtocolAll, SecurityGroupRuleProtocolTCP, SecurityGroupRuleProtocolUDP, SecurityGroupRuleProtocolICMP)
+	validate.Range(&f, "priority", r.Priority, 1, 199)
+	validate.OneOf(&f, "policy", r.Policy, SecurityGroupRulePolicyAllow, SecurityGroupRulePolicyDrop)
+	validate.OneOf(&f, "remoteType", r.RemoteType, SecurityGroupRuleRemoteTypeAddress, SecurityGroupRuleRemoteTypeSecurityGroup)
+	switch r.RemoteType {
+	case SecurityGroupRuleRemoteTypeAddress:
+		f.Check(r.RemoteAddress != nil && *r.RemoteAddress != "", "remoteAddress", "is required for remote type address")
+	case SecurityGroupRuleRemoteTypeSecurityGroup:
+		f.Check(r.RemoteSecurityGroupIdentity != nil && *r.RemoteSecurityGroupIdentity != "", "remoteSecurityGroupIdentity", "is required for remote type securityGroup")
+	}
+	if r.RemoteAddress != nil {
+		f.IPOrCIDR("remoteAddress", *r.RemoteAddress)
+	}
+	if r.Protocol == SecurityGroupRuleProtocolTCP || r.Protocol == SecurityGroupRuleProtocolUDP {
+		validate.Range(&f, "portRangeMin", r.PortRangeMin, 1, 65535)
+		validate.Range(&f, "portRangeMax", r.PortRangeMax, 1, 65535)
+		f.Check(r.PortRangeMin <= r.PortRangeMax, "portRangeMax", "must not be less than portRangeMin")
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateSecurityGroupRequest) Validate() error {
+	var f validate.Fields
+	validateSecurityGroupName(&f, r.Name)
+	f.Required("vpcIdentity", r.VpcIdentity)
+	validateSecurityGroupRules(&f, "ingressRules", r.IngressRules)
+	validateSecurityGroupRules(&f, "egressRules", r.EgressRules)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateSecurityGroupRequest) Validate() error {
+	var f validate.Fields
+	validateSecurityGroupName(&f, r.Name)
+	if !r.SkipRulesUpdate {
+		validateSecurityGroupRules(&f, "ingressRules", r.IngressRules)
+		validateSecurityGroupRules(&f, "egressRules", r.EgressRules)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r BatchUpdateSecurityGroupRulesRequest) Validate() error {
+	var f validate.Fields
+	validateSecurityGroupRules(&f, "rules", r.Rules)
+	return f.Err()
+}
+
+func validateSecurityGroupName(f *validate.Fields, name string) {
+	f.Required("name", name)
+	f.MaxLength("name", name, 16)
+	f.ASCII("name", name)
+}
+
+func validateSecurityGroupRules(f *validate.Fields, field string, rules []SecurityGroupRule) {
+	for i, rule := range rules {
+		f.Nested(validate.Index(field, i), rule.Validate())
+	}
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateSnapshotRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("volumeIdentity", r.VolumeIdentity)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateSnapshotRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	return f.Err()
+}
+
+// Validate checks the fields of the target before it is sent.
+func (t SnapshotPolicyTarget) Validate() error {
+	var f validate.Fields
+	f.Required("type", string(t.Type))
+	validate.OneOf(&f, "type", t.Type, SnapshotPolicyTargetTypeSelector, SnapshotPolicyTargetTypeExplicit)
+	switch t.Type {
+	case SnapshotPolicyTargetTypeSelector:
+		f.Check(len(t.Selector) > 0, "selector", "is required for target type selector")
+	case SnapshotPolicyTargetTypeExplicit:
+		f.Check(len(t.VolumeIdentities) > 0, "volumeIdentities", "is required for target type explicit")
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateSnapshotPolicyRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("region", r.Region)
+	validateSnapshotPolicy(&f, r.Schedule, r.Ttl.Nanoseconds(), r.KeepCount, r.Target)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateSnapshotPolicyRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	validateSnapshotPolicy(&f, r.Schedule, r.Ttl.Nanoseconds(), r.KeepCount, r.Target)
+	return f.Err()
+}
+
+func validateSnapshotPolicy(f *validate.Fields, schedule string, ttl int64, keepCount *int, target SnapshotPolicyTarget) {
+	f.Required("schedule", schedule)
+	f.Check(ttl >= 0, "ttl", "must not be negative")
+	if keepCount != nil {
+		f.Check(*keepCount > 0, "keepCount", "must be positive")
+	}
+	f.Nested("target", target.Validate())
+}
+
+// Validate checks the fields of the health check before it is sent. Zero values are
+// left to the API defaults.
+func (h BackendHealthCheck) Validate() error {
+	var f validate.Fields
+	validate.OneOf(&f, "protocol", h.Protocol, loadbalancerProtocols...)
+	if h.Port != 0 {
+		validate.Range(&f, "port", h.Port, 1, 65535)
+	}
+	if h.Path != "" {
+		f.Check(strings.HasPrefix(h.Path, "/"), "path", "must start with /")
+	}
+	if h.PeriodSeconds != 0 {
+		validate.Range(&f, "periodSeconds", h.PeriodSeconds, 5, 300)
+	}
+	if h.TimeoutSeconds != 0 {
+		validate.Range(&f, "timeoutSeconds", h.TimeoutSeconds, 1, 300)
+	}
+	if h.UnhealthyThreshold != 0 {
+		validate.Range(&f, "unhealthyThreshold", h.UnhealthyThreshold, 1, 10)
+	}
+	if h.HealthyThreshold != 0 {
+		validate.Range(&f, "healthyThreshold", h.HealthyThreshold, 1, 10)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateTargetGroup) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("vpc", r.Vpc)
+	f.Required("protocol", string(r.Protocol))
+	validateTargetGroup(&f, r.TargetPort, r.Protocol, r.LoadbalancingPolicy, r.HealthCheck)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateTargetGroup) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	validateTargetGroup(&f, r.TargetPort, r.Protocol, r.LoadbalancingPolicy, r.HealthCheck)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateTargetGroupRequest) Validate() error {
+	var f validate.Fields
+	f.Required("identity", r.Identity)
+	f.Nested("", r.UpdateTargetGroup.Validate())
+	return f.Err()
+}
+
+func validateTargetGroup(f *validate.Fields, port int, protocol LoadbalancerProtocol, policy *LoadbalancingPolicy, healthCheck *BackendHealthCheck) {
+	validate.Range(f, "targetPort", port, 1, 65535)
+	validate.OneOf(f, "protocol", protocol, loadbalancerProtocols...)
+	if policy != nil {
+		validate.OneOf(f, "loadbalancingPolicy", *policy, LoadbalancingPolicyRoundRobin, LoadbalancingPolicyRandom, LoadbalancingPolicyMagLev)
+	}
+	if healthCheck != nil {
+		f.Nested("healthCheck", healthCheck.Validate())
+	}
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateReservedIpRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("region", r.Region)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateReservedIpRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateVpc) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	for i, cidr := range r.VpcCidrs {
+		f.CIDR(validate.Index("vpcCidrs", i), cidr)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateVpc) Validate() error {
+	var f validate.Fields
+	for i, cidr := range r.VpcCidrs {
+		f.CIDR(validate.Index("vpcCidrs", i), cidr)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateVolume) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	if r.RestoreFromSnapshotId == nil {
+		f.Check(r.Size > 0, "size", "must be positive")
+	} else {
+		f.Check(r.Size >= 0, "size", "must not be negative")
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateVolume) Validate() error {
+	var f validate.Fields
+	f.Check(r.Size >= 0, "size", "must not be negative")
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent. The CIDR of a dual
+// stack subnet holds an IPv4 and an IPv6 block, separated by a comma.
+func (r CreateSubnet) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("vpcIdentity", r.VpcIdentity)
+	f.Required("cidr", r.Cidr)
+	if r.Cidr != "" {
+		for _, cidr := range strings.Split(r.Cidr, ",") {
+			f.CIDR("cidr", strings.TrimSpace(cidr))
+		}
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateSubnet) Validate() error {
+	var f validate.Fields
+	if r.AssociatedRouteTableIdentity != nil {
+		f.Required("associatedRouteTableIdentity", *r.AssociatedRouteTableIdentity)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateRouteTableRoutes) Validate() error {
+	var f validate.Fields
+	for i, route := range r.Routes {
+		f.Nested(validate.Index("routes", i), route.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateRouteTableRoute) Validate() error {
+	var f validate.Fields
+	validateRoute(&f, r.DestinationCidrBlock, r.GatewayAddress)
+	return f.Err()
+}
+
+// Validate checks the fields of the route before it is sent.
+func (r UpdateRouteTableRoute) Validate() error {
+	var f validate.Fields
+	validateRoute(&f, r.DestinationCidrBlock, r.GatewayAddress)
+	return f.Err()
+}
+
+func validateRoute(f *validate.Fields, destination, gatewayAddress string) {
+	f.Required("destinationCidrBlock", destination)
+	f.CIDR("destinationCidrBlock", destination)
+	f.IP("gatewayAddress", gatewayAddress)
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateRouteTable) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("vpcIdentity", r.VpcIdentity)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateRouteTable) Validate() error {
+	var f validate.Fields
+	if r.Name != nil {
+		f.Required("name", *r.Name)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateVpcNatGateway) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("subnetIdentity", r.SubnetIdentity)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateVpcNatGateway) Validate() error {
+	var f validate.Fields
+	for i, identity := range r.SecurityGroupAttachments {
+		f.Required(validate.Index("securityGroupAttachments", i), identity)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the root volume before it is sent.
+func (v CreateMachineVolume) Validate() error {
+	var f validate.Fields
+	if v.ExistingVolumeRef != nil {
+		f.Required("existingVolumeRef", *v.ExistingVolumeRef)
+	}
+	f.Check(v.Size >= 0, "size", "must not be negative")
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateMachine) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Required("subnet", r.Subnet)
+	f.Required("machineType", r.MachineType)
+	if r.RootVolume.ExistingVolumeRef == nil {
+		f.Required("machineImage", r.MachineImage)
+	}
+	if r.State != nil {
+		validate.OneOf(&f, "state", *r.State, MachineStateRunning, MachineStateStopped)
+	}
+	f.Nested("rootVolume", r.RootVolume.Validate())
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateMachine) Validate() error {
+	var f validate.Fields
+	if r.State != nil {
+		validate.OneOf(&f, "state", *r.State, MachineStateRunning, MachineStateStopped)
+	}
+	if r.MachineType != nil {
+		f.Required("machineType", *r.MachineType)
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateVpcFirewallRuleRequest) Validate() error {
+	var f validate.Fields
+	f.Required("vpcIdentity", r.VpcIdentity)
+	if r.Priority != nil {
+		validate.Range(&f, "priority", *r.Priority, 1, 1000)
+	}
+	validateFirewallRule(&f, r.Name, r.Source, r.Destination, r.SourcePorts, r.DestinationPorts, r.Action, r.Direction, r.State)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateVpcFirewallRuleRequest) Validate() error {
+	var f validate.Fields
+	validate.Range(&f, "priority", r.Priority, 1, 1000)
+	validateFirewallRule(&f, r.Name, r.Source, r.Destination, r.SourcePorts, r.DestinationPorts, r.Action, r.Direction, r.State)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r BulkUpdateVpcFirewallRuleRequest) Validate() error {
+	var f validate.Fields
+	for i, rule := range r.FirewallRules {
+		f.Nested(validate.Index("firewallRules", i), rule.Validate())
+	}
+	return f.Err()
+}
+
+func validateFirewallRule(f *validate.Fields, name string, source, destination *string, sourcePorts, destinationPorts []int32, action FirewallRuleAction, direction VpcFirewallRuleDirection, state FirewallRuleState) {
+	f.Required("name", name)
+	f.MaxLength("name", name, 16)
+	f.ASCII("name", name)
+	if source != nil {
+		f.CIDR("source", *source)
+	}
+	if destination != nil {
+		f.CIDR("destination", *destination)
+	}
+	for i, port := range sourcePorts {
+		validate.Range(f, validate.Index("sourcePorts", i), port, 0, 65535)
+	}
+	for i, port := range destinationPorts {
+		validate.Range(f, validate.Index("destinationPorts", i), port, 0, 65535)
+	}
+	validate.OneOf(f, "action", action, FirewallRuleActionAllow, FirewallRuleActionDrop)
+	validate.OneOf(f, "direction", direction, VpcFirewallRuleDirectionInbound, VpcFirewallRuleDirectionOutbound)
+	validate.OneOf(f, "state", state, FirewallRuleStateActive, FirewallRuleStateInactive)
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateVpcPeeringConnectionRequest) Validate() error {
+	var f validate.Fields
+	validateVpcPeeringConnection(&f, r.Name, r.Description)
+	f.Required("requesterVpcIdentity", r.RequesterVpcIdentity)
+	f.Required("accepterVpcIdentity", r.AccepterVpcIdentity)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateVpcPeeringConnectionRequest) Validate() error {
+	var f validate.Fields
+	validateVpcPeeringConnection(&f, r.Name, r.Description)
+	return f.Err()
+}
+
+func validateVpcPeeringConnection(f *validate.Fields, name, description string) {
+	f.Required("name", name)
+	f.MaxLength("name", name, 63)
+	f.ASCII("name", name)
+	f.MaxLength("description", description, 500)
+	f.ASCII("description", description)
+}
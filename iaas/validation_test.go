@@ -0,0 +1,176 @@
+package iaas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	address := "10.0.0.0/8"
+	badAddress := "10.0.0.0/40"
+	sg := "sg-123"
+	policy := LoadbalancingPolicy("LEAST_CONN")
+	stopped := MachineStateStopped
+	deleted := MachineStateDeleted
+	priority := int32(1001)
+	gateway := "not-an-ip"
+
+	rule := SecurityGroupRule{
+		Name:          "https",
+		IPVersion:     SecurityGroupIPVersionIPv4,
+		Protocol:      SecurityGroupRuleProtocolTCP,
+		Priority:      100,
+		RemoteType:    SecurityGroupRuleRemoteTypeAddress,
+		RemoteAddress: &address,
+		PortRangeMin:  443,
+		PortRangeMax:  443,
+		Policy:        SecurityGroupRulePolicyAllow,
+	}
+
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid security group",
+			req:  CreateSecurityGroupRequest{Name: "web", VpcIdentity: "vpc-1", IngressRules: []SecurityGroupRule{rule}},
+		},
+		{
+			name: "security group reports every invalid field",
+			req: CreateSecurityGroupRequest{
+				Name: "a-very-long-security-group-name",
+				IngressRules: []SecurityGroupRule{
+					rule,
+					{Protocol: SecurityGroupRuleProtocolUDP, Priority: 200, RemoteType: SecurityGroupRuleRemoteTypeAddress, RemoteAddress: &badAddress, PortRangeMin: 60, PortRangeMax: 53},
+				},
+				EgressRules: []SecurityGroupRule{
+					{Protocol: "sctp", Priority: 1, RemoteType: SecurityGroupRuleRemoteTypeSecurityGroup},
+				},
+			},
+			want: []string{
+				"name",
+				"vpcIdentity",
+				"ingressRules[1].priority",
+				"ingressRules[1].remoteAddress",
+				"ingressRules[1].portRangeMax",
+				"egressRules[0].protocol",
+				"egressRules[0].remoteSecurityGroupIdentity",
+			},
+		},
+		{
+			name: "remote security group",
+			req: BatchUpdateSecurityGroupRulesRequest{Rules: []SecurityGroupRule{
+				{Protocol: SecurityGroupRuleProtocolAll, Priority: 199, RemoteType: SecurityGroupRuleRemoteTypeSecurityGroup, RemoteSecurityGroupIdentity: &sg},
+			}},
+		},
+		{
+			name: "rules are skipped with skipRulesUpdate",
+			req:  UpdateSecurityGroupRequest{Name: "web", SkipRulesUpdate: true, IngressRules: []SecurityGroupRule{{}}},
+		},
+		{
+			name: "target group health check",
+			req: CreateTargetGroup{
+				Name:                "web",
+				Vpc:                 "vpc-1",
+				TargetPort:          70000,
+				Protocol:            ProtocolHTTP,
+				LoadbalancingPolicy: &policy,
+				HealthCheck:         &BackendHealthCheck{Protocol: ProtocolHTTP, Path: "healthz", PeriodSeconds: 1, HealthyThreshold: 3},
+			},
+			want: []string{"targetPort", "loadbalancingPolicy", "healthCheck.path", "healthCheck.periodSeconds"},
+		},
+		{
+			name: "update target group by identity",
+			req:  UpdateTargetGroupRequest{UpdateTargetGroup: UpdateTargetGroup{Name: "web", TargetPort: 8080, HealthCheck: &BackendHealthCheck{PeriodSeconds: 301}}},
+			want: []string{"identity", "healthCheck.periodSeconds"},
+		},
+		{
+			name: "loadbalancer listeners",
+			req: CreateLoadbalancer{Name: "lb", Subnet: "subnet-1", Listeners: []CreateListener{
+				{Port: 443, Protocol: ProtocolHTTPS, AllowedSources: []string{"10.0.0.1", "192.168.0.0/16"}},
+				{Port: 0, Protocol: "ftp", AllowedSources: []string{"internal"}},
+			}},
+			want: []string{"listeners[1].port", "listeners[1].protocol", "listeners[1].allowedSources[0]"},
+		},
+		{
+			name: "vpc cidrs",
+			req:  CreateVpc{Name: "vpc", VpcCidrs: []string{"10.0.0.0/16", "10.0.0.0"}},
+			want: []string{"vpcCidrs[1]"},
+		},
+		{
+			name: "dual stack subnet",
+			req:  CreateSubnet{Name: "subnet", VpcIdentity: "vpc-1", Cidr: "10.0.0.0/24, fd00::/64"},
+		},
+		{
+			name: "invalid subnet",
+			req:  CreateSubnet{Cidr: "10.0.0.0/24,fd00::"},
+			want: []string{"name", "vpcIdentity", "cidr"},
+		},
+		{
+			name: "routes",
+			req: UpdateRouteTableRoutes{Routes: []UpdateRouteTableRoute{
+				{DestinationCidrBlock: "0.0.0.0/0", TargetNatGatewayIdentity: "nat-1"},
+				{GatewayAddress: gateway},
+			}},
+			want: []string{"routes[1].destinationCidrBlock", "routes[1].gatewayAddress"},
+		},
+		{
+			name: "volume restored from snapshot",
+			req:  CreateVolume{Name: "data", RestoreFromSnapshotId: &sg},
+		},
+		{
+			name: "volume size",
+			req:  CreateVolume{Name: "data"},
+			want: []string{"size"},
+		},
+		{
+			name: "machine",
+			req:  CreateMachine{Name: "vm", Subnet: "subnet-1", MachineImage: "ubuntu", MachineType: "small", State: &stopped},
+		},
+		{
+			name: "machine state",
+			req:  CreateMachine{Name: "vm", Subnet: "subnet-1", MachineType: "small", State: &deleted, RootVolume: CreateMachineVolume{Size: -1}},
+			want: []string{"machineImage", "state", "rootVolume.size"},
+		},
+		{
+			name: "firewall rule",
+			req: CreateVpcFirewallRuleRequest{
+				Name:             "allow-web",
+				VpcIdentity:      "vpc-1",
+				Source:           &badAddress,
+				DestinationPorts: []int32{80, 65536},
+				Action:           "reject",
+				Priority:         &priority,
+				Direction:        VpcFirewallRuleDirectionInbound,
+			},
+			want: []string{"priority", "source", "destinationPorts[1]", "action"},
+		},
+		{
+			name: "snapshot policy target",
+			req:  CreateSnapshotPolicyRequest{Name: "daily", Region: "nl-01", Schedule: "0 0 * * *", Target: SnapshotPolicyTarget{Type: SnapshotPolicyTargetTypeSelector}},
+			want: []string{"target.selector"},
+		},
+		{
+			name: "vpc peering connection",
+			req:  CreateVpcPeeringConnectionRequest{Name: "peering", RequesterVpcIdentity: "vpc-1"},
+			want: []string{"accepterVpcIdentity"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
@@ -2,13 +2,12 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -45,6 +44,13 @@ func (c *Client) ListVolumes(ctx context.Context, listRequest *ListVolumesReques
 	return volumes, nil
 }
 
+// AllVolumes returns an iterator over the results of ListVolumes.
+func (c *Client) AllVolumes(ctx context.Context, listRequest *ListVolumesRequest) iter.Seq2[Volume, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Volume, error) {
+		return c.ListVolumes(ctx, listRequest)
+	})
+}
+
 // GetVolume retrieves a specific volume by its identity.
 // The identity is the unique identifier for the volume.
 func (c *Client) GetVolume(ctx context.Context, identity string) (*Volume, error) {
@@ -115,12 +121,12 @@ func (c *Client) DeleteVolume(ctx context.Context, identity string) error {
 
 // AttachVolumeAndWaitUntilAttached attaches a volume to a machine and waits until it is attached.
 // The user is expected to provide a timeout context.
-func (c *Client) AttachVolumeAndWaitUntilAttached(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest) error {
+func (c *Client) AttachVolumeAndWaitUntilAttached(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest, opts ...wait.Option) error {
 	_, err := c.AttachVolume(ctx, volumeIdentity, attach)
 	if err != nil {
 		return err
 	}
-	return c.WaitUntilVolumeIsAttached(ctx, volumeIdentity)
+	return c.WaitUntilVolumeIsAttached(ctx, volumeIdentity, opts...)
 }
 
 // AttachVolume attaches a volume to a machine.
@@ -142,12 +148,12 @@ func (c *Client) AttachVolume(ctx context.Context, volumeIdentity string, attach
 
 // DetachVolumeAndWaitUntilAvailable detaches a volume from a machine and waits until it is available.
 // The user is expected to provide a timeout context.
-func (c *Client) DetachVolumeAndWaitUntilAvailable(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest) error {
+func (c *Client) DetachVolumeAndWaitUntilAvailable(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest, opts ...wait.Option) error {
 	err := c.DetachVolume(ctx, volumeIdentity, detach)
 	if err != nil {
 		return err
 	}
-	return c.WaitUntilVolumeIsAvailable(ctx, volumeIdentity)
+	return c.WaitUntilVolumeIsAvailable(ctx, volumeIdentity, opts...)
 }
 
 // DetachVolume detaches a volume from a machine.
@@ -165,71 +171,41 @@ func (c *Client) DetachVolume(ctx context.Context, volumeIdentity string, detach
 	return nil
 }
 
-func (c *Client) WaitUntilVolumeIsAttached(ctx context.Context, volumeIdentity string) error {
-	return c.WaitUntilVolumeIsStatus(ctx, volumeIdentity, "attached")
+// WaitUntilVolumeIsAttached waits until a volume is attached.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVolumeIsAttached(ctx context.Context, volumeIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilVolumeIsStatus(ctx, volumeIdentity, "attached", opts...)
 }
 
-func (c *Client) WaitUntilVolumeIsAvailable(ctx context.Context, volumeIdentity string) error {
-	return c.WaitUntilVolumeIsStatus(ctx, volumeIdentity, "available")
+// WaitUntilVolumeIsAvailable waits until a volume is available.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVolumeIsAvailable(ctx context.Context, volumeIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilVolumeIsStatus(ctx, volumeIdentity, "available", opts...)
 }
 
 // WaitUntilVolumeIsStatus waits until a volume is in a specific status.
+// It returns an error if the volume fails instead.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilVolumeIsStatus(ctx context.Context, volumeIdentity string, status string) error {
-	volume, err := c.GetVolume(ctx, volumeIdentity)
-	if err != nil {
-		return err
-	}
-	if strings.EqualFold(volume.Status, status) {
-		return nil
-	}
-	// wait until the volume is unattached
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(1 * time.Second):
-		}
+func (c *Client) WaitUntilVolumeIsStatus(ctx context.Context, volumeIdentity string, status string, opts ...wait.Option) error {
+	_, err := wait.UntilStatus("volume "+volumeIdentity, c.volumeGetter(volumeIdentity), volumeStatus, status, "failed").
+		Wait(ctx, waitOptions(opts)...)
+	return err
+}
 
-		volume, err = c.GetVolume(ctx, volumeIdentity)
-		if err != nil {
-			return err
-		}
-		if strings.EqualFold(volume.Status, status) {
-			return nil
-		}
-	}
+// WaitUntilVolumeIsDeleted waits until a volume is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVolumeIsDeleted(ctx context.Context, volumeIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("volume "+volumeIdentity, c.volumeGetter(volumeIdentity), volumeStatus, "deleting", "deleted").
+		Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
-func (c *Client) WaitUntilVolumeIsDeleted(ctx context.Context, volumeIdentity string) error {
-	volume, err := c.GetVolume(ctx, volumeIdentity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if strings.EqualFold(volume.Status, "deleted") {
-		return nil
-	}
-	if !strings.EqualFold(volume.Status, "deleting") {
-		return fmt.Errorf("volume %s is not being deleted (status: %s)", volumeIdentity, volume.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			volume, err := c.GetVolume(ctx, volumeIdentity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if strings.EqualFold(volume.Status, "deleted") {
-				return nil
-			}
-		}
+func (c *Client) volumeGetter(identity string) func(context.Context) (*Volume, error) {
+	return func(ctx context.Context) (*Volume, error) {
+		return c.GetVolume(ctx, identity)
 	}
 }
+
+func volumeStatus(volume *Volume) string {
+	return volume.Status
+}
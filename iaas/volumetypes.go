@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -41,6 +42,13 @@ func (c *Client) ListVolumeTypes(ctx context.Context, listRequest *ListVolumeTyp
 	return volumeTypes, nil
 }
 
+// AllVolumeTypes returns an iterator over the results of ListVolumeTypes.
+func (c *Client) AllVolumeTypes(ctx context.Context, listRequest *ListVolumeTypesRequest) iter.Seq2[VolumeType, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VolumeType, error) {
+		return c.ListVolumeTypes(ctx, listRequest)
+	})
+}
+
 // GetVolumeType gets a volume type by its identity.
 func (c *Client) GetVolumeType(ctx context.Context, identity string) (*VolumeType, error) {
 	var volumeType *VolumeType
@@ -3,6 +3,7 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -187,6 +188,13 @@ func (c *Client) ListVpcFirewallRule(ctx context.Context, identity string, reque
 	return firewallRules, nil
 }
 
+// AllVpcFirewallRules returns an iterator over the results of ListVpcFirewallRule.
+func (c *Client) AllVpcFirewallRules(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) iter.Seq2[VpcFirewallRule, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcFirewallRule, error) {
+		return c.ListVpcFirewallRule(ctx, identity, request)
+	})
+}
+
 // CreateVpcFirewallRule creates a new VPC firewall rule.
 func (c *Client) CreateVpcFirewallRule(ctx context.Context, identity string, create CreateVpcFirewallRuleRequest) (*VpcFirewallRule, error) {
 	var firewallRule *VpcFirewallRule
@@ -3,11 +3,13 @@ package iaas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/base"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 // VpcPeeringConnectionStatus represents the status of a VPC peering connection
@@ -174,6 +176,13 @@ func (c *Client) ListVpcPeeringConnections(ctx context.Context, request *ListVpc
 	return peeringConnections, nil
 }
 
+// AllVpcPeeringConnections returns an iterator over the results of ListVpcPeeringConnections.
+func (c *Client) AllVpcPeeringConnections(ctx context.Context, request *ListVpcPeeringConnectionsRequest) iter.Seq2[VpcPeeringConnection, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]VpcPeeringConnection, error) {
+		return c.ListVpcPeeringConnections(ctx, request)
+	})
+}
+
 // CreateVpcPeeringConnection creates a new VPC peering connection.
 func (c *Client) CreateVpcPeeringConnection(ctx context.Context, create CreateVpcPeeringConnectionRequest) (*VpcPeeringConnection, error) {
 	var peeringConnection *VpcPeeringConnection
@@ -234,6 +243,45 @@ func (c *Client) DeleteVpcPeeringConnection(ctx context.Context, identity string
 	return nil
 }
 
+// WaitUntilVpcPeeringConnectionIsActive waits until a VPC peering connection is active.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVpcPeeringConnectionIsActive(ctx context.Context, identity string, opts ...wait.Option) (*VpcPeeringConnection, error) {
+	return c.WaitUntilVpcPeeringConnectionIsStatus(ctx, identity, VpcPeeringConnectionStatusActive, opts...)
+}
+
+// WaitUntilVpcPeeringConnectionIsStatus waits until a VPC peering connection is in a specific status.
+// It returns an error if the peering connection is rejected, fails, expires or is deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVpcPeeringConnectionIsStatus(ctx context.Context, identity string, status VpcPeeringConnectionStatus, opts ...wait.Option) (*VpcPeeringConnection, error) {
+	w := wait.UntilStatus("VPC peering connection "+identity, c.vpcPeeringConnectionGetter(identity), vpcPeeringConnectionStatus, string(status),
+		string(VpcPeeringConnectionStatusRejected), string(VpcPeeringConnectionStatusFailed), string(VpcPeeringConnectionStatusExpired),
+		string(VpcPeeringConnectionStatusDeleting), string(VpcPeeringConnectionStatusDeleted))
+	peeringConnection, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return peeringConnection, nil
+}
+
+// WaitUntilVpcPeeringConnectionIsDeleted waits until a VPC peering connection is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilVpcPeeringConnectionIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("VPC peering connection "+identity, c.vpcPeeringConnectionGetter(identity), vpcPeeringConnectionStatus,
+		string(VpcPeeringConnectionStatusDeleting), string(VpcPeeringConnectionStatusDeleted), string(VpcPeeringConnectionStatusFailed)).
+		Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) vpcPeeringConnectionGetter(identity string) func(context.Context) (*VpcPeeringConnection, error) {
+	return func(ctx context.Context) (*VpcPeeringConnection, error) {
+		return c.GetVpcPeeringConnection(ctx, identity)
+	}
+}
+
+func vpcPeeringConnectionStatus(peeringConnection *VpcPeeringConnection) string {
+	return string(peeringConnection.Status)
+}
+
 // AcceptVpcPeeringConnection accepts a VPC peering connection.
 func (c *Client) AcceptVpcPeeringConnection(ctx context.Context, identity string, accept AcceptVpcPeeringConnectionRequest) (*VpcPeeringConnection, error) {
 	var peeringConnection *VpcPeeringConnection
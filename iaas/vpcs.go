@@ -2,13 +2,12 @@ package iaas
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -42,6 +41,13 @@ func (c *Client) ListVpcs(ctx context.Context, request *ListVpcsRequest) ([]Vpc,
 	return vpcs, nil
 }
 
+// AllVpcs returns an iterator over the results of ListVpcs.
+func (c *Client) AllVpcs(ctx context.Context, request *ListVpcsRequest) iter.Seq2[Vpc, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Vpc, error) {
+		return c.ListVpcs(ctx, request)
+	})
+}
+
 // GetVpc retrieves a specific VPC by its identity.
 func (c *Client) GetVpc(ctx context.Context, identity string) (*Vpc, error) {
 	var vpc *Vpc
@@ -104,69 +110,33 @@ func (c *Client) DeleteVpc(ctx context.Context, identity string) error {
 
 // WaitUntilVpcIsReady waits until a VPC is ready.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilVpcIsReady(ctx context.Context, vpcIdentity string) error {
-	return c.WaitUntilVpcIsStatus(ctx, vpcIdentity, "ready")
+func (c *Client) WaitUntilVpcIsReady(ctx context.Context, vpcIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilVpcIsStatus(ctx, vpcIdentity, "ready", opts...)
 }
 
 // WaitUntilVpcIsStatus waits until a VPC is in a specific status.
+// It returns an error if the VPC fails instead.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilVpcIsStatus(ctx context.Context, vpcIdentity string, status string) error {
-	vpc, err := c.GetVpc(ctx, vpcIdentity)
-	if err != nil {
-		return err
-	}
-	if strings.EqualFold(vpc.Status, status) {
-		return nil
-	}
-	// wait until the VPC is in the desired status
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-		}
-
-		vpc, err = c.GetVpc(ctx, vpcIdentity)
-		if err != nil {
-			return err
-		}
-		if strings.EqualFold(vpc.Status, status) {
-			return nil
-		}
-	}
+func (c *Client) WaitUntilVpcIsStatus(ctx context.Context, vpcIdentity string, status string, opts ...wait.Option) error {
+	_, err := wait.UntilStatus("VPC "+vpcIdentity, c.vpcGetter(vpcIdentity), vpcStatus, status, "failed").
+		Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
 // WaitUntilVpcIsDeleted waits until a VPC is deleted.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilVpcIsDeleted(ctx context.Context, vpcIdentity string) error {
-	vpc, err := c.GetVpc(ctx, vpcIdentity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if strings.EqualFold(vpc.Status, "deleted") {
-		return nil
-	}
-	if !strings.EqualFold(vpc.Status, "deleting") {
-		return fmt.Errorf("VPC %s is not being deleted (status: %s)", vpcIdentity, vpc.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			vpc, err := c.GetVpc(ctx, vpcIdentity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if strings.EqualFold(vpc.Status, "deleted") {
-				return nil
-			}
-		}
+func (c *Client) WaitUntilVpcIsDeleted(ctx context.Context, vpcIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("VPC "+vpcIdentity, c.vpcGetter(vpcIdentity), vpcStatus, "deleting", "deleted").
+		Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) vpcGetter(identity string) func(context.Context) (*Vpc, error) {
+	return func(ctx context.Context) (*Vpc, error) {
+		return c.GetVpc(ctx, identity)
 	}
 }
+
+func vpcStatus(vpc *Vpc) string {
+	return vpc.Status
+}
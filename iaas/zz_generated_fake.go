@@ -0,0 +1,2089 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package iaas
+
+import (
+	"context"
+	"iter"
+
+	"github.com/gorilla/websocket"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AcceptVpcPeeringConnectionFunc, if set, handles calls to AcceptVpcPeeringConnection.
+	AcceptVpcPeeringConnectionFunc func(ctx context.Context, identity string, accept AcceptVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+	// AllCloudInitTemplatesFunc, if set, handles calls to AllCloudInitTemplates.
+	AllCloudInitTemplatesFunc func(ctx context.Context) iter.Seq2[CloudInitTemplate, error]
+	// AllListenersFunc, if set, handles calls to AllListeners.
+	AllListenersFunc func(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) iter.Seq2[VpcLoadbalancerListener, error]
+	// AllLoadbalancersFunc, if set, handles calls to AllLoadbalancers.
+	AllLoadbalancersFunc func(ctx context.Context, listRequest *ListLoadbalancersRequest) iter.Seq2[VpcLoadbalancer, error]
+	// AllMachineImagesFunc, if set, handles calls to AllMachineImages.
+	AllMachineImagesFunc func(ctx context.Context, listRequest *ListMachineImagesRequest) iter.Seq2[MachineImage, error]
+	// AllMachineTypeCategoriesFunc, if set, handles calls to AllMachineTypeCategories.
+	AllMachineTypeCategoriesFunc func(ctx context.Context) iter.Seq2[MachineTypeCategory, error]
+	// AllMachineTypesFunc, if set, handles calls to AllMachineTypes.
+	AllMachineTypesFunc func(ctx context.Context, listRequest *ListMachineTypesRequest) iter.Seq2[MachineType, error]
+	// AllMachinesFunc, if set, handles calls to AllMachines.
+	AllMachinesFunc func(ctx context.Context, listRequest *ListMachinesRequest) iter.Seq2[Machine, error]
+	// AllNatGatewaysFunc, if set, handles calls to AllNatGateways.
+	AllNatGatewaysFunc func(ctx context.Context, listRequest *ListNatGatewaysRequest) iter.Seq2[VpcNatGateway, error]
+	// AllRegionsFunc, if set, handles calls to AllRegions.
+	AllRegionsFunc func(ctx context.Context, listRequest *ListRegionsRequest) iter.Seq2[Region, error]
+	// AllReservedIPsFunc, if set, handles calls to AllReservedIPs.
+	AllReservedIPsFunc func(ctx context.Context, listRequest *ListReservedIPsRequest) iter.Seq2[ReservedIP, error]
+	// AllRouteTablesFunc, if set, handles calls to AllRouteTables.
+	AllRouteTablesFunc func(ctx context.Context, listRequest *ListRouteTablesRequest) iter.Seq2[RouteTable, error]
+	// AllSecurityGroupsFunc, if set, handles calls to AllSecurityGroups.
+	AllSecurityGroupsFunc func(ctx context.Context, listRequest *ListSecurityGroupsRequest) iter.Seq2[SecurityGroup, error]
+	// AllSnapshotPoliciesFunc, if set, handles calls to AllSnapshotPolicies.
+	AllSnapshotPoliciesFunc func(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) iter.Seq2[SnapshotPolicy, error]
+	// AllSnapshotsFunc, if set, handles calls to AllSnapshots.
+	AllSnapshotsFunc func(ctx context.Context, listRequest *ListSnapshotsRequest) iter.Seq2[Snapshot, error]
+	// AllSubnetsFunc, if set, handles calls to AllSubnets.
+	AllSubnetsFunc func(ctx context.Context, listRequest *ListSubnetsRequest) iter.Seq2[Subnet, error]
+	// AllTargetGroupsFunc, if set, handles calls to AllTargetGroups.
+	AllTargetGroupsFunc func(ctx context.Context, listRequest *ListTargetGroupsRequest) iter.Seq2[VpcLoadbalancerTargetGroup, error]
+	// AllVolumeTypesFunc, if set, handles calls to AllVolumeTypes.
+	AllVolumeTypesFunc func(ctx context.Context, listRequest *ListVolumeTypesRequest) iter.Seq2[VolumeType, error]
+	// AllVolumesFunc, if set, handles calls to AllVolumes.
+	AllVolumesFunc func(ctx context.Context, listRequest *ListVolumesRequest) iter.Seq2[Volume, error]
+	// AllVpcFirewallRulesFunc, if set, handles calls to AllVpcFirewallRules.
+	AllVpcFirewallRulesFunc func(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) iter.Seq2[VpcFirewallRule, error]
+	// AllVpcPeeringConnectionsFunc, if set, handles calls to AllVpcPeeringConnections.
+	AllVpcPeeringConnectionsFunc func(ctx context.Context, request *ListVpcPeeringConnectionsRequest) iter.Seq2[VpcPeeringConnection, error]
+	// AllVpcsFunc, if set, handles calls to AllVpcs.
+	AllVpcsFunc func(ctx context.Context, request *ListVpcsRequest) iter.Seq2[Vpc, error]
+	// AssociateReservedIPFunc, if set, handles calls to AssociateReservedIP.
+	AssociateReservedIPFunc func(ctx context.Context, identity string, body AssociateReservedIpRequest) (*ReservedIP, error)
+	// AttachServerToTargetGroupFunc, if set, handles calls to AttachServerToTargetGroup.
+	AttachServerToTargetGroupFunc func(ctx context.Context, attachRequest AttachTargetGroupRequest) (*LoadbalancerTargetGroupAttachment, error)
+	// AttachVolumeFunc, if set, handles calls to AttachVolume.
+	AttachVolumeFunc func(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest) (*VolumeAttachment, error)
+	// AttachVolumeAndWaitUntilAttachedFunc, if set, handles calls to AttachVolumeAndWaitUntilAttached.
+	AttachVolumeAndWaitUntilAttachedFunc func(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest, opts ...wait.Option) error
+	// BatchUpdateSecurityGroupEgressRulesFunc, if set, handles calls to BatchUpdateSecurityGroupEgressRules.
+	BatchUpdateSecurityGroupEgressRulesFunc func(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) ([]SecurityGroupRule, error)
+	// BatchUpdateSecurityGroupIngressRulesFunc, if set, handles calls to BatchUpdateSecurityGroupIngressRules.
+	BatchUpdateSecurityGroupIngressRulesFunc func(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) ([]SecurityGroupRule, error)
+	// BulkUpdateVpcFirewallRuleFunc, if set, handles calls to BulkUpdateVpcFirewallRule.
+	BulkUpdateVpcFirewallRuleFunc func(ctx context.Context, identity string, update BulkUpdateVpcFirewallRuleRequest) ([]VpcFirewallRule, error)
+	// CreateCloudInitTemplateFunc, if set, handles calls to CreateCloudInitTemplate.
+	CreateCloudInitTemplateFunc func(ctx context.Context, create CreateCloudInitTemplateRequest) (*CloudInitTemplate, error)
+	// CreateListenerFunc, if set, handles calls to CreateListener.
+	CreateListenerFunc func(ctx context.Context, loadbalancerID string, create CreateListener) (*VpcLoadbalancerListener, error)
+	// CreateLoadbalancerFunc, if set, handles calls to CreateLoadbalancer.
+	CreateLoadbalancerFunc func(ctx context.Context, create CreateLoadbalancer) (*VpcLoadbalancer, error)
+	// CreateMachineFunc, if set, handles calls to CreateMachine.
+	CreateMachineFunc func(ctx context.Context, create CreateMachine) (*Machine, error)
+	// CreateNatGatewayFunc, if set, handles calls to CreateNatGateway.
+	CreateNatGatewayFunc func(ctx context.Context, create CreateVpcNatGateway) (*VpcNatGateway, error)
+	// CreateReservedIPFunc, if set, handles calls to CreateReservedIP.
+	CreateReservedIPFunc func(ctx context.Context, create CreateReservedIpRequest) (*ReservedIP, error)
+	// CreateRouteTableFunc, if set, handles calls to CreateRouteTable.
+	CreateRouteTableFunc func(ctx context.Context, create CreateRouteTable) (*RouteTable, error)
+	// CreateRouteTableRouteFunc, if set, handles calls to CreateRouteTableRoute.
+	CreateRouteTableRouteFunc func(ctx context.Context, identity string, create CreateRouteTableRoute) (*RouteEntry, error)
+	// CreateSecurityGroupFunc, if set, handles calls to CreateSecurityGroup.
+	CreateSecurityGroupFunc func(ctx context.Context, create CreateSecurityGroupRequest) (*SecurityGroup, error)
+	// CreateSnapshotFunc, if set, handles calls to CreateSnapshot.
+	CreateSnapshotFunc func(ctx context.Context, create CreateSnapshotRequest) (*Snapshot, error)
+	// CreateSnapshotPolicyFunc, if set, handles calls to CreateSnapshotPolicy.
+	CreateSnapshotPolicyFunc func(ctx context.Context, create CreateSnapshotPolicyRequest) (*SnapshotPolicy, error)
+	// CreateSubnetFunc, if set, handles calls to CreateSubnet.
+	CreateSubnetFunc func(ctx context.Context, create CreateSubnet) (*Subnet, error)
+	// CreateTargetGroupFunc, if set, handles calls to CreateTargetGroup.
+	CreateTargetGroupFunc func(ctx context.Context, create CreateTargetGroup) (*VpcLoadbalancerTargetGroup, error)
+	// CreateVolumeFunc, if set, handles calls to CreateVolume.
+	CreateVolumeFunc func(ctx context.Context, create CreateVolume) (*Volume, error)
+	// CreateVpcFunc, if set, handles calls to CreateVpc.
+	CreateVpcFunc func(ctx context.Context, create CreateVpc) (*Vpc, error)
+	// CreateVpcFirewallRuleFunc, if set, handles calls to CreateVpcFirewallRule.
+	CreateVpcFirewallRuleFunc func(ctx context.Context, identity string, create CreateVpcFirewallRuleRequest) (*VpcFirewallRule, error)
+	// CreateVpcPeeringConnectionFunc, if set, handles calls to CreateVpcPeeringConnection.
+	CreateVpcPeeringConnectionFunc func(ctx context.Context, create CreateVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+	// DeleteCloudInitTemplateFunc, if set, handles calls to DeleteCloudInitTemplate.
+	DeleteCloudInitTemplateFunc func(ctx context.Context, identity string) error
+	// DeleteListenerFunc, if set, handles calls to DeleteListener.
+	DeleteListenerFunc func(ctx context.Context, loadbalancerID string, listenerID string) error
+	// DeleteLoadbalancerFunc, if set, handles calls to DeleteLoadbalancer.
+	DeleteLoadbalancerFunc func(ctx context.Context, loadbalancerIdentity string) error
+	// DeleteMachineFunc, if set, handles calls to DeleteMachine.
+	DeleteMachineFunc func(ctx context.Context, identity string) error
+	// DeleteNatGatewayFunc, if set, handles calls to DeleteNatGateway.
+	DeleteNatGatewayFunc func(ctx context.Context, identity string) error
+	// DeleteReservedIPFunc, if set, handles calls to DeleteReservedIP.
+	DeleteReservedIPFunc func(ctx context.Context, identity string) error
+	// DeleteRouteTableFunc, if set, handles calls to DeleteRouteTable.
+	DeleteRouteTableFunc func(ctx context.Context, identity string) error
+	// DeleteRouteTableRouteFunc, if set, handles calls to DeleteRouteTableRoute.
+	DeleteRouteTableRouteFunc func(ctx context.Context, identity string, routeIdentity string) error
+	// DeleteSecurityGroupFunc, if set, handles calls to DeleteSecurityGroup.
+	DeleteSecurityGroupFunc func(ctx context.Context, identity string) error
+	// DeleteSnapshotFunc, if set, handles calls to DeleteSnapshot.
+	DeleteSnapshotFunc func(ctx context.Context, identity string) error
+	// DeleteSnapshotPolicyFunc, if set, handles calls to DeleteSnapshotPolicy.
+	DeleteSnapshotPolicyFunc func(ctx context.Context, identity string) error
+	// DeleteSubnetFunc, if set, handles calls to DeleteSubnet.
+	DeleteSubnetFunc func(ctx context.Context, identity string) error
+	// DeleteTargetGroupFunc, if set, handles calls to DeleteTargetGroup.
+	DeleteTargetGroupFunc func(ctx context.Context, deleteRequest DeleteTargetGroupRequest) error
+	// DeleteVolumeFunc, if set, handles calls to DeleteVolume.
+	DeleteVolumeFunc func(ctx context.Context, identity string) error
+	// DeleteVpcFunc, if set, handles calls to DeleteVpc.
+	DeleteVpcFunc func(ctx context.Context, identity string) error
+	// DeleteVpcFirewallRuleFunc, if set, handles calls to DeleteVpcFirewallRule.
+	DeleteVpcFirewallRuleFunc func(ctx context.Context, identity string, firewallRuleIdentity string) error
+	// DeleteVpcPeeringConnectionFunc, if set, handles calls to DeleteVpcPeeringConnection.
+	DeleteVpcPeeringConnectionFunc func(ctx context.Context, identity string) error
+	// DetachServerFromTargetGroupFunc, if set, handles calls to DetachServerFromTargetGroup.
+	DetachServerFromTargetGroupFunc func(ctx context.Context, detachRequest DetachTargetRequest) error
+	// DetachVolumeFunc, if set, handles calls to DetachVolume.
+	DetachVolumeFunc func(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest) error
+	// DetachVolumeAndWaitUntilAvailableFunc, if set, handles calls to DetachVolumeAndWaitUntilAvailable.
+	DetachVolumeAndWaitUntilAvailableFunc func(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest, opts ...wait.Option) error
+	// DisassociateReservedIPFunc, if set, handles calls to DisassociateReservedIP.
+	DisassociateReservedIPFunc func(ctx context.Context, identity string) (*ReservedIP, error)
+	// GetCloudInitTemplateFunc, if set, handles calls to GetCloudInitTemplate.
+	GetCloudInitTemplateFunc func(ctx context.Context, identity string) (*CloudInitTemplate, error)
+	// GetListenerFunc, if set, handles calls to GetListener.
+	GetListenerFunc func(ctx context.Context, getRequest GetLoadbalancerListenerRequest) (*VpcLoadbalancerListener, error)
+	// GetLoadbalancerFunc, if set, handles calls to GetLoadbalancer.
+	GetLoadbalancerFunc func(ctx context.Context, loadbalancerIdentity string) (*VpcLoadbalancer, error)
+	// GetMachineFunc, if set, handles calls to GetMachine.
+	GetMachineFunc func(ctx context.Context, identity string) (*Machine, error)
+	// GetMachineImageFunc, if set, handles calls to GetMachineImage.
+	GetMachineImageFunc func(ctx context.Context, identity string) (*MachineImage, error)
+	// GetMachineTypeFunc, if set, handles calls to GetMachineType.
+	GetMachineTypeFunc func(ctx context.Context, identity string) (*MachineType, error)
+	// GetNatGatewayFunc, if set, handles calls to GetNatGateway.
+	GetNatGatewayFunc func(ctx context.Context, identity string) (*VpcNatGateway, error)
+	// GetRegionFunc, if set, handles calls to GetRegion.
+	GetRegionFunc func(ctx context.Context, identity string) (*Region, error)
+	// GetReservedIPFunc, if set, handles calls to GetReservedIP.
+	GetReservedIPFunc func(ctx context.Context, identity string) (*ReservedIP, error)
+	// GetRouteTableFunc, if set, handles calls to GetRouteTable.
+	GetRouteTableFunc func(ctx context.Context, identity string) (*RouteTable, error)
+	// GetRouteTableRouteFunc, if set, handles calls to GetRouteTableRoute.
+	GetRouteTableRouteFunc func(ctx context.Context, identity string, routeIdentity string) (*RouteEntry, error)
+	// GetSecurityGroupFunc, if set, handles calls to GetSecurityGroup.
+	GetSecurityGroupFunc func(ctx context.Context, identity string) (*SecurityGroup, error)
+	// GetSnapshotFunc, if set, handles calls to GetSnapshot.
+	GetSnapshotFunc func(ctx context.Context, identity string) (*Snapshot, error)
+	// GetSnapshotPolicyFunc, if set, handles calls to GetSnapshotPolicy.
+	GetSnapshotPolicyFunc func(ctx context.Context, identity string) (*SnapshotPolicy, error)
+	// GetSubnetFunc, if set, handles calls to GetSubnet.
+	GetSubnetFunc func(ctx context.Context, identity string) (*Subnet, error)
+	// GetTargetGroupFunc, if set, handles calls to GetTargetGroup.
+	GetTargetGroupFunc func(ctx context.Context, getRequest GetTargetGroupRequest) (*VpcLoadbalancerTargetGroup, error)
+	// GetVolumeFunc, if set, handles calls to GetVolume.
+	GetVolumeFunc func(ctx context.Context, identity string) (*Volume, error)
+	// GetVolumeTypeFunc, if set, handles calls to GetVolumeType.
+	GetVolumeTypeFunc func(ctx context.Context, identity string) (*VolumeType, error)
+	// GetVpcFunc, if set, handles calls to GetVpc.
+	GetVpcFunc func(ctx context.Context, identity string) (*Vpc, error)
+	// GetVpcFirewallRuleFunc, if set, handles calls to GetVpcFirewallRule.
+	GetVpcFirewallRuleFunc func(ctx context.Context, identity string, firewallRuleIdentity string) (*VpcFirewallRule, error)
+	// GetVpcPeeringConnectionFunc, if set, handles calls to GetVpcPeeringConnection.
+	GetVpcPeeringConnectionFunc func(ctx context.Context, identity string) (*VpcPeeringConnection, error)
+	// ListCloudInitTemplatesFunc, if set, handles calls to ListCloudInitTemplates.
+	ListCloudInitTemplatesFunc func(ctx context.Context) ([]CloudInitTemplate, error)
+	// ListListenersFunc, if set, handles calls to ListListeners.
+	ListListenersFunc func(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) ([]VpcLoadbalancerListener, error)
+	// ListLoadbalancersFunc, if set, handles calls to ListLoadbalancers.
+	ListLoadbalancersFunc func(ctx context.Context, listRequest *ListLoadbalancersRequest) ([]VpcLoadbalancer, error)
+	// ListMachineImagesFunc, if set, handles calls to ListMachineImages.
+	ListMachineImagesFunc func(ctx context.Context, listRequest *ListMachineImagesRequest) ([]MachineImage, error)
+	// ListMachineTypeCategoriesFunc, if set, handles calls to ListMachineTypeCategories.
+	ListMachineTypeCategoriesFunc func(ctx context.Context) ([]MachineTypeCategory, error)
+	// ListMachineTypesFunc, if set, handles calls to ListMachineTypes.
+	ListMachineTypesFunc func(ctx context.Context, listRequest *ListMachineTypesRequest) ([]MachineType, error)
+	// ListMachinesFunc, if set, handles calls to ListMachines.
+	ListMachinesFunc func(ctx context.Context, listRequest *ListMachinesRequest) ([]Machine, error)
+	// ListNatGatewaysFunc, if set, handles calls to ListNatGateways.
+	ListNatGatewaysFunc func(ctx context.Context, listRequest *ListNatGatewaysRequest) ([]VpcNatGateway, error)
+	// ListRegionsFunc, if set, handles calls to ListRegions.
+	ListRegionsFunc func(ctx context.Context, listRequest *ListRegionsRequest) ([]Region, error)
+	// ListReservedIPsFunc, if set, handles calls to ListReservedIPs.
+	ListReservedIPsFunc func(ctx context.Context, listRequest *ListReservedIPsRequest) ([]ReservedIP, error)
+	// ListRouteTablesFunc, if set, handles calls to ListRouteTables.
+	ListRouteTablesFunc func(ctx context.Context, listRequest *ListRouteTablesRequest) ([]RouteTable, error)
+	// ListSecurityGroupsFunc, if set, handles calls to ListSecurityGroups.
+	ListSecurityGroupsFunc func(ctx context.Context, listRequest *ListSecurityGroupsRequest) ([]SecurityGroup, error)
+	// ListSnapshotPoliciesFunc, if set, handles calls to ListSnapshotPolicies.
+	ListSnapshotPoliciesFunc func(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) ([]SnapshotPolicy, error)
+	// ListSnapshotsFunc, if set, handles calls to ListSnapshots.
+	ListSnapshotsFunc func(ctx context.Context, listRequest *ListSnapshotsRequest) ([]Snapshot, error)
+	// ListSubnetsFunc, if set, handles calls to ListSubnets.
+	ListSubnetsFunc func(ctx context.Context, listRequest *ListSubnetsRequest) ([]Subnet, error)
+	// ListTargetGroupsFunc, if set, handles calls to ListTargetGroups.
+	ListTargetGroupsFunc func(ctx context.Context, listRequest *ListTargetGroupsRequest) ([]VpcLoadbalancerTargetGroup, error)
+	// ListVolumeTypesFunc, if set, handles calls to ListVolumeTypes.
+	ListVolumeTypesFunc func(ctx context.Context, listRequest *ListVolumeTypesRequest) ([]VolumeType, error)
+	// ListVolumesFunc, if set, handles calls to ListVolumes.
+	ListVolumesFunc func(ctx context.Context, listRequest *ListVolumesRequest) ([]Volume, error)
+	// ListVpcFirewallRuleFunc, if set, handles calls to ListVpcFirewallRule.
+	ListVpcFirewallRuleFunc func(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) ([]VpcFirewallRule, error)
+	// ListVpcPeeringConnectionsFunc, if set, handles calls to ListVpcPeeringConnections.
+	ListVpcPeeringConnectionsFunc func(ctx context.Context, request *ListVpcPeeringConnectionsRequest) ([]VpcPeeringConnection, error)
+	// ListVpcsFunc, if set, handles calls to ListVpcs.
+	ListVpcsFunc func(ctx context.Context, request *ListVpcsRequest) ([]Vpc, error)
+	// MachineConsoleFunc, if set, handles calls to MachineConsole.
+	MachineConsoleFunc func(ctx context.Context, identity string) (*websocket.Conn, error)
+	// MachineConsoleSessionFunc, if set, handles calls to MachineConsoleSession.
+	MachineConsoleSessionFunc func(ctx context.Context, identity string, opts client.WebsocketOptions) (*client.WebsocketSession, error)
+	// MachineRestartFunc, if set, handles calls to MachineRestart.
+	MachineRestartFunc func(ctx context.Context, identity string) error
+	// MachineStartFunc, if set, handles calls to MachineStart.
+	MachineStartFunc func(ctx context.Context, identity string) error
+	// MachineStopFunc, if set, handles calls to MachineStop.
+	MachineStopFunc func(ctx context.Context, identity string) error
+	// RejectVpcPeeringConnectionFunc, if set, handles calls to RejectVpcPeeringConnection.
+	RejectVpcPeeringConnectionFunc func(ctx context.Context, identity string, reject RejectVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+	// SetTargetGroupServerAttachmentsFunc, if set, handles calls to SetTargetGroupServerAttachments.
+	SetTargetGroupServerAttachmentsFunc func(ctx context.Context, setRequest TargetGroupAttachmentsBatch) error
+	// UpdateCloudInitTemplateFunc, if set, handles calls to UpdateCloudInitTemplate.
+	UpdateCloudInitTemplateFunc func(ctx context.Context, identity string, update UpdateCloudInitTemplateRequest) (*CloudInitTemplate, error)
+	// UpdateListenerFunc, if set, handles calls to UpdateListener.
+	UpdateListenerFunc func(ctx context.Context, loadbalancerID string, listenerID string, update UpdateListener) (*VpcLoadbalancerListener, error)
+	// UpdateLoadbalancerFunc, if set, handles calls to UpdateLoadbalancer.
+	UpdateLoadbalancerFunc func(ctx context.Context, loadbalancerIdentity string, update UpdateLoadbalancer) (*VpcLoadbalancer, error)
+	// UpdateMachineFunc, if set, handles calls to UpdateMachine.
+	UpdateMachineFunc func(ctx context.Context, identity string, update UpdateMachine) (*Machine, error)
+	// UpdateNatGatewayFunc, if set, handles calls to UpdateNatGateway.
+	UpdateNatGatewayFunc func(ctx context.Context, identity string, update UpdateVpcNatGateway) (*VpcNatGateway, error)
+	// UpdateReservedIPFunc, if set, handles calls to UpdateReservedIP.
+	UpdateReservedIPFunc func(ctx context.Context, identity string, update UpdateReservedIpRequest) (*ReservedIP, error)
+	// UpdateRouteTableFunc, if set, handles calls to UpdateRouteTable.
+	UpdateRouteTableFunc func(ctx context.Context, identity string, update UpdateRouteTable) (*RouteTable, error)
+	// UpdateRouteTableRouteFunc, if set, handles calls to UpdateRouteTableRoute.
+	UpdateRouteTableRouteFunc func(ctx context.Context, identity string, routeIdentity string, update UpdateRouteTableRoute) (*RouteEntry, error)
+	// UpdateRouteTableRoutesFunc, if set, handles calls to UpdateRouteTableRoutes.
+	UpdateRouteTableRoutesFunc func(ctx context.Context, identity string, update UpdateRouteTableRoutes) ([]RouteEntry, error)
+	// UpdateSecurityGroupFunc, if set, handles calls to UpdateSecurityGroup.
+	UpdateSecurityGroupFunc func(ctx context.Context, identity string, update UpdateSecurityGroupRequest) (*SecurityGroup, error)
+	// UpdateSnapshotFunc, if set, handles calls to UpdateSnapshot.
+	UpdateSnapshotFunc func(ctx context.Context, identity string, update UpdateSnapshotRequest) (*Snapshot, error)
+	// UpdateSnapshotPolicyFunc, if set, handles calls to UpdateSnapshotPolicy.
+	UpdateSnapshotPolicyFunc func(ctx context.Context, identity string, update UpdateSnapshotPolicyRequest) (*SnapshotPolicy, error)
+	// UpdateSubnetFunc, if set, handles calls to UpdateSubnet.
+	UpdateSubnetFunc func(ctx context.Context, identity string, update UpdateSubnet) (*Subnet, error)
+	// UpdateTargetGroupFunc, if set, handles calls to UpdateTargetGroup.
+	UpdateTargetGroupFunc func(ctx context.Context, update UpdateTargetGroupRequest) (*VpcLoadbalancerTargetGroup, error)
+	// UpdateVolumeFunc, if set, handles calls to UpdateVolume.
+	UpdateVolumeFunc func(ctx context.Context, identity string, update UpdateVolume) (*Volume, error)
+	// UpdateVpcFunc, if set, handles calls to UpdateVpc.
+	UpdateVpcFunc func(ctx context.Context, identity string, update UpdateVpc) (*Vpc, error)
+	// UpdateVpcFirewallRuleFunc, if set, handles calls to UpdateVpcFirewallRule.
+	UpdateVpcFirewallRuleFunc func(ctx context.Context, identity string, firewallRuleIdentity string, update UpdateVpcFirewallRuleRequest) (*VpcFirewallRule, error)
+	// UpdateVpcPeeringConnectionFunc, if set, handles calls to UpdateVpcPeeringConnection.
+	UpdateVpcPeeringConnectionFunc func(ctx context.Context, identity string, update UpdateVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+	// WaitUntilLoadbalancerIsDeletedFunc, if set, handles calls to WaitUntilLoadbalancerIsDeleted.
+	WaitUntilLoadbalancerIsDeletedFunc func(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error
+	// WaitUntilLoadbalancerIsReadyFunc, if set, handles calls to WaitUntilLoadbalancerIsReady.
+	WaitUntilLoadbalancerIsReadyFunc func(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error
+	// WaitUntilLoadbalancerIsStatusFunc, if set, handles calls to WaitUntilLoadbalancerIsStatus.
+	WaitUntilLoadbalancerIsStatusFunc func(ctx context.Context, loadbalancerIdentity string, status string, opts ...wait.Option) error
+	// WaitUntilMachineDeletedFunc, if set, handles calls to WaitUntilMachineDeleted.
+	WaitUntilMachineDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilMachineIsRunningFunc, if set, handles calls to WaitUntilMachineIsRunning.
+	WaitUntilMachineIsRunningFunc func(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error)
+	// WaitUntilMachineIsStateFunc, if set, handles calls to WaitUntilMachineIsState.
+	WaitUntilMachineIsStateFunc func(ctx context.Context, identity string, state MachineState, opts ...wait.Option) (*Machine, error)
+	// WaitUntilMachineIsStoppedFunc, if set, handles calls to WaitUntilMachineIsStopped.
+	WaitUntilMachineIsStoppedFunc func(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error)
+	// WaitUntilNatGatewayDeletedFunc, if set, handles calls to WaitUntilNatGatewayDeleted.
+	WaitUntilNatGatewayDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilNatGatewayHasEndpointFunc, if set, handles calls to WaitUntilNatGatewayHasEndpoint.
+	WaitUntilNatGatewayHasEndpointFunc func(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error)
+	// WaitUntilNatGatewayReadyFunc, if set, handles calls to WaitUntilNatGatewayReady.
+	WaitUntilNatGatewayReadyFunc func(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error)
+	// WaitUntilReservedIPIsDeletedFunc, if set, handles calls to WaitUntilReservedIPIsDeleted.
+	WaitUntilReservedIPIsDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilReservedIPIsStatusFunc, if set, handles calls to WaitUntilReservedIPIsStatus.
+	WaitUntilReservedIPIsStatusFunc func(ctx context.Context, identity string, status ReservedIpStatus, opts ...wait.Option) (*ReservedIP, error)
+	// WaitUntilSecurityGroupIsDeletedFunc, if set, handles calls to WaitUntilSecurityGroupIsDeleted.
+	WaitUntilSecurityGroupIsDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilSecurityGroupIsReadyFunc, if set, handles calls to WaitUntilSecurityGroupIsReady.
+	WaitUntilSecurityGroupIsReadyFunc func(ctx context.Context, identity string, opts ...wait.Option) (*SecurityGroup, error)
+	// WaitUntilSnapshotIsAvailableFunc, if set, handles calls to WaitUntilSnapshotIsAvailable.
+	WaitUntilSnapshotIsAvailableFunc func(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error
+	// WaitUntilSnapshotIsDeletedFunc, if set, handles calls to WaitUntilSnapshotIsDeleted.
+	WaitUntilSnapshotIsDeletedFunc func(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error
+	// WaitUntilSnapshotIsStatusFunc, if set, handles calls to WaitUntilSnapshotIsStatus.
+	WaitUntilSnapshotIsStatusFunc func(ctx context.Context, snapshotIdentity string, status SnapshotStatus, opts ...wait.Option) error
+	// WaitUntilSubnetDeletedFunc, if set, handles calls to WaitUntilSubnetDeleted.
+	WaitUntilSubnetDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilSubnetReadyFunc, if set, handles calls to WaitUntilSubnetReady.
+	WaitUntilSubnetReadyFunc func(ctx context.Context, identity string, opts ...wait.Option) (*Subnet, error)
+	// WaitUntilVolumeIsAttachedFunc, if set, handles calls to WaitUntilVolumeIsAttached.
+	WaitUntilVolumeIsAttachedFunc func(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+	// WaitUntilVolumeIsAvailableFunc, if set, handles calls to WaitUntilVolumeIsAvailable.
+	WaitUntilVolumeIsAvailableFunc func(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+	// WaitUntilVolumeIsDeletedFunc, if set, handles calls to WaitUntilVolumeIsDeleted.
+	WaitUntilVolumeIsDeletedFunc func(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+	// WaitUntilVolumeIsStatusFunc, if set, handles calls to WaitUntilVolumeIsStatus.
+	WaitUntilVolumeIsStatusFunc func(ctx context.Context, volumeIdentity string, status string, opts ...wait.Option) error
+	// WaitUntilVpcIsDeletedFunc, if set, handles calls to WaitUntilVpcIsDeleted.
+	WaitUntilVpcIsDeletedFunc func(ctx context.Context, vpcIdentity string, opts ...wait.Option) error
+	// WaitUntilVpcIsReadyFunc, if set, handles calls to WaitUntilVpcIsReady.
+	WaitUntilVpcIsReadyFunc func(ctx context.Context, vpcIdentity string, opts ...wait.Option) error
+	// WaitUntilVpcIsStatusFunc, if set, handles calls to WaitUntilVpcIsStatus.
+	WaitUntilVpcIsStatusFunc func(ctx context.Context, vpcIdentity string, status string, opts ...wait.Option) error
+	// WaitUntilVpcPeeringConnectionIsActiveFunc, if set, handles calls to WaitUntilVpcPeeringConnectionIsActive.
+	WaitUntilVpcPeeringConnectionIsActiveFunc func(ctx context.Context, identity string, opts ...wait.Option) (*VpcPeeringConnection, error)
+	// WaitUntilVpcPeeringConnectionIsDeletedFunc, if set, handles calls to WaitUntilVpcPeeringConnectionIsDeleted.
+	WaitUntilVpcPeeringConnectionIsDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilVpcPeeringConnectionIsStatusFunc, if set, handles calls to WaitUntilVpcPeeringConnectionIsStatus.
+	WaitUntilVpcPeeringConnectionIsStatusFunc func(ctx context.Context, identity string, status VpcPeeringConnectionStatus, opts ...wait.Option) (*VpcPeeringConnection, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AcceptVpcPeeringConnection records the call and invokes AcceptVpcPeeringConnectionFunc if set.
+func (f *Fake) AcceptVpcPeeringConnection(ctx context.Context, identity string, accept AcceptVpcPeeringConnectionRequest) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("AcceptVpcPeeringConnection", ctx, identity, accept)
+	if f.AcceptVpcPeeringConnectionFunc != nil {
+		return f.AcceptVpcPeeringConnectionFunc(ctx, identity, accept)
+	}
+	r1 = f.Err
+	return
+}
+
+// AllCloudInitTemplates records the call and invokes AllCloudInitTemplatesFunc if set.
+func (f *Fake) AllCloudInitTemplates(ctx context.Context) (r0 iter.Seq2[CloudInitTemplate, error]) {
+	f.Record("AllCloudInitTemplates", ctx)
+	if f.AllCloudInitTemplatesFunc != nil {
+		return f.AllCloudInitTemplatesFunc(ctx)
+	}
+	r0 = func(yield func(CloudInitTemplate, error) bool) {
+		if f.Err != nil {
+			var zero CloudInitTemplate
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllListeners records the call and invokes AllListenersFunc if set.
+func (f *Fake) AllListeners(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) (r0 iter.Seq2[VpcLoadbalancerListener, error]) {
+	f.Record("AllListeners", ctx, listRequest)
+	if f.AllListenersFunc != nil {
+		return f.AllListenersFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(VpcLoadbalancerListener, error) bool) {
+		if f.Err != nil {
+			var zero VpcLoadbalancerListener
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllLoadbalancers records the call and invokes AllLoadbalancersFunc if set.
+func (f *Fake) AllLoadbalancers(ctx context.Context, listRequest *ListLoadbalancersRequest) (r0 iter.Seq2[VpcLoadbalancer, error]) {
+	f.Record("AllLoadbalancers", ctx, listRequest)
+	if f.AllLoadbalancersFunc != nil {
+		return f.AllLoadbalancersFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(VpcLoadbalancer, error) bool) {
+		if f.Err != nil {
+			var zero VpcLoadbalancer
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllMachineImages records the call and invokes AllMachineImagesFunc if set.
+func (f *Fake) AllMachineImages(ctx context.Context, listRequest *ListMachineImagesRequest) (r0 iter.Seq2[MachineImage, error]) {
+	f.Record("AllMachineImages", ctx, listRequest)
+	if f.AllMachineImagesFunc != nil {
+		return f.AllMachineImagesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(MachineImage, error) bool) {
+		if f.Err != nil {
+			var zero MachineImage
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllMachineTypeCategories records the call and invokes AllMachineTypeCategoriesFunc if set.
+func (f *Fake) AllMachineTypeCategories(ctx context.Context) (r0 iter.Seq2[MachineTypeCategory, error]) {
+	f.Record("AllMachineTypeCategories", ctx)
+	if f.AllMachineTypeCategoriesFunc != nil {
+		return f.AllMachineTypeCategoriesFunc(ctx)
+	}
+	r0 = func(yield func(MachineTypeCategory, error) bool) {
+		if f.Err != nil {
+			var zero MachineTypeCategory
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllMachineTypes records the call and invokes AllMachineTypesFunc if set.
+func (f *Fake) AllMachineTypes(ctx context.Context, listRequest *ListMachineTypesRequest) (r0 iter.Seq2[MachineType, error]) {
+	f.Record("AllMachineTypes", ctx, listRequest)
+	if f.AllMachineTypesFunc != nil {
+		return f.AllMachineTypesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(MachineType, error) bool) {
+		if f.Err != nil {
+			var zero MachineType
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllMachines records the call and invokes AllMachinesFunc if set.
+func (f *Fake) AllMachines(ctx context.Context, listRequest *ListMachinesRequest) (r0 iter.Seq2[Machine, error]) {
+	f.Record("AllMachines", ctx, listRequest)
+	if f.AllMachinesFunc != nil {
+		return f.AllMachinesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(Machine, error) bool) {
+		if f.Err != nil {
+			var zero Machine
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllNatGateways records the call and invokes AllNatGatewaysFunc if set.
+func (f *Fake) AllNatGateways(ctx context.Context, listRequest *ListNatGatewaysRequest) (r0 iter.Seq2[VpcNatGateway, error]) {
+	f.Record("AllNatGateways", ctx, listRequest)
+	if f.AllNatGatewaysFunc != nil {
+		return f.AllNatGatewaysFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(VpcNatGateway, error) bool) {
+		if f.Err != nil {
+			var zero VpcNatGateway
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllRegions records the call and invokes AllRegionsFunc if set.
+func (f *Fake) AllRegions(ctx context.Context, listRequest *ListRegionsRequest) (r0 iter.Seq2[Region, error]) {
+	f.Record("AllRegions", ctx, listRequest)
+	if f.AllRegionsFunc != nil {
+		return f.AllRegionsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(Region, error) bool) {
+		if f.Err != nil {
+			var zero Region
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllReservedIPs records the call and invokes AllReservedIPsFunc if set.
+func (f *Fake) AllReservedIPs(ctx context.Context, listRequest *ListReservedIPsRequest) (r0 iter.Seq2[ReservedIP, error]) {
+	f.Record("AllReservedIPs", ctx, listRequest)
+	if f.AllReservedIPsFunc != nil {
+		return f.AllReservedIPsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(ReservedIP, error) bool) {
+		if f.Err != nil {
+			var zero ReservedIP
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllRouteTables records the call and invokes AllRouteTablesFunc if set.
+func (f *Fake) AllRouteTables(ctx context.Context, listRequest *ListRouteTablesRequest) (r0 iter.Seq2[RouteTable, error]) {
+	f.Record("AllRouteTables", ctx, listRequest)
+	if f.AllRouteTablesFunc != nil {
+		return f.AllRouteTablesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(RouteTable, error) bool) {
+		if f.Err != nil {
+			var zero RouteTable
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllSecurityGroups records the call and invokes AllSecurityGroupsFunc if set.
+func (f *Fake) AllSecurityGroups(ctx context.Context, listRequest *ListSecurityGroupsRequest) (r0 iter.Seq2[SecurityGroup, error]) {
+	f.Record("AllSecurityGroups", ctx, listRequest)
+	if f.AllSecurityGroupsFunc != nil {
+		return f.AllSecurityGroupsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(SecurityGroup, error) bool) {
+		if f.Err != nil {
+			var zero SecurityGroup
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllSnapshotPolicies records the call and invokes AllSnapshotPoliciesFunc if set.
+func (f *Fake) AllSnapshotPolicies(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) (r0 iter.Seq2[SnapshotPolicy, error]) {
+	f.Record("AllSnapshotPolicies", ctx, listRequest)
+	if f.AllSnapshotPoliciesFunc != nil {
+		return f.AllSnapshotPoliciesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(SnapshotPolicy, error) bool) {
+		if f.Err != nil {
+			var zero SnapshotPolicy
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllSnapshots records the call and invokes AllSnapshotsFunc if set.
+func (f *Fake) AllSnapshots(ctx context.Context, listRequest *ListSnapshotsRequest) (r0 iter.Seq2[Snapshot, error]) {
+	f.Record("AllSnapshots", ctx, listRequest)
+	if f.AllSnapshotsFunc != nil {
+		return f.AllSnapshotsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(Snapshot, error) bool) {
+		if f.Err != nil {
+			var zero Snapshot
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllSubnets records the call and invokes AllSubnetsFunc if set.
+func (f *Fake) AllSubnets(ctx context.Context, listRequest *ListSubnetsRequest) (r0 iter.Seq2[Subnet, error]) {
+	f.Record("AllSubnets", ctx, listRequest)
+	if f.AllSubnetsFunc != nil {
+		return f.AllSubnetsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(Subnet, error) bool) {
+		if f.Err != nil {
+			var zero Subnet
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllTargetGroups records the call and invokes AllTargetGroupsFunc if set.
+func (f *Fake) AllTargetGroups(ctx context.Context, listRequest *ListTargetGroupsRequest) (r0 iter.Seq2[VpcLoadbalancerTargetGroup, error]) {
+	f.Record("AllTargetGroups", ctx, listRequest)
+	if f.AllTargetGroupsFunc != nil {
+		return f.AllTargetGroupsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(VpcLoadbalancerTargetGroup, error) bool) {
+		if f.Err != nil {
+			var zero VpcLoadbalancerTargetGroup
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllVolumeTypes records the call and invokes AllVolumeTypesFunc if set.
+func (f *Fake) AllVolumeTypes(ctx context.Context, listRequest *ListVolumeTypesRequest) (r0 iter.Seq2[VolumeType, error]) {
+	f.Record("AllVolumeTypes", ctx, listRequest)
+	if f.AllVolumeTypesFunc != nil {
+		return f.AllVolumeTypesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(VolumeType, error) bool) {
+		if f.Err != nil {
+			var zero VolumeType
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllVolumes records the call and invokes AllVolumesFunc if set.
+func (f *Fake) AllVolumes(ctx context.Context, listRequest *ListVolumesRequest) (r0 iter.Seq2[Volume, error]) {
+	f.Record("AllVolumes", ctx, listRequest)
+	if f.AllVolumesFunc != nil {
+		return f.AllVolumesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(Volume, error) bool) {
+		if f.Err != nil {
+			var zero Volume
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllVpcFirewallRules records the call and invokes AllVpcFirewallRulesFunc if set.
+func (f *Fake) AllVpcFirewallRules(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) (r0 iter.Seq2[VpcFirewallRule, error]) {
+	f.Record("AllVpcFirewallRules", ctx, identity, request)
+	if f.AllVpcFirewallRulesFunc != nil {
+		return f.AllVpcFirewallRulesFunc(ctx, identity, request)
+	}
+	r0 = func(yield func(VpcFirewallRule, error) bool) {
+		if f.Err != nil {
+			var zero VpcFirewallRule
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllVpcPeeringConnections records the call and invokes AllVpcPeeringConnectionsFunc if set.
+func (f *Fake) AllVpcPeeringConnections(ctx context.Context, request *ListVpcPeeringConnectionsRequest) (r0 iter.Seq2[VpcPeeringConnection, error]) {
+	f.Record("AllVpcPeeringConnections", ctx, request)
+	if f.AllVpcPeeringConnectionsFunc != nil {
+		return f.AllVpcPeeringConnectionsFunc(ctx, request)
+	}
+	r0 = func(yield func(VpcPeeringConnection, error) bool) {
+		if f.Err != nil {
+			var zero VpcPeeringConnection
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllVpcs records the call and invokes AllVpcsFunc if set.
+func (f *Fake) AllVpcs(ctx context.Context, request *ListVpcsRequest) (r0 iter.Seq2[Vpc, error]) {
+	f.Record("AllVpcs", ctx, request)
+	if f.AllVpcsFunc != nil {
+		return f.AllVpcsFunc(ctx, request)
+	}
+	r0 = func(yield func(Vpc, error) bool) {
+		if f.Err != nil {
+			var zero Vpc
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AssociateReservedIP records the call and invokes AssociateReservedIPFunc if set.
+func (f *Fake) AssociateReservedIP(ctx context.Context, identity string, body AssociateReservedIpRequest) (r0 *ReservedIP, r1 error) {
+	f.Record("AssociateReservedIP", ctx, identity, body)
+	if f.AssociateReservedIPFunc != nil {
+		return f.AssociateReservedIPFunc(ctx, identity, body)
+	}
+	r1 = f.Err
+	return
+}
+
+// AttachServerToTargetGroup records the call and invokes AttachServerToTargetGroupFunc if set.
+func (f *Fake) AttachServerToTargetGroup(ctx context.Context, attachRequest AttachTargetGroupRequest) (r0 *LoadbalancerTargetGroupAttachment, r1 error) {
+	f.Record("AttachServerToTargetGroup", ctx, attachRequest)
+	if f.AttachServerToTargetGroupFunc != nil {
+		return f.AttachServerToTargetGroupFunc(ctx, attachRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// AttachVolume records the call and invokes AttachVolumeFunc if set.
+func (f *Fake) AttachVolume(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest) (r0 *VolumeAttachment, r1 error) {
+	f.Record("AttachVolume", ctx, volumeIdentity, attach)
+	if f.AttachVolumeFunc != nil {
+		return f.AttachVolumeFunc(ctx, volumeIdentity, attach)
+	}
+	r1 = f.Err
+	return
+}
+
+// AttachVolumeAndWaitUntilAttached records the call and invokes AttachVolumeAndWaitUntilAttachedFunc if set.
+func (f *Fake) AttachVolumeAndWaitUntilAttached(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest, opts ...wait.Option) (r0 error) {
+	f.Record("AttachVolumeAndWaitUntilAttached", ctx, volumeIdentity, attach, opts)
+	if f.AttachVolumeAndWaitUntilAttachedFunc != nil {
+		return f.AttachVolumeAndWaitUntilAttachedFunc(ctx, volumeIdentity, attach, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// BatchUpdateSecurityGroupEgressRules records the call and invokes BatchUpdateSecurityGroupEgressRulesFunc if set.
+func (f *Fake) BatchUpdateSecurityGroupEgressRules(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) (r0 []SecurityGroupRule, r1 error) {
+	f.Record("BatchUpdateSecurityGroupEgressRules", ctx, identity, update)
+	if f.BatchUpdateSecurityGroupEgressRulesFunc != nil {
+		return f.BatchUpdateSecurityGroupEgressRulesFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// BatchUpdateSecurityGroupIngressRules records the call and invokes BatchUpdateSecurityGroupIngressRulesFunc if set.
+func (f *Fake) BatchUpdateSecurityGroupIngressRules(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) (r0 []SecurityGroupRule, r1 error) {
+	f.Record("BatchUpdateSecurityGroupIngressRules", ctx, identity, update)
+	if f.BatchUpdateSecurityGroupIngressRulesFunc != nil {
+		return f.BatchUpdateSecurityGroupIngressRulesFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// BulkUpdateVpcFirewallRule records the call and invokes BulkUpdateVpcFirewallRuleFunc if set.
+func (f *Fake) BulkUpdateVpcFirewallRule(ctx context.Context, identity string, update BulkUpdateVpcFirewallRuleRequest) (r0 []VpcFirewallRule, r1 error) {
+	f.Record("BulkUpdateVpcFirewallRule", ctx, identity, update)
+	if f.BulkUpdateVpcFirewallRuleFunc != nil {
+		return f.BulkUpdateVpcFirewallRuleFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateCloudInitTemplate records the call and invokes CreateCloudInitTemplateFunc if set.
+func (f *Fake) CreateCloudInitTemplate(ctx context.Context, create CreateCloudInitTemplateRequest) (r0 *CloudInitTemplate, r1 error) {
+	f.Record("CreateCloudInitTemplate", ctx, create)
+	if f.CreateCloudInitTemplateFunc != nil {
+		return f.CreateCloudInitTemplateFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateListener records the call and invokes CreateListenerFunc if set.
+func (f *Fake) CreateListener(ctx context.Context, loadbalancerID string, create CreateListener) (r0 *VpcLoadbalancerListener, r1 error) {
+	f.Record("CreateListener", ctx, loadbalancerID, create)
+	if f.CreateListenerFunc != nil {
+		return f.CreateListenerFunc(ctx, loadbalancerID, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateLoadbalancer records the call and invokes CreateLoadbalancerFunc if set.
+func (f *Fake) CreateLoadbalancer(ctx context.Context, create CreateLoadbalancer) (r0 *VpcLoadbalancer, r1 error) {
+	f.Record("CreateLoadbalancer", ctx, create)
+	if f.CreateLoadbalancerFunc != nil {
+		return f.CreateLoadbalancerFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateMachine records the call and invokes CreateMachineFunc if set.
+func (f *Fake) CreateMachine(ctx context.Context, create CreateMachine) (r0 *Machine, r1 error) {
+	f.Record("CreateMachine", ctx, create)
+	if f.CreateMachineFunc != nil {
+		return f.CreateMachineFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateNatGateway records the call and invokes CreateNatGatewayFunc if set.
+func (f *Fake) CreateNatGateway(ctx context.Context, create CreateVpcNatGateway) (r0 *VpcNatGateway, r1 error) {
+	f.Record("CreateNatGateway", ctx, create)
+	if f.CreateNatGatewayFunc != nil {
+		return f.CreateNatGatewayFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateReservedIP records the call and invokes CreateReservedIPFunc if set.
+func (f *Fake) CreateReservedIP(ctx context.Context, create CreateReservedIpRequest) (r0 *ReservedIP, r1 error) {
+	f.Record("CreateReservedIP", ctx, create)
+	if f.CreateReservedIPFunc != nil {
+		return f.CreateReservedIPFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateRouteTable records the call and invokes CreateRouteTableFunc if set.
+func (f *Fake) CreateRouteTable(ctx context.Context, create CreateRouteTable) (r0 *RouteTable, r1 error) {
+	f.Record("CreateRouteTable", ctx, create)
+	if f.CreateRouteTableFunc != nil {
+		return f.CreateRouteTableFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateRouteTableRoute records the call and invokes CreateRouteTableRouteFunc if set.
+func (f *Fake) CreateRouteTableRoute(ctx context.Context, identity string, create CreateRouteTableRoute) (r0 *RouteEntry, r1 error) {
+	f.Record("CreateRouteTableRoute", ctx, identity, create)
+	if f.CreateRouteTableRouteFunc != nil {
+		return f.CreateRouteTableRouteFunc(ctx, identity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateSecurityGroup records the call and invokes CreateSecurityGroupFunc if set.
+func (f *Fake) CreateSecurityGroup(ctx context.Context, create CreateSecurityGroupRequest) (r0 *SecurityGroup, r1 error) {
+	f.Record("CreateSecurityGroup", ctx, create)
+	if f.CreateSecurityGroupFunc != nil {
+		return f.CreateSecurityGroupFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateSnapshot records the call and invokes CreateSnapshotFunc if set.
+func (f *Fake) CreateSnapshot(ctx context.Context, create CreateSnapshotRequest) (r0 *Snapshot, r1 error) {
+	f.Record("CreateSnapshot", ctx, create)
+	if f.CreateSnapshotFunc != nil {
+		return f.CreateSnapshotFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateSnapshotPolicy records the call and invokes CreateSnapshotPolicyFunc if set.
+func (f *Fake) CreateSnapshotPolicy(ctx context.Context, create CreateSnapshotPolicyRequest) (r0 *SnapshotPolicy, r1 error) {
+	f.Record("CreateSnapshotPolicy", ctx, create)
+	if f.CreateSnapshotPolicyFunc != nil {
+		return f.CreateSnapshotPolicyFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateSubnet records the call and invokes CreateSubnetFunc if set.
+func (f *Fake) CreateSubnet(ctx context.Context, create CreateSubnet) (r0 *Subnet, r1 error) {
+	f.Record("CreateSubnet", ctx, create)
+	if f.CreateSubnetFunc != nil {
+		return f.CreateSubnetFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateTargetGroup records the call and invokes CreateTargetGroupFunc if set.
+func (f *Fake) CreateTargetGroup(ctx context.Context, create CreateTargetGroup) (r0 *VpcLoadbalancerTargetGroup, r1 error) {
+	f.Record("CreateTargetGroup", ctx, create)
+	if f.CreateTargetGroupFunc != nil {
+		return f.CreateTargetGroupFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateVolume records the call and invokes CreateVolumeFunc if set.
+func (f *Fake) CreateVolume(ctx context.Context, create CreateVolume) (r0 *Volume, r1 error) {
+	f.Record("CreateVolume", ctx, create)
+	if f.CreateVolumeFunc != nil {
+		return f.CreateVolumeFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateVpc records the call and invokes CreateVpcFunc if set.
+func (f *Fake) CreateVpc(ctx context.Context, create CreateVpc) (r0 *Vpc, r1 error) {
+	f.Record("CreateVpc", ctx, create)
+	if f.CreateVpcFunc != nil {
+		return f.CreateVpcFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateVpcFirewallRule records the call and invokes CreateVpcFirewallRuleFunc if set.
+func (f *Fake) CreateVpcFirewallRule(ctx context.Context, identity string, create CreateVpcFirewallRuleRequest) (r0 *VpcFirewallRule, r1 error) {
+	f.Record("CreateVpcFirewallRule", ctx, identity, create)
+	if f.CreateVpcFirewallRuleFunc != nil {
+		return f.CreateVpcFirewallRuleFunc(ctx, identity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateVpcPeeringConnection records the call and invokes CreateVpcPeeringConnectionFunc if set.
+func (f *Fake) CreateVpcPeeringConnection(ctx context.Context, create CreateVpcPeeringConnectionRequest) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("CreateVpcPeeringConnection", ctx, create)
+	if f.CreateVpcPeeringConnectionFunc != nil {
+		return f.CreateVpcPeeringConnectionFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteCloudInitTemplate records the call and invokes DeleteCloudInitTemplateFunc if set.
+func (f *Fake) DeleteCloudInitTemplate(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteCloudInitTemplate", ctx, identity)
+	if f.DeleteCloudInitTemplateFunc != nil {
+		return f.DeleteCloudInitTemplateFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteListener records the call and invokes DeleteListenerFunc if set.
+func (f *Fake) DeleteListener(ctx context.Context, loadbalancerID string, listenerID string) (r0 error) {
+	f.Record("DeleteListener", ctx, loadbalancerID, listenerID)
+	if f.DeleteListenerFunc != nil {
+		return f.DeleteListenerFunc(ctx, loadbalancerID, listenerID)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteLoadbalancer records the call and invokes DeleteLoadbalancerFunc if set.
+func (f *Fake) DeleteLoadbalancer(ctx context.Context, loadbalancerIdentity string) (r0 error) {
+	f.Record("DeleteLoadbalancer", ctx, loadbalancerIdentity)
+	if f.DeleteLoadbalancerFunc != nil {
+		return f.DeleteLoadbalancerFunc(ctx, loadbalancerIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteMachine records the call and invokes DeleteMachineFunc if set.
+func (f *Fake) DeleteMachine(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteMachine", ctx, identity)
+	if f.DeleteMachineFunc != nil {
+		return f.DeleteMachineFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteNatGateway records the call and invokes DeleteNatGatewayFunc if set.
+func (f *Fake) DeleteNatGateway(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteNatGateway", ctx, identity)
+	if f.DeleteNatGatewayFunc != nil {
+		return f.DeleteNatGatewayFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteReservedIP records the call and invokes DeleteReservedIPFunc if set.
+func (f *Fake) DeleteReservedIP(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteReservedIP", ctx, identity)
+	if f.DeleteReservedIPFunc != nil {
+		return f.DeleteReservedIPFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteRouteTable records the call and invokes DeleteRouteTableFunc if set.
+func (f *Fake) DeleteRouteTable(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteRouteTable", ctx, identity)
+	if f.DeleteRouteTableFunc != nil {
+		return f.DeleteRouteTableFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteRouteTableRoute records the call and invokes DeleteRouteTableRouteFunc if set.
+func (f *Fake) DeleteRouteTableRoute(ctx context.Context, identity string, routeIdentity string) (r0 error) {
+	f.Record("DeleteRouteTableRoute", ctx, identity, routeIdentity)
+	if f.DeleteRouteTableRouteFunc != nil {
+		return f.DeleteRouteTableRouteFunc(ctx, identity, routeIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteSecurityGroup records the call and invokes DeleteSecurityGroupFunc if set.
+func (f *Fake) DeleteSecurityGroup(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteSecurityGroup", ctx, identity)
+	if f.DeleteSecurityGroupFunc != nil {
+		return f.DeleteSecurityGroupFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteSnapshot records the call and invokes DeleteSnapshotFunc if set.
+func (f *Fake) DeleteSnapshot(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteSnapshot", ctx, identity)
+	if f.DeleteSnapshotFunc != nil {
+		return f.DeleteSnapshotFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteSnapshotPolicy records the call and invokes DeleteSnapshotPolicyFunc if set.
+func (f *Fake) DeleteSnapshotPolicy(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteSnapshotPolicy", ctx, identity)
+	if f.DeleteSnapshotPolicyFunc != nil {
+		return f.DeleteSnapshotPolicyFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteSubnet records the call and invokes DeleteSubnetFunc if set.
+func (f *Fake) DeleteSubnet(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteSubnet", ctx, identity)
+	if f.DeleteSubnetFunc != nil {
+		return f.DeleteSubnetFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteTargetGroup records the call and invokes DeleteTargetGroupFunc if set.
+func (f *Fake) DeleteTargetGroup(ctx context.Context, deleteRequest DeleteTargetGroupRequest) (r0 error) {
+	f.Record("DeleteTargetGroup", ctx, deleteRequest)
+	if f.DeleteTargetGroupFunc != nil {
+		return f.DeleteTargetGroupFunc(ctx, deleteRequest)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteVolume records the call and invokes DeleteVolumeFunc if set.
+func (f *Fake) DeleteVolume(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteVolume", ctx, identity)
+	if f.DeleteVolumeFunc != nil {
+		return f.DeleteVolumeFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteVpc records the call and invokes DeleteVpcFunc if set.
+func (f *Fake) DeleteVpc(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteVpc", ctx, identity)
+	if f.DeleteVpcFunc != nil {
+		return f.DeleteVpcFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteVpcFirewallRule records the call and invokes DeleteVpcFirewallRuleFunc if set.
+func (f *Fake) DeleteVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string) (r0 error) {
+	f.Record("DeleteVpcFirewallRule", ctx, identity, firewallRuleIdentity)
+	if f.DeleteVpcFirewallRuleFunc != nil {
+		return f.DeleteVpcFirewallRuleFunc(ctx, identity, firewallRuleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteVpcPeeringConnection records the call and invokes DeleteVpcPeeringConnectionFunc if set.
+func (f *Fake) DeleteVpcPeeringConnection(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteVpcPeeringConnection", ctx, identity)
+	if f.DeleteVpcPeeringConnectionFunc != nil {
+		return f.DeleteVpcPeeringConnectionFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DetachServerFromTargetGroup records the call and invokes DetachServerFromTargetGroupFunc if set.
+func (f *Fake) DetachServerFromTargetGroup(ctx context.Context, detachRequest DetachTargetRequest) (r0 error) {
+	f.Record("DetachServerFromTargetGroup", ctx, detachRequest)
+	if f.DetachServerFromTargetGroupFunc != nil {
+		return f.DetachServerFromTargetGroupFunc(ctx, detachRequest)
+	}
+	r0 = f.Err
+	return
+}
+
+// DetachVolume records the call and invokes DetachVolumeFunc if set.
+func (f *Fake) DetachVolume(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest) (r0 error) {
+	f.Record("DetachVolume", ctx, volumeIdentity, detach)
+	if f.DetachVolumeFunc != nil {
+		return f.DetachVolumeFunc(ctx, volumeIdentity, detach)
+	}
+	r0 = f.Err
+	return
+}
+
+// DetachVolumeAndWaitUntilAvailable records the call and invokes DetachVolumeAndWaitUntilAvailableFunc if set.
+func (f *Fake) DetachVolumeAndWaitUntilAvailable(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest, opts ...wait.Option) (r0 error) {
+	f.Record("DetachVolumeAndWaitUntilAvailable", ctx, volumeIdentity, detach, opts)
+	if f.DetachVolumeAndWaitUntilAvailableFunc != nil {
+		return f.DetachVolumeAndWaitUntilAvailableFunc(ctx, volumeIdentity, detach, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// DisassociateReservedIP records the call and invokes DisassociateReservedIPFunc if set.
+func (f *Fake) DisassociateReservedIP(ctx context.Context, identity string) (r0 *ReservedIP, r1 error) {
+	f.Record("DisassociateReservedIP", ctx, identity)
+	if f.DisassociateReservedIPFunc != nil {
+		return f.DisassociateReservedIPFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetCloudInitTemplate records the call and invokes GetCloudInitTemplateFunc if set.
+func (f *Fake) GetCloudInitTemplate(ctx context.Context, identity string) (r0 *CloudInitTemplate, r1 error) {
+	f.Record("GetCloudInitTemplate", ctx, identity)
+	if f.GetCloudInitTemplateFunc != nil {
+		return f.GetCloudInitTemplateFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetListener records the call and invokes GetListenerFunc if set.
+func (f *Fake) GetListener(ctx context.Context, getRequest GetLoadbalancerListenerRequest) (r0 *VpcLoadbalancerListener, r1 error) {
+	f.Record("GetListener", ctx, getRequest)
+	if f.GetListenerFunc != nil {
+		return f.GetListenerFunc(ctx, getRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetLoadbalancer records the call and invokes GetLoadbalancerFunc if set.
+func (f *Fake) GetLoadbalancer(ctx context.Context, loadbalancerIdentity string) (r0 *VpcLoadbalancer, r1 error) {
+	f.Record("GetLoadbalancer", ctx, loadbalancerIdentity)
+	if f.GetLoadbalancerFunc != nil {
+		return f.GetLoadbalancerFunc(ctx, loadbalancerIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetMachine records the call and invokes GetMachineFunc if set.
+func (f *Fake) GetMachine(ctx context.Context, identity string) (r0 *Machine, r1 error) {
+	f.Record("GetMachine", ctx, identity)
+	if f.GetMachineFunc != nil {
+		return f.GetMachineFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetMachineImage records the call and invokes GetMachineImageFunc if set.
+func (f *Fake) GetMachineImage(ctx context.Context, identity string) (r0 *MachineImage, r1 error) {
+	f.Record("GetMachineImage", ctx, identity)
+	if f.GetMachineImageFunc != nil {
+		return f.GetMachineImageFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetMachineType records the call and invokes GetMachineTypeFunc if set.
+func (f *Fake) GetMachineType(ctx context.Context, identity string) (r0 *MachineType, r1 error) {
+	f.Record("GetMachineType", ctx, identity)
+	if f.GetMachineTypeFunc != nil {
+		return f.GetMachineTypeFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetNatGateway records the call and invokes GetNatGatewayFunc if set.
+func (f *Fake) GetNatGateway(ctx context.Context, identity string) (r0 *VpcNatGateway, r1 error) {
+	f.Record("GetNatGateway", ctx, identity)
+	if f.GetNatGatewayFunc != nil {
+		return f.GetNatGatewayFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetRegion records the call and invokes GetRegionFunc if set.
+func (f *Fake) GetRegion(ctx context.Context, identity string) (r0 *Region, r1 error) {
+	f.Record("GetRegion", ctx, identity)
+	if f.GetRegionFunc != nil {
+		return f.GetRegionFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetReservedIP records the call and invokes GetReservedIPFunc if set.
+func (f *Fake) GetReservedIP(ctx context.Context, identity string) (r0 *ReservedIP, r1 error) {
+	f.Record("GetReservedIP", ctx, identity)
+	if f.GetReservedIPFunc != nil {
+		return f.GetReservedIPFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetRouteTable records the call and invokes GetRouteTableFunc if set.
+func (f *Fake) GetRouteTable(ctx context.Context, identity string) (r0 *RouteTable, r1 error) {
+	f.Record("GetRouteTable", ctx, identity)
+	if f.GetRouteTableFunc != nil {
+		return f.GetRouteTableFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetRouteTableRoute records the call and invokes GetRouteTableRouteFunc if set.
+func (f *Fake) GetRouteTableRoute(ctx context.Context, identity string, routeIdentity string) (r0 *RouteEntry, r1 error) {
+	f.Record("GetRouteTableRoute", ctx, identity, routeIdentity)
+	if f.GetRouteTableRouteFunc != nil {
+		return f.GetRouteTableRouteFunc(ctx, identity, routeIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSecurityGroup records the call and invokes GetSecurityGroupFunc if set.
+func (f *Fake) GetSecurityGroup(ctx context.Context, identity string) (r0 *SecurityGroup, r1 error) {
+	f.Record("GetSecurityGroup", ctx, identity)
+	if f.GetSecurityGroupFunc != nil {
+		return f.GetSecurityGroupFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSnapshot records the call and invokes GetSnapshotFunc if set.
+func (f *Fake) GetSnapshot(ctx context.Context, identity string) (r0 *Snapshot, r1 error) {
+	f.Record("GetSnapshot", ctx, identity)
+	if f.GetSnapshotFunc != nil {
+		return f.GetSnapshotFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSnapshotPolicy records the call and invokes GetSnapshotPolicyFunc if set.
+func (f *Fake) GetSnapshotPolicy(ctx context.Context, identity string) (r0 *SnapshotPolicy, r1 error) {
+	f.Record("GetSnapshotPolicy", ctx, identity)
+	if f.GetSnapshotPolicyFunc != nil {
+		return f.GetSnapshotPolicyFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSubnet records the call and invokes GetSubnetFunc if set.
+func (f *Fake) GetSubnet(ctx context.Context, identity string) (r0 *Subnet, r1 error) {
+	f.Record("GetSubnet", ctx, identity)
+	if f.GetSubnetFunc != nil {
+		return f.GetSubnetFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetTargetGroup records the call and invokes GetTargetGroupFunc if set.
+func (f *Fake) GetTargetGroup(ctx context.Context, getRequest GetTargetGroupRequest) (r0 *VpcLoadbalancerTargetGroup, r1 error) {
+	f.Record("GetTargetGroup", ctx, getRequest)
+	if f.GetTargetGroupFunc != nil {
+		return f.GetTargetGroupFunc(ctx, getRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetVolume records the call and invokes GetVolumeFunc if set.
+func (f *Fake) GetVolume(ctx context.Context, identity string) (r0 *Volume, r1 error) {
+	f.Record("GetVolume", ctx, identity)
+	if f.GetVolumeFunc != nil {
+		return f.GetVolumeFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetVolumeType records the call and invokes GetVolumeTypeFunc if set.
+func (f *Fake) GetVolumeType(ctx context.Context, identity string) (r0 *VolumeType, r1 error) {
+	f.Record("GetVolumeType", ctx, identity)
+	if f.GetVolumeTypeFunc != nil {
+		return f.GetVolumeTypeFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetVpc records the call and invokes GetVpcFunc if set.
+func (f *Fake) GetVpc(ctx context.Context, identity string) (r0 *Vpc, r1 error) {
+	f.Record("GetVpc", ctx, identity)
+	if f.GetVpcFunc != nil {
+		return f.GetVpcFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetVpcFirewallRule records the call and invokes GetVpcFirewallRuleFunc if set.
+func (f *Fake) GetVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string) (r0 *VpcFirewallRule, r1 error) {
+	f.Record("GetVpcFirewallRule", ctx, identity, firewallRuleIdentity)
+	if f.GetVpcFirewallRuleFunc != nil {
+		return f.GetVpcFirewallRuleFunc(ctx, identity, firewallRuleIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetVpcPeeringConnection records the call and invokes GetVpcPeeringConnectionFunc if set.
+func (f *Fake) GetVpcPeeringConnection(ctx context.Context, identity string) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("GetVpcPeeringConnection", ctx, identity)
+	if f.GetVpcPeeringConnectionFunc != nil {
+		return f.GetVpcPeeringConnectionFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListCloudInitTemplates records the call and invokes ListCloudInitTemplatesFunc if set.
+func (f *Fake) ListCloudInitTemplates(ctx context.Context) (r0 []CloudInitTemplate, r1 error) {
+	f.Record("ListCloudInitTemplates", ctx)
+	if f.ListCloudInitTemplatesFunc != nil {
+		return f.ListCloudInitTemplatesFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListListeners records the call and invokes ListListenersFunc if set.
+func (f *Fake) ListListeners(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) (r0 []VpcLoadbalancerListener, r1 error) {
+	f.Record("ListListeners", ctx, listRequest)
+	if f.ListListenersFunc != nil {
+		return f.ListListenersFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListLoadbalancers records the call and invokes ListLoadbalancersFunc if set.
+func (f *Fake) ListLoadbalancers(ctx context.Context, listRequest *ListLoadbalancersRequest) (r0 []VpcLoadbalancer, r1 error) {
+	f.Record("ListLoadbalancers", ctx, listRequest)
+	if f.ListLoadbalancersFunc != nil {
+		return f.ListLoadbalancersFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListMachineImages records the call and invokes ListMachineImagesFunc if set.
+func (f *Fake) ListMachineImages(ctx context.Context, listRequest *ListMachineImagesRequest) (r0 []MachineImage, r1 error) {
+	f.Record("ListMachineImages", ctx, listRequest)
+	if f.ListMachineImagesFunc != nil {
+		return f.ListMachineImagesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListMachineTypeCategories records the call and invokes ListMachineTypeCategoriesFunc if set.
+func (f *Fake) ListMachineTypeCategories(ctx context.Context) (r0 []MachineTypeCategory, r1 error) {
+	f.Record("ListMachineTypeCategories", ctx)
+	if f.ListMachineTypeCategoriesFunc != nil {
+		return f.ListMachineTypeCategoriesFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListMachineTypes records the call and invokes ListMachineTypesFunc if set.
+func (f *Fake) ListMachineTypes(ctx context.Context, listRequest *ListMachineTypesRequest) (r0 []MachineType, r1 error) {
+	f.Record("ListMachineTypes", ctx, listRequest)
+	if f.ListMachineTypesFunc != nil {
+		return f.ListMachineTypesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListMachines records the call and invokes ListMachinesFunc if set.
+func (f *Fake) ListMachines(ctx context.Context, listRequest *ListMachinesRequest) (r0 []Machine, r1 error) {
+	f.Record("ListMachines", ctx, listRequest)
+	if f.ListMachinesFunc != nil {
+		return f.ListMachinesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListNatGateways records the call and invokes ListNatGatewaysFunc if set.
+func (f *Fake) ListNatGateways(ctx context.Context, listRequest *ListNatGatewaysRequest) (r0 []VpcNatGateway, r1 error) {
+	f.Record("ListNatGateways", ctx, listRequest)
+	if f.ListNatGatewaysFunc != nil {
+		return f.ListNatGatewaysFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListRegions records the call and invokes ListRegionsFunc if set.
+func (f *Fake) ListRegions(ctx context.Context, listRequest *ListRegionsRequest) (r0 []Region, r1 error) {
+	f.Record("ListRegions", ctx, listRequest)
+	if f.ListRegionsFunc != nil {
+		return f.ListRegionsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListReservedIPs records the call and invokes ListReservedIPsFunc if set.
+func (f *Fake) ListReservedIPs(ctx context.Context, listRequest *ListReservedIPsRequest) (r0 []ReservedIP, r1 error) {
+	f.Record("ListReservedIPs", ctx, listRequest)
+	if f.ListReservedIPsFunc != nil {
+		return f.ListReservedIPsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListRouteTables records the call and invokes ListRouteTablesFunc if set.
+func (f *Fake) ListRouteTables(ctx context.Context, listRequest *ListRouteTablesRequest) (r0 []RouteTable, r1 error) {
+	f.Record("ListRouteTables", ctx, listRequest)
+	if f.ListRouteTablesFunc != nil {
+		return f.ListRouteTablesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListSecurityGroups records the call and invokes ListSecurityGroupsFunc if set.
+func (f *Fake) ListSecurityGroups(ctx context.Context, listRequest *ListSecurityGroupsRequest) (r0 []SecurityGroup, r1 error) {
+	f.Record("ListSecurityGroups", ctx, listRequest)
+	if f.ListSecurityGroupsFunc != nil {
+		return f.ListSecurityGroupsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListSnapshotPolicies records the call and invokes ListSnapshotPoliciesFunc if set.
+func (f *Fake) ListSnapshotPolicies(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) (r0 []SnapshotPolicy, r1 error) {
+	f.Record("ListSnapshotPolicies", ctx, listRequest)
+	if f.ListSnapshotPoliciesFunc != nil {
+		return f.ListSnapshotPoliciesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListSnapshots records the call and invokes ListSnapshotsFunc if set.
+func (f *Fake) ListSnapshots(ctx context.Context, listRequest *ListSnapshotsRequest) (r0 []Snapshot, r1 error) {
+	f.Record("ListSnapshots", ctx, listRequest)
+	if f.ListSnapshotsFunc != nil {
+		return f.ListSnapshotsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListSubnets records the call and invokes ListSubnetsFunc if set.
+func (f *Fake) ListSubnets(ctx context.Context, listRequest *ListSubnetsRequest) (r0 []Subnet, r1 error) {
+	f.Record("ListSubnets", ctx, listRequest)
+	if f.ListSubnetsFunc != nil {
+		return f.ListSubnetsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListTargetGroups records the call and invokes ListTargetGroupsFunc if set.
+func (f *Fake) ListTargetGroups(ctx context.Context, listRequest *ListTargetGroupsRequest) (r0 []VpcLoadbalancerTargetGroup, r1 error) {
+	f.Record("ListTargetGroups", ctx, listRequest)
+	if f.ListTargetGroupsFunc != nil {
+		return f.ListTargetGroupsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListVolumeTypes records the call and invokes ListVolumeTypesFunc if set.
+func (f *Fake) ListVolumeTypes(ctx context.Context, listRequest *ListVolumeTypesRequest) (r0 []VolumeType, r1 error) {
+	f.Record("ListVolumeTypes", ctx, listRequest)
+	if f.ListVolumeTypesFunc != nil {
+		return f.ListVolumeTypesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListVolumes records the call and invokes ListVolumesFunc if set.
+func (f *Fake) ListVolumes(ctx context.Context, listRequest *ListVolumesRequest) (r0 []Volume, r1 error) {
+	f.Record("ListVolumes", ctx, listRequest)
+	if f.ListVolumesFunc != nil {
+		return f.ListVolumesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListVpcFirewallRule records the call and invokes ListVpcFirewallRuleFunc if set.
+func (f *Fake) ListVpcFirewallRule(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) (r0 []VpcFirewallRule, r1 error) {
+	f.Record("ListVpcFirewallRule", ctx, identity, request)
+	if f.ListVpcFirewallRuleFunc != nil {
+		return f.ListVpcFirewallRuleFunc(ctx, identity, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListVpcPeeringConnections records the call and invokes ListVpcPeeringConnectionsFunc if set.
+func (f *Fake) ListVpcPeeringConnections(ctx context.Context, request *ListVpcPeeringConnectionsRequest) (r0 []VpcPeeringConnection, r1 error) {
+	f.Record("ListVpcPeeringConnections", ctx, request)
+	if f.ListVpcPeeringConnectionsFunc != nil {
+		return f.ListVpcPeeringConnectionsFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListVpcs records the call and invokes ListVpcsFunc if set.
+func (f *Fake) ListVpcs(ctx context.Context, request *ListVpcsRequest) (r0 []Vpc, r1 error) {
+	f.Record("ListVpcs", ctx, request)
+	if f.ListVpcsFunc != nil {
+		return f.ListVpcsFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// MachineConsole records the call and invokes MachineConsoleFunc if set.
+func (f *Fake) MachineConsole(ctx context.Context, identity string) (r0 *websocket.Conn, r1 error) {
+	f.Record("MachineConsole", ctx, identity)
+	if f.MachineConsoleFunc != nil {
+		return f.MachineConsoleFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// MachineConsoleSession records the call and invokes MachineConsoleSessionFunc if set.
+func (f *Fake) MachineConsoleSession(ctx context.Context, identity string, opts client.WebsocketOptions) (r0 *client.WebsocketSession, r1 error) {
+	f.Record("MachineConsoleSession", ctx, identity, opts)
+	if f.MachineConsoleSessionFunc != nil {
+		return f.MachineConsoleSessionFunc(ctx, identity, opts)
+	}
+	r1 = f.Err
+	return
+}
+
+// MachineRestart records the call and invokes MachineRestartFunc if set.
+func (f *Fake) MachineRestart(ctx context.Context, identity string) (r0 error) {
+	f.Record("MachineRestart", ctx, identity)
+	if f.MachineRestartFunc != nil {
+		return f.MachineRestartFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// MachineStart records the call and invokes MachineStartFunc if set.
+func (f *Fake) MachineStart(ctx context.Context, identity string) (r0 error) {
+	f.Record("MachineStart", ctx, identity)
+	if f.MachineStartFunc != nil {
+		return f.MachineStartFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// MachineStop records the call and invokes MachineStopFunc if set.
+func (f *Fake) MachineStop(ctx context.Context, identity string) (r0 error) {
+	f.Record("MachineStop", ctx, identity)
+	if f.MachineStopFunc != nil {
+		return f.MachineStopFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// RejectVpcPeeringConnection records the call and invokes RejectVpcPeeringConnectionFunc if set.
+func (f *Fake) RejectVpcPeeringConnection(ctx context.Context, identity string, reject RejectVpcPeeringConnectionRequest) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("RejectVpcPeeringConnection", ctx, identity, reject)
+	if f.RejectVpcPeeringConnectionFunc != nil {
+		return f.RejectVpcPeeringConnectionFunc(ctx, identity, reject)
+	}
+	r1 = f.Err
+	return
+}
+
+// SetTargetGroupServerAttachments records the call and invokes SetTargetGroupServerAttachmentsFunc if set.
+func (f *Fake) SetTargetGroupServerAttachments(ctx context.Context, setRequest TargetGroupAttachmentsBatch) (r0 error) {
+	f.Record("SetTargetGroupServerAttachments", ctx, setRequest)
+	if f.SetTargetGroupServerAttachmentsFunc != nil {
+		return f.SetTargetGroupServerAttachmentsFunc(ctx, setRequest)
+	}
+	r0 = f.Err
+	return
+}
+
+// UpdateCloudInitTemplate records the call and invokes UpdateCloudInitTemplateFunc if set.
+func (f *Fake) UpdateCloudInitTemplate(ctx context.Context, identity string, update UpdateCloudInitTemplateRequest) (r0 *CloudInitTemplate, r1 error) {
+	f.Record("UpdateCloudInitTemplate", ctx, identity, update)
+	if f.UpdateCloudInitTemplateFunc != nil {
+		return f.UpdateCloudInitTemplateFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateListener records the call and invokes UpdateListenerFunc if set.
+func (f *Fake) UpdateListener(ctx context.Context, loadbalancerID string, listenerID string, update UpdateListener) (r0 *VpcLoadbalancerListener, r1 error) {
+	f.Record("UpdateListener", ctx, loadbalancerID, listenerID, update)
+	if f.UpdateListenerFunc != nil {
+		return f.UpdateListenerFunc(ctx, loadbalancerID, listenerID, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateLoadbalancer records the call and invokes UpdateLoadbalancerFunc if set.
+func (f *Fake) UpdateLoadbalancer(ctx context.Context, loadbalancerIdentity string, update UpdateLoadbalancer) (r0 *VpcLoadbalancer, r1 error) {
+	f.Record("UpdateLoadbalancer", ctx, loadbalancerIdentity, update)
+	if f.UpdateLoadbalancerFunc != nil {
+		return f.UpdateLoadbalancerFunc(ctx, loadbalancerIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateMachine records the call and invokes UpdateMachineFunc if set.
+func (f *Fake) UpdateMachine(ctx context.Context, identity string, update UpdateMachine) (r0 *Machine, r1 error) {
+	f.Record("UpdateMachine", ctx, identity, update)
+	if f.UpdateMachineFunc != nil {
+		return f.UpdateMachineFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateNatGateway records the call and invokes UpdateNatGatewayFunc if set.
+func (f *Fake) UpdateNatGateway(ctx context.Context, identity string, update UpdateVpcNatGateway) (r0 *VpcNatGateway, r1 error) {
+	f.Record("UpdateNatGateway", ctx, identity, update)
+	if f.UpdateNatGatewayFunc != nil {
+		return f.UpdateNatGatewayFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateReservedIP records the call and invokes UpdateReservedIPFunc if set.
+func (f *Fake) UpdateReservedIP(ctx context.Context, identity string, update UpdateReservedIpRequest) (r0 *ReservedIP, r1 error) {
+	f.Record("UpdateReservedIP", ctx, identity, update)
+	if f.UpdateReservedIPFunc != nil {
+		return f.UpdateReservedIPFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateRouteTable records the call and invokes UpdateRouteTableFunc if set.
+func (f *Fake) UpdateRouteTable(ctx context.Context, identity string, update UpdateRouteTable) (r0 *RouteTable, r1 error) {
+	f.Record("UpdateRouteTable", ctx, identity, update)
+	if f.UpdateRouteTableFunc != nil {
+		return f.UpdateRouteTableFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateRouteTableRoute records the call and invokes UpdateRouteTableRouteFunc if set.
+func (f *Fake) UpdateRouteTableRoute(ctx context.Context, identity string, routeIdentity string, update UpdateRouteTableRoute) (r0 *RouteEntry, r1 error) {
+	f.Record("UpdateRouteTableRoute", ctx, identity, routeIdentity, update)
+	if f.UpdateRouteTableRouteFunc != nil {
+		return f.UpdateRouteTableRouteFunc(ctx, identity, routeIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateRouteTableRoutes records the call and invokes UpdateRouteTableRoutesFunc if set.
+func (f *Fake) UpdateRouteTableRoutes(ctx context.Context, identity string, update UpdateRouteTableRoutes) (r0 []RouteEntry, r1 error) {
+	f.Record("UpdateRouteTableRoutes", ctx, identity, update)
+	if f.UpdateRouteTableRoutesFunc != nil {
+		return f.UpdateRouteTableRoutesFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateSecurityGroup records the call and invokes UpdateSecurityGroupFunc if set.
+func (f *Fake) UpdateSecurityGroup(ctx context.Context, identity string, update UpdateSecurityGroupRequest) (r0 *SecurityGroup, r1 error) {
+	f.Record("UpdateSecurityGroup", ctx, identity, update)
+	if f.UpdateSecurityGroupFunc != nil {
+		return f.UpdateSecurityGroupFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateSnapshot records the call and invokes UpdateSnapshotFunc if set.
+func (f *Fake) UpdateSnapshot(ctx context.Context, identity string, update UpdateSnapshotRequest) (r0 *Snapshot, r1 error) {
+	f.Record("UpdateSnapshot", ctx, identity, update)
+	if f.UpdateSnapshotFunc != nil {
+		return f.UpdateSnapshotFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateSnapshotPolicy records the call and invokes UpdateSnapshotPolicyFunc if set.
+func (f *Fake) UpdateSnapshotPolicy(ctx context.Context, identity string, update UpdateSnapshotPolicyRequest) (r0 *SnapshotPolicy, r1 error) {
+	f.Record("UpdateSnapshotPolicy", ctx, identity, update)
+	if f.UpdateSnapshotPolicyFunc != nil {
+		return f.UpdateSnapshotPolicyFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateSubnet records the call and invokes UpdateSubnetFunc if set.
+func (f *Fake) UpdateSubnet(ctx context.Context, identity string, update UpdateSubnet) (r0 *Subnet, r1 error) {
+	f.Record("UpdateSubnet", ctx, identity, update)
+	if f.UpdateSubnetFunc != nil {
+		return f.UpdateSubnetFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateTargetGroup records the call and invokes UpdateTargetGroupFunc if set.
+func (f *Fake) UpdateTargetGroup(ctx context.Context, update UpdateTargetGroupRequest) (r0 *VpcLoadbalancerTargetGroup, r1 error) {
+	f.Record("UpdateTargetGroup", ctx, update)
+	if f.UpdateTargetGroupFunc != nil {
+		return f.UpdateTargetGroupFunc(ctx, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateVolume records the call and invokes UpdateVolumeFunc if set.
+func (f *Fake) UpdateVolume(ctx context.Context, identity string, update UpdateVolume) (r0 *Volume, r1 error) {
+	f.Record("UpdateVolume", ctx, identity, update)
+	if f.UpdateVolumeFunc != nil {
+		return f.UpdateVolumeFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateVpc records the call and invokes UpdateVpcFunc if set.
+func (f *Fake) UpdateVpc(ctx context.Context, identity string, update UpdateVpc) (r0 *Vpc, r1 error) {
+	f.Record("UpdateVpc", ctx, identity, update)
+	if f.UpdateVpcFunc != nil {
+		return f.UpdateVpcFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateVpcFirewallRule records the call and invokes UpdateVpcFirewallRuleFunc if set.
+func (f *Fake) UpdateVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string, update UpdateVpcFirewallRuleRequest) (r0 *VpcFirewallRule, r1 error) {
+	f.Record("UpdateVpcFirewallRule", ctx, identity, firewallRuleIdentity, update)
+	if f.UpdateVpcFirewallRuleFunc != nil {
+		return f.UpdateVpcFirewallRuleFunc(ctx, identity, firewallRuleIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateVpcPeeringConnection records the call and invokes UpdateVpcPeeringConnectionFunc if set.
+func (f *Fake) UpdateVpcPeeringConnection(ctx context.Context, identity string, update UpdateVpcPeeringConnectionRequest) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("UpdateVpcPeeringConnection", ctx, identity, update)
+	if f.UpdateVpcPeeringConnectionFunc != nil {
+		return f.UpdateVpcPeeringConnectionFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilLoadbalancerIsDeleted records the call and invokes WaitUntilLoadbalancerIsDeletedFunc if set.
+func (f *Fake) WaitUntilLoadbalancerIsDeleted(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilLoadbalancerIsDeleted", ctx, loadbalancerIdentity, opts)
+	if f.WaitUntilLoadbalancerIsDeletedFunc != nil {
+		return f.WaitUntilLoadbalancerIsDeletedFunc(ctx, loadbalancerIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilLoadbalancerIsReady records the call and invokes WaitUntilLoadbalancerIsReadyFunc if set.
+func (f *Fake) WaitUntilLoadbalancerIsReady(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilLoadbalancerIsReady", ctx, loadbalancerIdentity, opts)
+	if f.WaitUntilLoadbalancerIsReadyFunc != nil {
+		return f.WaitUntilLoadbalancerIsReadyFunc(ctx, loadbalancerIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilLoadbalancerIsStatus records the call and invokes WaitUntilLoadbalancerIsStatusFunc if set.
+func (f *Fake) WaitUntilLoadbalancerIsStatus(ctx context.Context, loadbalancerIdentity string, status string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilLoadbalancerIsStatus", ctx, loadbalancerIdentity, status, opts)
+	if f.WaitUntilLoadbalancerIsStatusFunc != nil {
+		return f.WaitUntilLoadbalancerIsStatusFunc(ctx, loadbalancerIdentity, status, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilMachineDeleted records the call and invokes WaitUntilMachineDeletedFunc if set.
+func (f *Fake) WaitUntilMachineDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilMachineDeleted", ctx, identity, opts)
+	if f.WaitUntilMachineDeletedFunc != nil {
+		return f.WaitUntilMachineDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilMachineIsRunning records the call and invokes WaitUntilMachineIsRunningFunc if set.
+func (f *Fake) WaitUntilMachineIsRunning(ctx context.Context, identity string, opts ...wait.Option) (r0 *Machine, r1 error) {
+	f.Record("WaitUntilMachineIsRunning", ctx, identity, opts)
+	if f.WaitUntilMachineIsRunningFunc != nil {
+		return f.WaitUntilMachineIsRunningFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilMachineIsState records the call and invokes WaitUntilMachineIsStateFunc if set.
+func (f *Fake) WaitUntilMachineIsState(ctx context.Context, identity string, state MachineState, opts ...wait.Option) (r0 *Machine, r1 error) {
+	f.Record("WaitUntilMachineIsState", ctx, identity, state, opts)
+	if f.WaitUntilMachineIsStateFunc != nil {
+		return f.WaitUntilMachineIsStateFunc(ctx, identity, state, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilMachineIsStopped records the call and invokes WaitUntilMachineIsStoppedFunc if set.
+func (f *Fake) WaitUntilMachineIsStopped(ctx context.Context, identity string, opts ...wait.Option) (r0 *Machine, r1 error) {
+	f.Record("WaitUntilMachineIsStopped", ctx, identity, opts)
+	if f.WaitUntilMachineIsStoppedFunc != nil {
+		return f.WaitUntilMachineIsStoppedFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilNatGatewayDeleted records the call and invokes WaitUntilNatGatewayDeletedFunc if set.
+func (f *Fake) WaitUntilNatGatewayDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilNatGatewayDeleted", ctx, identity, opts)
+	if f.WaitUntilNatGatewayDeletedFunc != nil {
+		return f.WaitUntilNatGatewayDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilNatGatewayHasEndpoint records the call and invokes WaitUntilNatGatewayHasEndpointFunc if set.
+func (f *Fake) WaitUntilNatGatewayHasEndpoint(ctx context.Context, identity string, opts ...wait.Option) (r0 *VpcNatGateway, r1 error) {
+	f.Record("WaitUntilNatGatewayHasEndpoint", ctx, identity, opts)
+	if f.WaitUntilNatGatewayHasEndpointFunc != nil {
+		return f.WaitUntilNatGatewayHasEndpointFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilNatGatewayReady records the call and invokes WaitUntilNatGatewayReadyFunc if set.
+func (f *Fake) WaitUntilNatGatewayReady(ctx context.Context, identity string, opts ...wait.Option) (r0 *VpcNatGateway, r1 error) {
+	f.Record("WaitUntilNatGatewayReady", ctx, identity, opts)
+	if f.WaitUntilNatGatewayReadyFunc != nil {
+		return f.WaitUntilNatGatewayReadyFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilReservedIPIsDeleted records the call and invokes WaitUntilReservedIPIsDeletedFunc if set.
+func (f *Fake) WaitUntilReservedIPIsDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilReservedIPIsDeleted", ctx, identity, opts)
+	if f.WaitUntilReservedIPIsDeletedFunc != nil {
+		return f.WaitUntilReservedIPIsDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilReservedIPIsStatus records the call and invokes WaitUntilReservedIPIsStatusFunc if set.
+func (f *Fake) WaitUntilReservedIPIsStatus(ctx context.Context, identity string, status ReservedIpStatus, opts ...wait.Option) (r0 *ReservedIP, r1 error) {
+	f.Record("WaitUntilReservedIPIsStatus", ctx, identity, status, opts)
+	if f.WaitUntilReservedIPIsStatusFunc != nil {
+		return f.WaitUntilReservedIPIsStatusFunc(ctx, identity, status, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilSecurityGroupIsDeleted records the call and invokes WaitUntilSecurityGroupIsDeletedFunc if set.
+func (f *Fake) WaitUntilSecurityGroupIsDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilSecurityGroupIsDeleted", ctx, identity, opts)
+	if f.WaitUntilSecurityGroupIsDeletedFunc != nil {
+		return f.WaitUntilSecurityGroupIsDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilSecurityGroupIsReady records the call and invokes WaitUntilSecurityGroupIsReadyFunc if set.
+func (f *Fake) WaitUntilSecurityGroupIsReady(ctx context.Context, identity string, opts ...wait.Option) (r0 *SecurityGroup, r1 error) {
+	f.Record("WaitUntilSecurityGroupIsReady", ctx, identity, opts)
+	if f.WaitUntilSecurityGroupIsReadyFunc != nil {
+		return f.WaitUntilSecurityGroupIsReadyFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilSnapshotIsAvailable records the call and invokes WaitUntilSnapshotIsAvailableFunc if set.
+func (f *Fake) WaitUntilSnapshotIsAvailable(ctx context.Context, snapshotIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilSnapshotIsAvailable", ctx, snapshotIdentity, opts)
+	if f.WaitUntilSnapshotIsAvailableFunc != nil {
+		return f.WaitUntilSnapshotIsAvailableFunc(ctx, snapshotIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilSnapshotIsDeleted records the call and invokes WaitUntilSnapshotIsDeletedFunc if set.
+func (f *Fake) WaitUntilSnapshotIsDeleted(ctx context.Context, snapshotIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilSnapshotIsDeleted", ctx, snapshotIdentity, opts)
+	if f.WaitUntilSnapshotIsDeletedFunc != nil {
+		return f.WaitUntilSnapshotIsDeletedFunc(ctx, snapshotIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilSnapshotIsStatus records the call and invokes WaitUntilSnapshotIsStatusFunc if set.
+func (f *Fake) WaitUntilSnapshotIsStatus(ctx context.Context, snapshotIdentity string, status SnapshotStatus, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilSnapshotIsStatus", ctx, snapshotIdentity, status, opts)
+	if f.WaitUntilSnapshotIsStatusFunc != nil {
+		return f.WaitUntilSnapshotIsStatusFunc(ctx, snapshotIdentity, status, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilSubnetDeleted records the call and invokes WaitUntilSubnetDeletedFunc if set.
+func (f *Fake) WaitUntilSubnetDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilSubnetDeleted", ctx, identity, opts)
+	if f.WaitUntilSubnetDeletedFunc != nil {
+		return f.WaitUntilSubnetDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilSubnetReady records the call and invokes WaitUntilSubnetReadyFunc if set.
+func (f *Fake) WaitUntilSubnetReady(ctx context.Context, identity string, opts ...wait.Option) (r0 *Subnet, r1 error) {
+	f.Record("WaitUntilSubnetReady", ctx, identity, opts)
+	if f.WaitUntilSubnetReadyFunc != nil {
+		return f.WaitUntilSubnetReadyFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilVolumeIsAttached records the call and invokes WaitUntilVolumeIsAttachedFunc if set.
+func (f *Fake) WaitUntilVolumeIsAttached(ctx context.Context, volumeIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVolumeIsAttached", ctx, volumeIdentity, opts)
+	if f.WaitUntilVolumeIsAttachedFunc != nil {
+		return f.WaitUntilVolumeIsAttachedFunc(ctx, volumeIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVolumeIsAvailable records the call and invokes WaitUntilVolumeIsAvailableFunc if set.
+func (f *Fake) WaitUntilVolumeIsAvailable(ctx context.Context, volumeIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVolumeIsAvailable", ctx, volumeIdentity, opts)
+	if f.WaitUntilVolumeIsAvailableFunc != nil {
+		return f.WaitUntilVolumeIsAvailableFunc(ctx, volumeIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVolumeIsDeleted records the call and invokes WaitUntilVolumeIsDeletedFunc if set.
+func (f *Fake) WaitUntilVolumeIsDeleted(ctx context.Context, volumeIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVolumeIsDeleted", ctx, volumeIdentity, opts)
+	if f.WaitUntilVolumeIsDeletedFunc != nil {
+		return f.WaitUntilVolumeIsDeletedFunc(ctx, volumeIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVolumeIsStatus records the call and invokes WaitUntilVolumeIsStatusFunc if set.
+func (f *Fake) WaitUntilVolumeIsStatus(ctx context.Context, volumeIdentity string, status string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVolumeIsStatus", ctx, volumeIdentity, status, opts)
+	if f.WaitUntilVolumeIsStatusFunc != nil {
+		return f.WaitUntilVolumeIsStatusFunc(ctx, volumeIdentity, status, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVpcIsDeleted records the call and invokes WaitUntilVpcIsDeletedFunc if set.
+func (f *Fake) WaitUntilVpcIsDeleted(ctx context.Context, vpcIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVpcIsDeleted", ctx, vpcIdentity, opts)
+	if f.WaitUntilVpcIsDeletedFunc != nil {
+		return f.WaitUntilVpcIsDeletedFunc(ctx, vpcIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVpcIsReady records the call and invokes WaitUntilVpcIsReadyFunc if set.
+func (f *Fake) WaitUntilVpcIsReady(ctx context.Context, vpcIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVpcIsReady", ctx, vpcIdentity, opts)
+	if f.WaitUntilVpcIsReadyFunc != nil {
+		return f.WaitUntilVpcIsReadyFunc(ctx, vpcIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVpcIsStatus records the call and invokes WaitUntilVpcIsStatusFunc if set.
+func (f *Fake) WaitUntilVpcIsStatus(ctx context.Context, vpcIdentity string, status string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVpcIsStatus", ctx, vpcIdentity, status, opts)
+	if f.WaitUntilVpcIsStatusFunc != nil {
+		return f.WaitUntilVpcIsStatusFunc(ctx, vpcIdentity, status, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVpcPeeringConnectionIsActive records the call and invokes WaitUntilVpcPeeringConnectionIsActiveFunc if set.
+func (f *Fake) WaitUntilVpcPeeringConnectionIsActive(ctx context.Context, identity string, opts ...wait.Option) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("WaitUntilVpcPeeringConnectionIsActive", ctx, identity, opts)
+	if f.WaitUntilVpcPeeringConnectionIsActiveFunc != nil {
+		return f.WaitUntilVpcPeeringConnectionIsActiveFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilVpcPeeringConnectionIsDeleted records the call and invokes WaitUntilVpcPeeringConnectionIsDeletedFunc if set.
+func (f *Fake) WaitUntilVpcPeeringConnectionIsDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilVpcPeeringConnectionIsDeleted", ctx, identity, opts)
+	if f.WaitUntilVpcPeeringConnectionIsDeletedFunc != nil {
+		return f.WaitUntilVpcPeeringConnectionIsDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilVpcPeeringConnectionIsStatus records the call and invokes WaitUntilVpcPeeringConnectionIsStatusFunc if set.
+func (f *Fake) WaitUntilVpcPeeringConnectionIsStatus(ctx context.Context, identity string, status VpcPeeringConnectionStatus, opts ...wait.Option) (r0 *VpcPeeringConnection, r1 error) {
+	f.Record("WaitUntilVpcPeeringConnectionIsStatus", ctx, identity, status, opts)
+	if f.WaitUntilVpcPeeringConnectionIsStatusFunc != nil {
+		return f.WaitUntilVpcPeeringConnectionIsStatusFunc(ctx, identity, status, opts...)
+	}
+	r1 = f.Err
+	return
+}
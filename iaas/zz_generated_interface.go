@@ -0,0 +1,611 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package iaas
+
+import (
+	"context"
+	"iter"
+
+	"github.com/gorilla/websocket"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AcceptVpcPeeringConnection accepts a VPC peering connection.
+	AcceptVpcPeeringConnection(ctx context.Context, identity string, accept AcceptVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+
+	// AllCloudInitTemplates returns an iterator over the results of ListCloudInitTemplates.
+	AllCloudInitTemplates(ctx context.Context) iter.Seq2[CloudInitTemplate, error]
+
+	// AllListeners returns an iterator over the results of ListListeners.
+	AllListeners(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) iter.Seq2[VpcLoadbalancerListener, error]
+
+	// AllLoadbalancers returns an iterator over the results of ListLoadbalancers.
+	AllLoadbalancers(ctx context.Context, listRequest *ListLoadbalancersRequest) iter.Seq2[VpcLoadbalancer, error]
+
+	// AllMachineImages returns an iterator over the results of ListMachineImages.
+	AllMachineImages(ctx context.Context, listRequest *ListMachineImagesRequest) iter.Seq2[MachineImage, error]
+
+	// AllMachineTypeCategories returns an iterator over the results of ListMachineTypeCategories.
+	AllMachineTypeCategories(ctx context.Context) iter.Seq2[MachineTypeCategory, error]
+
+	// AllMachineTypes returns an iterator over the results of ListMachineTypes.
+	AllMachineTypes(ctx context.Context, listRequest *ListMachineTypesRequest) iter.Seq2[MachineType, error]
+
+	// AllMachines returns an iterator over the results of ListMachines.
+	AllMachines(ctx context.Context, listRequest *ListMachinesRequest) iter.Seq2[Machine, error]
+
+	// AllNatGateways returns an iterator over the results of ListNatGateways.
+	AllNatGateways(ctx context.Context, listRequest *ListNatGatewaysRequest) iter.Seq2[VpcNatGateway, error]
+
+	// AllRegions returns an iterator over the results of ListRegions.
+	AllRegions(ctx context.Context, listRequest *ListRegionsRequest) iter.Seq2[Region, error]
+
+	// AllReservedIPs returns an iterator over the results of ListReservedIPs.
+	AllReservedIPs(ctx context.Context, listRequest *ListReservedIPsRequest) iter.Seq2[ReservedIP, error]
+
+	// AllRouteTables returns an iterator over the results of ListRouteTables.
+	AllRouteTables(ctx context.Context, listRequest *ListRouteTablesRequest) iter.Seq2[RouteTable, error]
+
+	// AllSecurityGroups returns an iterator over the results of ListSecurityGroups.
+	AllSecurityGroups(ctx context.Context, listRequest *ListSecurityGroupsRequest) iter.Seq2[SecurityGroup, error]
+
+	// AllSnapshotPolicies returns an iterator over the results of ListSnapshotPolicies.
+	AllSnapshotPolicies(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) iter.Seq2[SnapshotPolicy, error]
+
+	// AllSnapshots returns an iterator over the results of ListSnapshots.
+	AllSnapshots(ctx context.Context, listRequest *ListSnapshotsRequest) iter.Seq2[Snapshot, error]
+
+	// AllSubnets returns an iterator over the results of ListSubnets.
+	AllSubnets(ctx context.Context, listRequest *ListSubnetsRequest) iter.Seq2[Subnet, error]
+
+	// AllTargetGroups returns an iterator over the results of ListTargetGroups.
+	AllTargetGroups(ctx context.Context, listRequest *ListTargetGroupsRequest) iter.Seq2[VpcLoadbalancerTargetGroup, error]
+
+	// AllVolumeTypes returns an iterator over the results of ListVolumeTypes.
+	AllVolumeTypes(ctx context.Context, listRequest *ListVolumeTypesRequest) iter.Seq2[VolumeType, error]
+
+	// AllVolumes returns an iterator over the results of ListVolumes.
+	AllVolumes(ctx context.Context, listRequest *ListVolumesRequest) iter.Seq2[Volume, error]
+
+	// AllVpcFirewallRules returns an iterator over the results of ListVpcFirewallRule.
+	AllVpcFirewallRules(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) iter.Seq2[VpcFirewallRule, error]
+
+	// AllVpcPeeringConnections returns an iterator over the results of ListVpcPeeringConnections.
+	AllVpcPeeringConnections(ctx context.Context, request *ListVpcPeeringConnectionsRequest) iter.Seq2[VpcPeeringConnection, error]
+
+	// AllVpcs returns an iterator over the results of ListVpcs.
+	AllVpcs(ctx context.Context, request *ListVpcsRequest) iter.Seq2[Vpc, error]
+
+	// AssociateReservedIP attaches the reserved IP to a load balancer or NAT gateway.
+	AssociateReservedIP(ctx context.Context, identity string, body AssociateReservedIpRequest) (*ReservedIP, error)
+
+	// AttachServerToTargetGroup attaches a server to a target group.
+	AttachServerToTargetGroup(ctx context.Context, attachRequest AttachTargetGroupRequest) (*LoadbalancerTargetGroupAttachment, error)
+
+	// AttachVolume attaches a volume to a machine.
+	AttachVolume(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest) (*VolumeAttachment, error)
+
+	// AttachVolumeAndWaitUntilAttached attaches a volume to a machine and waits until it is attached.
+	// The user is expected to provide a timeout context.
+	AttachVolumeAndWaitUntilAttached(ctx context.Context, volumeIdentity string, attach AttachVolumeRequest, opts ...wait.Option) error
+
+	// BatchUpdateSecurityGroupEgressRules updates the egress rules for a specific security group.
+	BatchUpdateSecurityGroupEgressRules(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) ([]SecurityGroupRule, error)
+
+	// BatchUpdateSecurityGroupIngressRules updates the ingress rules for a specific security group.
+	BatchUpdateSecurityGroupIngressRules(ctx context.Context, identity string, update BatchUpdateSecurityGroupRulesRequest) ([]SecurityGroupRule, error)
+
+	// BulkUpdateVpcFirewallRule updates multiple VPC firewall rules.
+	BulkUpdateVpcFirewallRule(ctx context.Context, identity string, update BulkUpdateVpcFirewallRuleRequest) ([]VpcFirewallRule, error)
+
+	// CreateCloudInitTemplate creates a new cloud-init template with the provided configuration.
+	// Cloud-init templates contain scripts that run when instances are first booted.
+	CreateCloudInitTemplate(ctx context.Context, create CreateCloudInitTemplateRequest) (*CloudInitTemplate, error)
+
+	// CreateListener creates a new loadbalancer listener.
+	CreateListener(ctx context.Context, loadbalancerID string, create CreateListener) (*VpcLoadbalancerListener, error)
+
+	// CreateLoadbalancer creates a new loadbalancer.
+	CreateLoadbalancer(ctx context.Context, create CreateLoadbalancer) (*VpcLoadbalancer, error)
+
+	// CreateMachine creates a new Machine.
+	CreateMachine(ctx context.Context, create CreateMachine) (*Machine, error)
+
+	// CreateNatGateway creates a new NatGateway.
+	CreateNatGateway(ctx context.Context, create CreateVpcNatGateway) (*VpcNatGateway, error)
+
+	// CreateReservedIP creates a reserved IP (201).
+	CreateReservedIP(ctx context.Context, create CreateReservedIpRequest) (*ReservedIP, error)
+
+	// CreateRouteTable creates a new RouteTable.
+	CreateRouteTable(ctx context.Context, create CreateRouteTable) (*RouteTable, error)
+
+	// CreateRouteTableRoute creates a new route for a specific RouteTable.
+	CreateRouteTableRoute(ctx context.Context, identity string, create CreateRouteTableRoute) (*RouteEntry, error)
+
+	// CreateSecurityGroup creates a new security group.
+	CreateSecurityGroup(ctx context.Context, create CreateSecurityGroupRequest) (*SecurityGroup, error)
+
+	// CreateSnapshot creates a new snapshot.
+	CreateSnapshot(ctx context.Context, create CreateSnapshotRequest) (*Snapshot, error)
+
+	// CreateSnapshotPolicy creates a new snapshot policy.
+	CreateSnapshotPolicy(ctx context.Context, create CreateSnapshotPolicyRequest) (*SnapshotPolicy, error)
+
+	// CreateSubnet creates a new Subnet.
+	CreateSubnet(ctx context.Context, create CreateSubnet) (*Subnet, error)
+
+	// CreateTargetGroup creates a new loadbalancer target group.
+	CreateTargetGroup(ctx context.Context, create CreateTargetGroup) (*VpcLoadbalancerTargetGroup, error)
+
+	// CreateVolume creates a new volume.
+	CreateVolume(ctx context.Context, create CreateVolume) (*Volume, error)
+
+	// CreateVpc creates a new VPC.
+	CreateVpc(ctx context.Context, create CreateVpc) (*Vpc, error)
+
+	// CreateVpcFirewallRule creates a new VPC firewall rule.
+	CreateVpcFirewallRule(ctx context.Context, identity string, create CreateVpcFirewallRuleRequest) (*VpcFirewallRule, error)
+
+	// CreateVpcPeeringConnection creates a new VPC peering connection.
+	CreateVpcPeeringConnection(ctx context.Context, create CreateVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+
+	// DeleteCloudInitTemplate permanently removes a cloud-init template from the system.
+	// This operation cannot be undone and will affect any instances using this template.
+	DeleteCloudInitTemplate(ctx context.Context, identity string) error
+
+	// DeleteListener deletes a specific loadbalancer listener by its identity.
+	DeleteListener(ctx context.Context, loadbalancerID string, listenerID string) error
+
+	// DeleteLoadbalancer deletes a specific loadbalancer by its identity.
+	DeleteLoadbalancer(ctx context.Context, loadbalancerIdentity string) error
+
+	// DeleteMachine deletes a specific Machine by its identity.
+	DeleteMachine(ctx context.Context, identity string) error
+
+	// DeleteNatGateway deletes a specific NatGateway by its identity.
+	DeleteNatGateway(ctx context.Context, identity string) error
+
+	// DeleteReservedIP deletes a reserved IP. If attached, the API disassociates first (204).
+	DeleteReservedIP(ctx context.Context, identity string) error
+
+	// DeleteRouteTable deletes a specific RouteTable by its identity.
+	DeleteRouteTable(ctx context.Context, identity string) error
+
+	// DeleteRouteTableRoute deletes a specific route for a specific RouteTable.
+	DeleteRouteTableRoute(ctx context.Context, identity string, routeIdentity string) error
+
+	// DeleteSecurityGroup deletes a specific security group by its identity.
+	DeleteSecurityGroup(ctx context.Context, identity string) error
+
+	// DeleteSnapshot deletes a snapshot.
+	DeleteSnapshot(ctx context.Context, identity string) error
+
+	// DeleteSnapshotPolicy deletes a snapshot policy.
+	DeleteSnapshotPolicy(ctx context.Context, identity string) error
+
+	// DeleteSubnet deletes a specific Subnet by its identity.
+	DeleteSubnet(ctx context.Context, identity string) error
+
+	// DeleteTargetGroup deletes a specific loadbalancer target group by its identity.
+	DeleteTargetGroup(ctx context.Context, deleteRequest DeleteTargetGroupRequest) error
+
+	// DeleteVolume deletes a volume.
+	DeleteVolume(ctx context.Context, identity string) error
+
+	// DeleteVpc deletes a specific VPC by its identity.
+	DeleteVpc(ctx context.Context, identity string) error
+
+	// DeleteVpcFirewallRule deletes a specific VPC firewall rule by its identity.
+	DeleteVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string) error
+
+	// DeleteVpcPeeringConnection deletes a specific VPC peering connection by its identity.
+	DeleteVpcPeeringConnection(ctx context.Context, identity string) error
+
+	// DetachServerFromTargetGroup detaches a server from a target group.
+	DetachServerFromTargetGroup(ctx context.Context, detachRequest DetachTargetRequest) error
+
+	// DetachVolume detaches a volume from a machine.
+	DetachVolume(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest) error
+
+	// DetachVolumeAndWaitUntilAvailable detaches a volume from a machine and waits until it is available.
+	// The user is expected to provide a timeout context.
+	DetachVolumeAndWaitUntilAvailable(ctx context.Context, volumeIdentity string, detach DetachVolumeRequest, opts ...wait.Option) error
+
+	// DisassociateReservedIP detaches the reserved IP from its current target.
+	DisassociateReservedIP(ctx context.Context, identity string) (*ReservedIP, error)
+
+	// GetCloudInitTemplate retrieves a specific cloud-init template by its unique identity.
+	// The identity is a UUID that uniquely identifies the template in the system.
+	GetCloudInitTemplate(ctx context.Context, identity string) (*CloudInitTemplate, error)
+
+	// GetListener retrieves a specific loadbalancer listener by its identity.
+	GetListener(ctx context.Context, getRequest GetLoadbalancerListenerRequest) (*VpcLoadbalancerListener, error)
+
+	// GetLoadbalancer retrieves a specific loadbalancer by its identity.
+	GetLoadbalancer(ctx context.Context, loadbalancerIdentity string) (*VpcLoadbalancer, error)
+
+	// GetMachine retrieves a specific Machine by its identity.
+	GetMachine(ctx context.Context, identity string) (*Machine, error)
+
+	// GetMachineImage retrieves a specific MachineImage by its identity.
+	// The identity is the unique identifier for the MachineImage.
+	GetMachineImage(ctx context.Context, identity string) (*MachineImage, error)
+
+	// GetMachineType retrieves a specific MachineType by its identity.
+	// The identity is the unique identifier for the MachineType.
+	GetMachineType(ctx context.Context, identity string) (*MachineType, error)
+
+	// GetNatGateway retrieves a specific NatGateway by its identity.
+	GetNatGateway(ctx context.Context, identity string) (*VpcNatGateway, error)
+
+	// GetRegion retrieves a specific Region by its identity.
+	GetRegion(ctx context.Context, identity string) (*Region, error)
+
+	// GetReservedIP returns a reserved IP by identity.
+	GetReservedIP(ctx context.Context, identity string) (*ReservedIP, error)
+
+	// GetRouteTable retrieves a specific RouteTable by its identity.
+	GetRouteTable(ctx context.Context, identity string) (*RouteTable, error)
+
+	// GetRouteTableRoute retrieves a specific route for a specific RouteTable.
+	GetRouteTableRoute(ctx context.Context, identity string, routeIdentity string) (*RouteEntry, error)
+
+	// GetSecurityGroup retrieves a specific security group by its identity.
+	GetSecurityGroup(ctx context.Context, identity string) (*SecurityGroup, error)
+
+	// GetSnapshot retrieves a specific snapshot by its identity.
+	// The identity is the unique identifier for the snapshot.
+	GetSnapshot(ctx context.Context, identity string) (*Snapshot, error)
+
+	// GetSnapshotPolicy retrieves a specific snapshot policy by its identity.
+	// The identity is the unique identifier for the snapshot policy.
+	GetSnapshotPolicy(ctx context.Context, identity string) (*SnapshotPolicy, error)
+
+	// GetSubnet retrieves a specific Subnet by its identity.
+	// It returns an error if the subnet is not found.
+	// Example: subnet, err := c.GetSubnet(ctx, "subnet-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to get subnet: %v", err)
+	// 	}
+	GetSubnet(ctx context.Context, identity string) (*Subnet, error)
+
+	// GetTargetGroup retrieves a specific loadbalancer target group by its identity.
+	GetTargetGroup(ctx context.Context, getRequest GetTargetGroupRequest) (*VpcLoadbalancerTargetGroup, error)
+
+	// GetVolume retrieves a specific volume by its identity.
+	// The identity is the unique identifier for the volume.
+	GetVolume(ctx context.Context, identity string) (*Volume, error)
+
+	// GetVolumeType gets a volume type by its identity.
+	GetVolumeType(ctx context.Context, identity string) (*VolumeType, error)
+
+	// GetVpc retrieves a specific VPC by its identity.
+	GetVpc(ctx context.Context, identity string) (*Vpc, error)
+
+	// GetVpcFirewallRule retrieves a specific VPC firewall rule by its identity.
+	GetVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string) (*VpcFirewallRule, error)
+
+	// GetVpcPeeringConnection retrieves a specific VPC peering connection by its identity.
+	GetVpcPeeringConnection(ctx context.Context, identity string) (*VpcPeeringConnection, error)
+
+	// ListCloudInitTemplates retrieves all available cloud-init templates.
+	// Returns a slice of CloudInitTemplate objects that can be used for instance initialization.
+	ListCloudInitTemplates(ctx context.Context) ([]CloudInitTemplate, error)
+
+	// ListListeners lists all listeners for a specific loadbalancer.
+	ListListeners(ctx context.Context, listRequest *ListLoadbalancerListenersRequest) ([]VpcLoadbalancerListener, error)
+
+	// ListLoadbalancers lists all loadbalancers for a given organisation.
+	ListLoadbalancers(ctx context.Context, listRequest *ListLoadbalancersRequest) ([]VpcLoadbalancer, error)
+
+	// ListMachineImages lists all MachineImages for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListMachineImages(ctx context.Context, listRequest *ListMachineImagesRequest) ([]MachineImage, error)
+
+	// ListMachineTypeCategories lists all MachineTypeCategories for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListMachineTypeCategories(ctx context.Context) ([]MachineTypeCategory, error)
+
+	// ListMachineTypes lists all MachineTypes for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListMachineTypes(ctx context.Context, listRequest *ListMachineTypesRequest) ([]MachineType, error)
+
+	// ListMachines lists all Machines for a given organisation.
+	ListMachines(ctx context.Context, listRequest *ListMachinesRequest) ([]Machine, error)
+
+	// ListNatGateways lists all NatGateways for a given organisation.
+	ListNatGateways(ctx context.Context, listRequest *ListNatGatewaysRequest) ([]VpcNatGateway, error)
+
+	// ListRegions lists all Regions for a given organisation.
+	ListRegions(ctx context.Context, listRequest *ListRegionsRequest) ([]Region, error)
+
+	// ListReservedIPs lists reserved IPs for the organisation (auth context).
+	ListReservedIPs(ctx context.Context, listRequest *ListReservedIPsRequest) ([]ReservedIP, error)
+
+	// ListRouteTables lists all RouteTables for a given organisation.
+	ListRouteTables(ctx context.Context, listRequest *ListRouteTablesRequest) ([]RouteTable, error)
+
+	// ListSecurityGroups lists all security groups for a given organisation.
+	ListSecurityGroups(ctx context.Context, listRequest *ListSecurityGroupsRequest) ([]SecurityGroup, error)
+
+	// ListSnapshotPolicies lists all snapshot policies for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListSnapshotPolicies(ctx context.Context, listRequest *ListSnapshotPoliciesRequest) ([]SnapshotPolicy, error)
+
+	// ListSnapshots lists all snapshots for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListSnapshots(ctx context.Context, listRequest *ListSnapshotsRequest) ([]Snapshot, error)
+
+	// ListSubnets lists all Subnets for a given organisation.
+	ListSubnets(ctx context.Context, listRequest *ListSubnetsRequest) ([]Subnet, error)
+
+	// ListTargetGroups lists all loadbalancer target groups for a given organisation.
+	ListTargetGroups(ctx context.Context, listRequest *ListTargetGroupsRequest) ([]VpcLoadbalancerTargetGroup, error)
+
+	// ListVolumeTypes lists all volume types.
+	ListVolumeTypes(ctx context.Context, listRequest *ListVolumeTypesRequest) ([]VolumeType, error)
+
+	// ListVolumes lists all volumes for the current organisation.
+	// The current organisation is determined by the client's organisation identity.
+	ListVolumes(ctx context.Context, listRequest *ListVolumesRequest) ([]Volume, error)
+
+	// ListVpcFirewallRule lists all VPC firewall rules for a given VPC identity.
+	ListVpcFirewallRule(ctx context.Context, identity string, request *ListVpcFirewallRulesRequest) ([]VpcFirewallRule, error)
+
+	// ListVpcPeeringConnections lists all VPC peering connections for the current organisation.
+	ListVpcPeeringConnections(ctx context.Context, request *ListVpcPeeringConnectionsRequest) ([]VpcPeeringConnection, error)
+
+	// ListVpcs lists all VPCs for a given organisation.
+	ListVpcs(ctx context.Context, request *ListVpcsRequest) ([]Vpc, error)
+
+	// console
+	// This creates a new console for the machine and returns a websocket connection to the console.
+	MachineConsole(ctx context.Context, identity string) (*websocket.Conn, error)
+
+	// MachineConsoleSession opens a console for the machine as a websocket session, which keeps
+	// the connection alive and can reconnect when it drops. Use NetConn on the session to treat
+	// the console as a stream.
+	MachineConsoleSession(ctx context.Context, identity string, opts client.WebsocketOptions) (*client.WebsocketSession, error)
+
+	MachineRestart(ctx context.Context, identity string) error
+
+	// start,stop,restart
+	MachineStart(ctx context.Context, identity string) error
+
+	MachineStop(ctx context.Context, identity string) error
+
+	// RejectVpcPeeringConnection rejects a VPC peering connection.
+	RejectVpcPeeringConnection(ctx context.Context, identity string, reject RejectVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+
+	// SetTargetGroupServerAttachments sets the server attachments for a target group.
+	// This will replace the existing attachments with the ones provided in the request.
+	// Note: Any existing attachments not present in the request will be detached.
+	SetTargetGroupServerAttachments(ctx context.Context, setRequest TargetGroupAttachmentsBatch) error
+
+	// UpdateCloudInitTemplate updates an existing cloud-init template with the provided configuration.
+	UpdateCloudInitTemplate(ctx context.Context, identity string, update UpdateCloudInitTemplateRequest) (*CloudInitTemplate, error)
+
+	// UpdateListener updates an existing loadbalancer listener.
+	UpdateListener(ctx context.Context, loadbalancerID string, listenerID string, update UpdateListener) (*VpcLoadbalancerListener, error)
+
+	// UpdateLoadbalancer updates an existing loadbalancer.
+	UpdateLoadbalancer(ctx context.Context, loadbalancerIdentity string, update UpdateLoadbalancer) (*VpcLoadbalancer, error)
+
+	// UpdateMachine updates an existing Machine.
+	UpdateMachine(ctx context.Context, identity string, update UpdateMachine) (*Machine, error)
+
+	// UpdateNatGateway updates an existing NatGateway.
+	UpdateNatGateway(ctx context.Context, identity string, update UpdateVpcNatGateway) (*VpcNatGateway, error)
+
+	// UpdateReservedIP updates name, description, labels, and annotations.
+	UpdateReservedIP(ctx context.Context, identity string, update UpdateReservedIpRequest) (*ReservedIP, error)
+
+	// UpdateRouteTable updates an existing RouteTable.
+	UpdateRouteTable(ctx context.Context, identity string, update UpdateRouteTable) (*RouteTable, error)
+
+	// UpdateRouteTableRoute updates a specific route for a specific RouteTable.
+	UpdateRouteTableRoute(ctx context.Context, identity string, routeIdentity string, update UpdateRouteTableRoute) (*RouteEntry, error)
+
+	// UpdateRouteTableRoutes updates the routes for a specific RouteTable.
+	UpdateRouteTableRoutes(ctx context.Context, identity string, update UpdateRouteTableRoutes) ([]RouteEntry, error)
+
+	// UpdateSecurityGroup updates an existing security group.
+	UpdateSecurityGroup(ctx context.Context, identity string, update UpdateSecurityGroupRequest) (*SecurityGroup, error)
+
+	// UpdateSnapshot updates a snapshot.
+	UpdateSnapshot(ctx context.Context, identity string, update UpdateSnapshotRequest) (*Snapshot, error)
+
+	// UpdateSnapshotPolicy updates a snapshot policy.
+	UpdateSnapshotPolicy(ctx context.Context, identity string, update UpdateSnapshotPolicyRequest) (*SnapshotPolicy, error)
+
+	// UpdateSubnet updates an existing Subnet.
+	UpdateSubnet(ctx context.Context, identity string, update UpdateSubnet) (*Subnet, error)
+
+	// UpdateTargetGroup updates an existing loadbalancer target group.
+	UpdateTargetGroup(ctx context.Context, update UpdateTargetGroupRequest) (*VpcLoadbalancerTargetGroup, error)
+
+	// UpdateVolume updates a volume.
+	UpdateVolume(ctx context.Context, identity string, update UpdateVolume) (*Volume, error)
+
+	// UpdateVpc updates an existing VPC.
+	UpdateVpc(ctx context.Context, identity string, update UpdateVpc) (*Vpc, error)
+
+	// UpdateVpcFirewallRule updates an existing VPC firewall rule.
+	UpdateVpcFirewallRule(ctx context.Context, identity string, firewallRuleIdentity string, update UpdateVpcFirewallRuleRequest) (*VpcFirewallRule, error)
+
+	// UpdateVpcPeeringConnection updates an existing VPC peering connection.
+	UpdateVpcPeeringConnection(ctx context.Context, identity string, update UpdateVpcPeeringConnectionRequest) (*VpcPeeringConnection, error)
+
+	// WaitUntilLoadbalancerIsDeleted waits until a loadbalancer is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilLoadbalancerIsDeleted(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error
+
+	// WaitUntilLoadbalancerIsReady waits until a loadbalancer is ready.
+	// The user is expected to provide a timeout context.
+	WaitUntilLoadbalancerIsReady(ctx context.Context, loadbalancerIdentity string, opts ...wait.Option) error
+
+	// WaitUntilLoadbalancerIsStatus waits until a loadbalancer is in a specific status.
+	// It returns an error if the loadbalancer fails instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilLoadbalancerIsStatus(ctx context.Context, loadbalancerIdentity string, status string, opts ...wait.Option) error
+
+	// WaitUntilMachineDeleted waits until the machine is deleted.
+	// It returns an error if the machine fails to delete.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// err := c.WaitUntilMachineDeleted(ctxt, "machine-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for machine to be deleted: %v", err)
+	// 	}
+	// 	defer cancel()
+	WaitUntilMachineDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilMachineIsRunning waits until the machine is running.
+	// The user is expected to provide a timeout context.
+	WaitUntilMachineIsRunning(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error)
+
+	// WaitUntilMachineIsState waits until the machine is in a specific state.
+	// It returns an error if the machine is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilMachineIsState(ctx context.Context, identity string, state MachineState, opts ...wait.Option) (*Machine, error)
+
+	// WaitUntilMachineIsStopped waits until the machine is stopped.
+	// The user is expected to provide a timeout context.
+	WaitUntilMachineIsStopped(ctx context.Context, identity string, opts ...wait.Option) (*Machine, error)
+
+	// WaitUntilNatGatewayDeleted waits until the nat gateway is deleted.
+	// It returns an error if the nat gateway fails to delete.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// err := c.WaitUntilNatGatewayDeleted(ctxt, "nat-gateway-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for nat gateway to be deleted: %v", err)
+	// 	}
+	// 	defer cancel()
+	WaitUntilNatGatewayDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilNatGatewayHasEndpoint waits until the nat gateway has an endpoint.
+	// It returns the nat gateway when it has an endpoint or an error if the nat gateway fails to get an endpoint.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// natGateway, err := c.WaitUntilNatGatewayHasEndpoint(ctxt, "nat-gateway-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for nat gateway to have an endpoint: %v", err)
+	// 	}
+	// 	defer cancel()
+	WaitUntilNatGatewayHasEndpoint(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error)
+
+	// WaitUntilNatGatewayReady waits until the nat gateway is ready.
+	// It returns the nat gateway when it is ready or an error if the nat gateway fails, or is being deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilNatGatewayReady(ctx context.Context, identity string, opts ...wait.Option) (*VpcNatGateway, error)
+
+	// WaitUntilReservedIPIsDeleted waits until a reserved IP is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilReservedIPIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilReservedIPIsStatus waits until a reserved IP is in a specific status.
+	// It returns an error if the reserved IP fails instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilReservedIPIsStatus(ctx context.Context, identity string, status ReservedIpStatus, opts ...wait.Option) (*ReservedIP, error)
+
+	// WaitUntilSecurityGroupIsDeleted waits until a security group is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilSecurityGroupIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilSecurityGroupIsReady waits until a security group is ready.
+	// It returns an error if the security group enters the error status instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilSecurityGroupIsReady(ctx context.Context, identity string, opts ...wait.Option) (*SecurityGroup, error)
+
+	// WaitUntilSnapshotIsAvailable waits until a snapshot is available.
+	// The user is expected to provide a timeout context.
+	WaitUntilSnapshotIsAvailable(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error
+
+	// WaitUntilSnapshotIsDeleted waits until a snapshot is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilSnapshotIsDeleted(ctx context.Context, snapshotIdentity string, opts ...wait.Option) error
+
+	// WaitUntilSnapshotIsStatus waits until a snapshot is in a specific status.
+	// It returns an error if the snapshot fails instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilSnapshotIsStatus(ctx context.Context, snapshotIdentity string, status SnapshotStatus, opts ...wait.Option) error
+
+	// WaitUntilSubnetDeleted waits until the subnet is deleted.
+	// It returns an error if the subnet fails to delete.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// err := c.WaitUntilSubnetDeleted(ctxt, "subnet-123")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for subnet to be deleted: %v", err)
+	// 	}
+	// 	defer cancel()
+	WaitUntilSubnetDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilSubnetReady waits until the subnet is ready.
+	// It returns the subnet when it is ready or an error if the subnet fails to become ready. This could happen if the subnet is being deleted, or entered a failed state.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// subnet, err := c.WaitUntilSubnetReady(ctxt, "subnet-123")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for subnet to become ready: %v", err)
+	// 	}
+	// 	defer cancel()
+	WaitUntilSubnetReady(ctx context.Context, identity string, opts ...wait.Option) (*Subnet, error)
+
+	// WaitUntilVolumeIsAttached waits until a volume is attached.
+	// The user is expected to provide a timeout context.
+	WaitUntilVolumeIsAttached(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+
+	// WaitUntilVolumeIsAvailable waits until a volume is available.
+	// The user is expected to provide a timeout context.
+	WaitUntilVolumeIsAvailable(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+
+	// WaitUntilVolumeIsDeleted waits until a volume is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilVolumeIsDeleted(ctx context.Context, volumeIdentity string, opts ...wait.Option) error
+
+	// WaitUntilVolumeIsStatus waits until a volume is in a specific status.
+	// It returns an error if the volume fails instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilVolumeIsStatus(ctx context.Context, volumeIdentity string, status string, opts ...wait.Option) error
+
+	// WaitUntilVpcIsDeleted waits until a VPC is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcIsDeleted(ctx context.Context, vpcIdentity string, opts ...wait.Option) error
+
+	// WaitUntilVpcIsReady waits until a VPC is ready.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcIsReady(ctx context.Context, vpcIdentity string, opts ...wait.Option) error
+
+	// WaitUntilVpcIsStatus waits until a VPC is in a specific status.
+	// It returns an error if the VPC fails instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcIsStatus(ctx context.Context, vpcIdentity string, status string, opts ...wait.Option) error
+
+	// WaitUntilVpcPeeringConnectionIsActive waits until a VPC peering connection is active.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcPeeringConnectionIsActive(ctx context.Context, identity string, opts ...wait.Option) (*VpcPeeringConnection, error)
+
+	// WaitUntilVpcPeeringConnectionIsDeleted waits until a VPC peering connection is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcPeeringConnectionIsDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilVpcPeeringConnectionIsStatus waits until a VPC peering connection is in a specific status.
+	// It returns an error if the peering connection is rejected, fails, expires or is deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilVpcPeeringConnectionIsStatus(ctx context.Context, identity string, status VpcPeeringConnectionStatus, opts ...wait.Option) (*VpcPeeringConnection, error)
+}
+
+var _ Interface = (*Client)(nil)
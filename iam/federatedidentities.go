@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -35,6 +36,13 @@ func (c *Client) ListFederatedIdentities(ctx context.Context, request *ListFeder
 	return identities, nil
 }
 
+// AllFederatedIdentities returns an iterator over the results of ListFederatedIdentities.
+func (c *Client) AllFederatedIdentities(ctx context.Context, request *ListFederatedIdentitiesRequest) iter.Seq2[FederatedIdentity, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]FederatedIdentity, error) {
+		return c.ListFederatedIdentities(ctx, request)
+	})
+}
+
 // GetFederatedIdentity retrieves a specific federated identity
 func (c *Client) GetFederatedIdentity(ctx context.Context, identity string) (*FederatedIdentity, error) {
 	var federatedIdentity *FederatedIdentity
@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -34,6 +35,13 @@ func (c *Client) ListFederatedIdentityProviders(ctx context.Context, request *Li
 	return providers, nil
 }
 
+// AllFederatedIdentityProviders returns an iterator over the results of ListFederatedIdentityProviders.
+func (c *Client) AllFederatedIdentityProviders(ctx context.Context, request *ListFederatedIdentityProvidersRequest) iter.Seq2[FederatedIdentityProvider, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]FederatedIdentityProvider, error) {
+		return c.ListFederatedIdentityProviders(ctx, request)
+	})
+}
+
 // GetFederatedIdentityProvider retrieves a specific federated identity provider
 func (c *Client) GetFederatedIdentityProvider(ctx context.Context, identity string) (*FederatedIdentityProvider, error) {
 	var provider *FederatedIdentityProvider
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -42,3 +43,10 @@ func (c *Client) ListOrganisationMemberInvites(ctx context.Context, request *Lis
 	}
 	return invites, nil
 }
+
+// AllOrganisationMemberInvites returns an iterator over the results of ListOrganisationMemberInvites.
+func (c *Client) AllOrganisationMemberInvites(ctx context.Context, request *ListOrganisationMemberInvitesRequest) iter.Seq2[OrganisationMemberInvite, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]OrganisationMemberInvite, error) {
+		return c.ListOrganisationMemberInvites(ctx, request)
+	})
+}
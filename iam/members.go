@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -28,6 +29,13 @@ func (c *Client) ListOrganisationMembers(ctx context.Context, request *ListMembe
 	return members, nil
 }
 
+// AllOrganisationMembers returns an iterator over the results of ListOrganisationMembers.
+func (c *Client) AllOrganisationMembers(ctx context.Context, request *ListMembersRequest) iter.Seq2[OrganisationMember, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]OrganisationMember, error) {
+		return c.ListOrganisationMembers(ctx, request)
+	})
+}
+
 // DeleteOrganisationMember deletes a member from an organisation
 func (c *Client) DeleteOrganisationMember(ctx context.Context, identity string) error {
 	req := c.R()
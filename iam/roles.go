@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -36,6 +37,13 @@ func (c *Client) ListOrganisationRoles(ctx context.Context, request *ListOrganis
 	return roles, nil
 }
 
+// AllOrganisationRoles returns an iterator over the results of ListOrganisationRoles.
+func (c *Client) AllOrganisationRoles(ctx context.Context, request *ListOrganisationRolesRequest) iter.Seq2[OrganisationRole, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]OrganisationRole, error) {
+		return c.ListOrganisationRoles(ctx, request)
+	})
+}
+
 // GetOrganisationRole retrieves a specific organisation role by its identity.
 func (c *Client) GetOrganisationRole(ctx context.Context, identity string) (*OrganisationRole, error) {
 	var role *OrganisationRole
@@ -126,6 +134,13 @@ func (c *Client) ListRoleBindings(ctx context.Context, roleIdentity string, requ
 	return bindings, nil
 }
 
+// AllRoleBindings returns an iterator over the results of ListRoleBindings.
+func (c *Client) AllRoleBindings(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) iter.Seq2[OrganisationRoleBinding, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]OrganisationRoleBinding, error) {
+		return c.ListRoleBindings(ctx, roleIdentity, request)
+	})
+}
+
 // CreateRoleBinding creates a new role binding for an organisation role.
 func (c *Client) CreateRoleBinding(ctx context.Context, roleIdentity string, create CreateRoleBinding) (*OrganisationRoleBinding, error) {
 	var binding *OrganisationRoleBinding
@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/thalassa-cloud/client-go/filters"
@@ -36,6 +37,13 @@ func (c *Client) ListServiceAccounts(ctx context.Context, request *ListServiceAc
 	return accounts, nil
 }
 
+// AllServiceAccounts returns an iterator over the results of ListServiceAccounts.
+func (c *Client) AllServiceAccounts(ctx context.Context, request *ListServiceAccountsRequest) iter.Seq2[ServiceAccount, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]ServiceAccount, error) {
+		return c.ListServiceAccounts(ctx, request)
+	})
+}
+
 // GetServiceAccount retrieves a specific service account
 func (c *Client) GetServiceAccount(ctx context.Context, identity string) (*ServiceAccount, error) {
 	var account *ServiceAccount
@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -38,6 +39,13 @@ func (c *Client) ListTeams(ctx context.Context, request *ListTeamsRequest) ([]Te
 	return teams, nil
 }
 
+// AllTeams returns an iterator over the results of ListTeams.
+func (c *Client) AllTeams(ctx context.Context, request *ListTeamsRequest) iter.Seq2[Team, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Team, error) {
+		return c.ListTeams(ctx, request)
+	})
+}
+
 type GetTeamRequest struct {
 }
 
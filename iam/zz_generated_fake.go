@@ -0,0 +1,617 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AddRoleRuleFunc, if set, handles calls to AddRoleRule.
+	AddRoleRuleFunc func(ctx context.Context, roleIdentity string, rule OrganisationRolePermissionRule) (*OrganisationRolePermissionRule, error)
+	// AddTeamMemberFunc, if set, handles calls to AddTeamMember.
+	AddTeamMemberFunc func(ctx context.Context, teamID string, request AddTeamMemberRequest) error
+	// AllFederatedIdentitiesFunc, if set, handles calls to AllFederatedIdentities.
+	AllFederatedIdentitiesFunc func(ctx context.Context, request *ListFederatedIdentitiesRequest) iter.Seq2[FederatedIdentity, error]
+	// AllFederatedIdentityProvidersFunc, if set, handles calls to AllFederatedIdentityProviders.
+	AllFederatedIdentityProvidersFunc func(ctx context.Context, request *ListFederatedIdentityProvidersRequest) iter.Seq2[FederatedIdentityProvider, error]
+	// AllOrganisationMemberInvitesFunc, if set, handles calls to AllOrganisationMemberInvites.
+	AllOrganisationMemberInvitesFunc func(ctx context.Context, request *ListOrganisationMemberInvitesRequest) iter.Seq2[OrganisationMemberInvite, error]
+	// AllOrganisationMembersFunc, if set, handles calls to AllOrganisationMembers.
+	AllOrganisationMembersFunc func(ctx context.Context, request *ListMembersRequest) iter.Seq2[OrganisationMember, error]
+	// AllOrganisationRolesFunc, if set, handles calls to AllOrganisationRoles.
+	AllOrganisationRolesFunc func(ctx context.Context, request *ListOrganisationRolesRequest) iter.Seq2[OrganisationRole, error]
+	// AllRoleBindingsFunc, if set, handles calls to AllRoleBindings.
+	AllRoleBindingsFunc func(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) iter.Seq2[OrganisationRoleBinding, error]
+	// AllServiceAccountsFunc, if set, handles calls to AllServiceAccounts.
+	AllServiceAccountsFunc func(ctx context.Context, request *ListServiceAccountsRequest) iter.Seq2[ServiceAccount, error]
+	// AllTeamsFunc, if set, handles calls to AllTeams.
+	AllTeamsFunc func(ctx context.Context, request *ListTeamsRequest) iter.Seq2[Team, error]
+	// CreateFederatedIdentityFunc, if set, handles calls to CreateFederatedIdentity.
+	CreateFederatedIdentityFunc func(ctx context.Context, create CreateFederatedIdentityRequest) (*FederatedIdentity, error)
+	// CreateFederatedIdentityProviderFunc, if set, handles calls to CreateFederatedIdentityProvider.
+	CreateFederatedIdentityProviderFunc func(ctx context.Context, create CreateFederatedIdentityProviderRequest) (*FederatedIdentityProvider, error)
+	// CreateOrganisationRoleFunc, if set, handles calls to CreateOrganisationRole.
+	CreateOrganisationRoleFunc func(ctx context.Context, create CreateOrganisationRoleRequest) (*OrganisationRole, error)
+	// CreateRoleBindingFunc, if set, handles calls to CreateRoleBinding.
+	CreateRoleBindingFunc func(ctx context.Context, roleIdentity string, create CreateRoleBinding) (*OrganisationRoleBinding, error)
+	// CreateServiceAccountFunc, if set, handles calls to CreateServiceAccount.
+	CreateServiceAccountFunc func(ctx context.Context, create CreateServiceAccountRequest) (*ServiceAccount, error)
+	// CreateServiceAccountAccessCredentialsFunc, if set, handles calls to CreateServiceAccountAccessCredentials.
+	CreateServiceAccountAccessCredentialsFunc func(ctx context.Context, serviceAccountIdentity string, request CreateServiceAccountAccessCredentialRequest) (*ServiceAccountCreatedAccessCredential, error)
+	// CreateTeamFunc, if set, handles calls to CreateTeam.
+	CreateTeamFunc func(ctx context.Context, create CreateTeam) (*Team, error)
+	// DeleteFederatedIdentityFunc, if set, handles calls to DeleteFederatedIdentity.
+	DeleteFederatedIdentityFunc func(ctx context.Context, identity string) error
+	// DeleteFederatedIdentityProviderFunc, if set, handles calls to DeleteFederatedIdentityProvider.
+	DeleteFederatedIdentityProviderFunc func(ctx context.Context, identity string) error
+	// DeleteOrganisationMemberFunc, if set, handles calls to DeleteOrganisationMember.
+	DeleteOrganisationMemberFunc func(ctx context.Context, identity string) error
+	// DeleteOrganisationRoleFunc, if set, handles calls to DeleteOrganisationRole.
+	DeleteOrganisationRoleFunc func(ctx context.Context, identity string) error
+	// DeleteRoleBindingFunc, if set, handles calls to DeleteRoleBinding.
+	DeleteRoleBindingFunc func(ctx context.Context, roleIdentity string, bindingIdentity string) error
+	// DeleteRuleFromRoleFunc, if set, handles calls to DeleteRuleFromRole.
+	DeleteRuleFromRoleFunc func(ctx context.Context, roleIdentity string, ruleIdentity string) error
+	// DeleteServiceAccountFunc, if set, handles calls to DeleteServiceAccount.
+	DeleteServiceAccountFunc func(ctx context.Context, identity string) error
+	// DeleteServiceAccountAccessCredentialsFunc, if set, handles calls to DeleteServiceAccountAccessCredentials.
+	DeleteServiceAccountAccessCredentialsFunc func(ctx context.Context, serviceAccountIdentity string, credentialIdentity string) error
+	// DeleteTeamFunc, if set, handles calls to DeleteTeam.
+	DeleteTeamFunc func(ctx context.Context, identity string) error
+	// GetFederatedIdentityFunc, if set, handles calls to GetFederatedIdentity.
+	GetFederatedIdentityFunc func(ctx context.Context, identity string) (*FederatedIdentity, error)
+	// GetFederatedIdentityProviderFunc, if set, handles calls to GetFederatedIdentityProvider.
+	GetFederatedIdentityProviderFunc func(ctx context.Context, identity string) (*FederatedIdentityProvider, error)
+	// GetOrganisationRoleFunc, if set, handles calls to GetOrganisationRole.
+	GetOrganisationRoleFunc func(ctx context.Context, identity string) (*OrganisationRole, error)
+	// GetServiceAccountFunc, if set, handles calls to GetServiceAccount.
+	GetServiceAccountFunc func(ctx context.Context, identity string) (*ServiceAccount, error)
+	// GetServiceAccountAccessCredentialsFunc, if set, handles calls to GetServiceAccountAccessCredentials.
+	GetServiceAccountAccessCredentialsFunc func(ctx context.Context, serviceAccountIdentity string) ([]ServiceAccountAccessCredential, error)
+	// GetTeamFunc, if set, handles calls to GetTeam.
+	GetTeamFunc func(ctx context.Context, identity string, arg2 *GetTeamRequest) (*Team, error)
+	// ListFederatedIdentitiesFunc, if set, handles calls to ListFederatedIdentities.
+	ListFederatedIdentitiesFunc func(ctx context.Context, request *ListFederatedIdentitiesRequest) ([]FederatedIdentity, error)
+	// ListFederatedIdentityProvidersFunc, if set, handles calls to ListFederatedIdentityProviders.
+	ListFederatedIdentityProvidersFunc func(ctx context.Context, request *ListFederatedIdentityProvidersRequest) ([]FederatedIdentityProvider, error)
+	// ListOrganisationMemberInvitesFunc, if set, handles calls to ListOrganisationMemberInvites.
+	ListOrganisationMemberInvitesFunc func(ctx context.Context, request *ListOrganisationMemberInvitesRequest) ([]OrganisationMemberInvite, error)
+	// ListOrganisationMembersFunc, if set, handles calls to ListOrganisationMembers.
+	ListOrganisationMembersFunc func(ctx context.Context, request *ListMembersRequest) ([]OrganisationMember, error)
+	// ListOrganisationRolesFunc, if set, handles calls to ListOrganisationRoles.
+	ListOrganisationRolesFunc func(ctx context.Context, request *ListOrganisationRolesRequest) ([]OrganisationRole, error)
+	// ListRoleBindingsFunc, if set, handles calls to ListRoleBindings.
+	ListRoleBindingsFunc func(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) ([]OrganisationRoleBinding, error)
+	// ListServiceAccountsFunc, if set, handles calls to ListServiceAccounts.
+	ListServiceAccountsFunc func(ctx context.Context, request *ListServiceAccountsRequest) ([]ServiceAccount, error)
+	// ListTeamsFunc, if set, handles calls to ListTeams.
+	ListTeamsFunc func(ctx context.Context, request *ListTeamsRequest) ([]Team, error)
+	// RemoveTeamMemberFunc, if set, handles calls to RemoveTeamMember.
+	RemoveTeamMemberFunc func(ctx context.Context, teamID string, memberIdentity string) error
+	// UpdateFederatedIdentityFunc, if set, handles calls to UpdateFederatedIdentity.
+	UpdateFederatedIdentityFunc func(ctx context.Context, identity string, update UpdateFederatedIdentityRequest) (*FederatedIdentity, error)
+	// UpdateFederatedIdentityProviderFunc, if set, handles calls to UpdateFederatedIdentityProvider.
+	UpdateFederatedIdentityProviderFunc func(ctx context.Context, identity string, update UpdateFederatedIdentityProviderRequest) (*FederatedIdentityProvider, error)
+	// UpdateOrganisationMemberFunc, if set, handles calls to UpdateOrganisationMember.
+	UpdateOrganisationMemberFunc func(ctx context.Context, identity string, request UpdateOrganisationMemberRequest) error
+	// UpdateServiceAccountFunc, if set, handles calls to UpdateServiceAccount.
+	UpdateServiceAccountFunc func(ctx context.Context, identity string, update UpdateServiceAccountRequest) (*ServiceAccount, error)
+	// UpdateTeamFunc, if set, handles calls to UpdateTeam.
+	UpdateTeamFunc func(ctx context.Context, identity string, update UpdateTeam) (*Team, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AddRoleRule records the call and invokes AddRoleRuleFunc if set.
+func (f *Fake) AddRoleRule(ctx context.Context, roleIdentity string, rule OrganisationRolePermissionRule) (r0 *OrganisationRolePermissionRule, r1 error) {
+	f.Record("AddRoleRule", ctx, roleIdentity, rule)
+	if f.AddRoleRuleFunc != nil {
+		return f.AddRoleRuleFunc(ctx, roleIdentity, rule)
+	}
+	r1 = f.Err
+	return
+}
+
+// AddTeamMember records the call and invokes AddTeamMemberFunc if set.
+func (f *Fake) AddTeamMember(ctx context.Context, teamID string, request AddTeamMemberRequest) (r0 error) {
+	f.Record("AddTeamMember", ctx, teamID, request)
+	if f.AddTeamMemberFunc != nil {
+		return f.AddTeamMemberFunc(ctx, teamID, request)
+	}
+	r0 = f.Err
+	return
+}
+
+// AllFederatedIdentities records the call and invokes AllFederatedIdentitiesFunc if set.
+func (f *Fake) AllFederatedIdentities(ctx context.Context, request *ListFederatedIdentitiesRequest) (r0 iter.Seq2[FederatedIdentity, error]) {
+	f.Record("AllFederatedIdentities", ctx, request)
+	if f.AllFederatedIdentitiesFunc != nil {
+		return f.AllFederatedIdentitiesFunc(ctx, request)
+	}
+	r0 = func(yield func(FederatedIdentity, error) bool) {
+		if f.Err != nil {
+			var zero FederatedIdentity
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllFederatedIdentityProviders records the call and invokes AllFederatedIdentityProvidersFunc if set.
+func (f *Fake) AllFederatedIdentityProviders(ctx context.Context, request *ListFederatedIdentityProvidersRequest) (r0 iter.Seq2[FederatedIdentityProvider, error]) {
+	f.Record("AllFederatedIdentityProviders", ctx, request)
+	if f.AllFederatedIdentityProvidersFunc != nil {
+		return f.AllFederatedIdentityProvidersFunc(ctx, request)
+	}
+	r0 = func(yield func(FederatedIdentityProvider, error) bool) {
+		if f.Err != nil {
+			var zero FederatedIdentityProvider
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllOrganisationMemberInvites records the call and invokes AllOrganisationMemberInvitesFunc if set.
+func (f *Fake) AllOrganisationMemberInvites(ctx context.Context, request *ListOrganisationMemberInvitesRequest) (r0 iter.Seq2[OrganisationMemberInvite, error]) {
+	f.Record("AllOrganisationMemberInvites", ctx, request)
+	if f.AllOrganisationMemberInvitesFunc != nil {
+		return f.AllOrganisationMemberInvitesFunc(ctx, request)
+	}
+	r0 = func(yield func(OrganisationMemberInvite, error) bool) {
+		if f.Err != nil {
+			var zero OrganisationMemberInvite
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllOrganisationMembers records the call and invokes AllOrganisationMembersFunc if set.
+func (f *Fake) AllOrganisationMembers(ctx context.Context, request *ListMembersRequest) (r0 iter.Seq2[OrganisationMember, error]) {
+	f.Record("AllOrganisationMembers", ctx, request)
+	if f.AllOrganisationMembersFunc != nil {
+		return f.AllOrganisationMembersFunc(ctx, request)
+	}
+	r0 = func(yield func(OrganisationMember, error) bool) {
+		if f.Err != nil {
+			var zero OrganisationMember
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllOrganisationRoles records the call and invokes AllOrganisationRolesFunc if set.
+func (f *Fake) AllOrganisationRoles(ctx context.Context, request *ListOrganisationRolesRequest) (r0 iter.Seq2[OrganisationRole, error]) {
+	f.Record("AllOrganisationRoles", ctx, request)
+	if f.AllOrganisationRolesFunc != nil {
+		return f.AllOrganisationRolesFunc(ctx, request)
+	}
+	r0 = func(yield func(OrganisationRole, error) bool) {
+		if f.Err != nil {
+			var zero OrganisationRole
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllRoleBindings records the call and invokes AllRoleBindingsFunc if set.
+func (f *Fake) AllRoleBindings(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) (r0 iter.Seq2[OrganisationRoleBinding, error]) {
+	f.Record("AllRoleBindings", ctx, roleIdentity, request)
+	if f.AllRoleBindingsFunc != nil {
+		return f.AllRoleBindingsFunc(ctx, roleIdentity, request)
+	}
+	r0 = func(yield func(OrganisationRoleBinding, error) bool) {
+		if f.Err != nil {
+			var zero OrganisationRoleBinding
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllServiceAccounts records the call and invokes AllServiceAccountsFunc if set.
+func (f *Fake) AllServiceAccounts(ctx context.Context, request *ListServiceAccountsRequest) (r0 iter.Seq2[ServiceAccount, error]) {
+	f.Record("AllServiceAccounts", ctx, request)
+	if f.AllServiceAccountsFunc != nil {
+		return f.AllServiceAccountsFunc(ctx, request)
+	}
+	r0 = func(yield func(ServiceAccount, error) bool) {
+		if f.Err != nil {
+			var zero ServiceAccount
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllTeams records the call and invokes AllTeamsFunc if set.
+func (f *Fake) AllTeams(ctx context.Context, request *ListTeamsRequest) (r0 iter.Seq2[Team, error]) {
+	f.Record("AllTeams", ctx, request)
+	if f.AllTeamsFunc != nil {
+		return f.AllTeamsFunc(ctx, request)
+	}
+	r0 = func(yield func(Team, error) bool) {
+		if f.Err != nil {
+			var zero Team
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateFederatedIdentity records the call and invokes CreateFederatedIdentityFunc if set.
+func (f *Fake) CreateFederatedIdentity(ctx context.Context, create CreateFederatedIdentityRequest) (r0 *FederatedIdentity, r1 error) {
+	f.Record("CreateFederatedIdentity", ctx, create)
+	if f.CreateFederatedIdentityFunc != nil {
+		return f.CreateFederatedIdentityFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateFederatedIdentityProvider records the call and invokes CreateFederatedIdentityProviderFunc if set.
+func (f *Fake) CreateFederatedIdentityProvider(ctx context.Context, create CreateFederatedIdentityProviderRequest) (r0 *FederatedIdentityProvider, r1 error) {
+	f.Record("CreateFederatedIdentityProvider", ctx, create)
+	if f.CreateFederatedIdentityProviderFunc != nil {
+		return f.CreateFederatedIdentityProviderFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateOrganisationRole records the call and invokes CreateOrganisationRoleFunc if set.
+func (f *Fake) CreateOrganisationRole(ctx context.Context, create CreateOrganisationRoleRequest) (r0 *OrganisationRole, r1 error) {
+	f.Record("CreateOrganisationRole", ctx, create)
+	if f.CreateOrganisationRoleFunc != nil {
+		return f.CreateOrganisationRoleFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateRoleBinding records the call and invokes CreateRoleBindingFunc if set.
+func (f *Fake) CreateRoleBinding(ctx context.Context, roleIdentity string, create CreateRoleBinding) (r0 *OrganisationRoleBinding, r1 error) {
+	f.Record("CreateRoleBinding", ctx, roleIdentity, create)
+	if f.CreateRoleBindingFunc != nil {
+		return f.CreateRoleBindingFunc(ctx, roleIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateServiceAccount records the call and invokes CreateServiceAccountFunc if set.
+func (f *Fake) CreateServiceAccount(ctx context.Context, create CreateServiceAccountRequest) (r0 *ServiceAccount, r1 error) {
+	f.Record("CreateServiceAccount", ctx, create)
+	if f.CreateServiceAccountFunc != nil {
+		return f.CreateServiceAccountFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateServiceAccountAccessCredentials records the call and invokes CreateServiceAccountAccessCredentialsFunc if set.
+func (f *Fake) CreateServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string, request CreateServiceAccountAccessCredentialRequest) (r0 *ServiceAccountCreatedAccessCredential, r1 error) {
+	f.Record("CreateServiceAccountAccessCredentials", ctx, serviceAccountIdentity, request)
+	if f.CreateServiceAccountAccessCredentialsFunc != nil {
+		return f.CreateServiceAccountAccessCredentialsFunc(ctx, serviceAccountIdentity, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateTeam records the call and invokes CreateTeamFunc if set.
+func (f *Fake) CreateTeam(ctx context.Context, create CreateTeam) (r0 *Team, r1 error) {
+	f.Record("CreateTeam", ctx, create)
+	if f.CreateTeamFunc != nil {
+		return f.CreateTeamFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteFederatedIdentity records the call and invokes DeleteFederatedIdentityFunc if set.
+func (f *Fake) DeleteFederatedIdentity(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteFederatedIdentity", ctx, identity)
+	if f.DeleteFederatedIdentityFunc != nil {
+		return f.DeleteFederatedIdentityFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteFederatedIdentityProvider records the call and invokes DeleteFederatedIdentityProviderFunc if set.
+func (f *Fake) DeleteFederatedIdentityProvider(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteFederatedIdentityProvider", ctx, identity)
+	if f.DeleteFederatedIdentityProviderFunc != nil {
+		return f.DeleteFederatedIdentityProviderFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteOrganisationMember records the call and invokes DeleteOrganisationMemberFunc if set.
+func (f *Fake) DeleteOrganisationMember(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteOrganisationMember", ctx, identity)
+	if f.DeleteOrganisationMemberFunc != nil {
+		return f.DeleteOrganisationMemberFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteOrganisationRole records the call and invokes DeleteOrganisationRoleFunc if set.
+func (f *Fake) DeleteOrganisationRole(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteOrganisationRole", ctx, identity)
+	if f.DeleteOrganisationRoleFunc != nil {
+		return f.DeleteOrganisationRoleFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteRoleBinding records the call and invokes DeleteRoleBindingFunc if set.
+func (f *Fake) DeleteRoleBinding(ctx context.Context, roleIdentity string, bindingIdentity string) (r0 error) {
+	f.Record("DeleteRoleBinding", ctx, roleIdentity, bindingIdentity)
+	if f.DeleteRoleBindingFunc != nil {
+		return f.DeleteRoleBindingFunc(ctx, roleIdentity, bindingIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteRuleFromRole records the call and invokes DeleteRuleFromRoleFunc if set.
+func (f *Fake) DeleteRuleFromRole(ctx context.Context, roleIdentity string, ruleIdentity string) (r0 error) {
+	f.Record("DeleteRuleFromRole", ctx, roleIdentity, ruleIdentity)
+	if f.DeleteRuleFromRoleFunc != nil {
+		return f.DeleteRuleFromRoleFunc(ctx, roleIdentity, ruleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteServiceAccount records the call and invokes DeleteServiceAccountFunc if set.
+func (f *Fake) DeleteServiceAccount(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteServiceAccount", ctx, identity)
+	if f.DeleteServiceAccountFunc != nil {
+		return f.DeleteServiceAccountFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteServiceAccountAccessCredentials records the call and invokes DeleteServiceAccountAccessCredentialsFunc if set.
+func (f *Fake) DeleteServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string, credentialIdentity string) (r0 error) {
+	f.Record("DeleteServiceAccountAccessCredentials", ctx, serviceAccountIdentity, credentialIdentity)
+	if f.DeleteServiceAccountAccessCredentialsFunc != nil {
+		return f.DeleteServiceAccountAccessCredentialsFunc(ctx, serviceAccountIdentity, credentialIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteTeam records the call and invokes DeleteTeamFunc if set.
+func (f *Fake) DeleteTeam(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteTeam", ctx, identity)
+	if f.DeleteTeamFunc != nil {
+		return f.DeleteTeamFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetFederatedIdentity records the call and invokes GetFederatedIdentityFunc if set.
+func (f *Fake) GetFederatedIdentity(ctx context.Context, identity string) (r0 *FederatedIdentity, r1 error) {
+	f.Record("GetFederatedIdentity", ctx, identity)
+	if f.GetFederatedIdentityFunc != nil {
+		return f.GetFederatedIdentityFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetFederatedIdentityProvider records the call and invokes GetFederatedIdentityProviderFunc if set.
+func (f *Fake) GetFederatedIdentityProvider(ctx context.Context, identity string) (r0 *FederatedIdentityProvider, r1 error) {
+	f.Record("GetFederatedIdentityProvider", ctx, identity)
+	if f.GetFederatedIdentityProviderFunc != nil {
+		return f.GetFederatedIdentityProviderFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetOrganisationRole records the call and invokes GetOrganisationRoleFunc if set.
+func (f *Fake) GetOrganisationRole(ctx context.Context, identity string) (r0 *OrganisationRole, r1 error) {
+	f.Record("GetOrganisationRole", ctx, identity)
+	if f.GetOrganisationRoleFunc != nil {
+		return f.GetOrganisationRoleFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetServiceAccount records the call and invokes GetServiceAccountFunc if set.
+func (f *Fake) GetServiceAccount(ctx context.Context, identity string) (r0 *ServiceAccount, r1 error) {
+	f.Record("GetServiceAccount", ctx, identity)
+	if f.GetServiceAccountFunc != nil {
+		return f.GetServiceAccountFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetServiceAccountAccessCredentials records the call and invokes GetServiceAccountAccessCredentialsFunc if set.
+func (f *Fake) GetServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string) (r0 []ServiceAccountAccessCredential, r1 error) {
+	f.Record("GetServiceAccountAccessCredentials", ctx, serviceAccountIdentity)
+	if f.GetServiceAccountAccessCredentialsFunc != nil {
+		return f.GetServiceAccountAccessCredentialsFunc(ctx, serviceAccountIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetTeam records the call and invokes GetTeamFunc if set.
+func (f *Fake) GetTeam(ctx context.Context, identity string, arg2 *GetTeamRequest) (r0 *Team, r1 error) {
+	f.Record("GetTeam", ctx, identity, arg2)
+	if f.GetTeamFunc != nil {
+		return f.GetTeamFunc(ctx, identity, arg2)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListFederatedIdentities records the call and invokes ListFederatedIdentitiesFunc if set.
+func (f *Fake) ListFederatedIdentities(ctx context.Context, request *ListFederatedIdentitiesRequest) (r0 []FederatedIdentity, r1 error) {
+	f.Record("ListFederatedIdentities", ctx, request)
+	if f.ListFederatedIdentitiesFunc != nil {
+		return f.ListFederatedIdentitiesFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListFederatedIdentityProviders records the call and invokes ListFederatedIdentityProvidersFunc if set.
+func (f *Fake) ListFederatedIdentityProviders(ctx context.Context, request *ListFederatedIdentityProvidersRequest) (r0 []FederatedIdentityProvider, r1 error) {
+	f.Record("ListFederatedIdentityProviders", ctx, request)
+	if f.ListFederatedIdentityProvidersFunc != nil {
+		return f.ListFederatedIdentityProvidersFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListOrganisationMemberInvites records the call and invokes ListOrganisationMemberInvitesFunc if set.
+func (f *Fake) ListOrganisationMemberInvites(ctx context.Context, request *ListOrganisationMemberInvitesRequest) (r0 []OrganisationMemberInvite, r1 error) {
+	f.Record("ListOrganisationMemberInvites", ctx, request)
+	if f.ListOrganisationMemberInvitesFunc != nil {
+		return f.ListOrganisationMemberInvitesFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListOrganisationMembers records the call and invokes ListOrganisationMembersFunc if set.
+func (f *Fake) ListOrganisationMembers(ctx context.Context, request *ListMembersRequest) (r0 []OrganisationMember, r1 error) {
+	f.Record("ListOrganisationMembers", ctx, request)
+	if f.ListOrganisationMembersFunc != nil {
+		return f.ListOrganisationMembersFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListOrganisationRoles records the call and invokes ListOrganisationRolesFunc if set.
+func (f *Fake) ListOrganisationRoles(ctx context.Context, request *ListOrganisationRolesRequest) (r0 []OrganisationRole, r1 error) {
+	f.Record("ListOrganisationRoles", ctx, request)
+	if f.ListOrganisationRolesFunc != nil {
+		return f.ListOrganisationRolesFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListRoleBindings records the call and invokes ListRoleBindingsFunc if set.
+func (f *Fake) ListRoleBindings(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) (r0 []OrganisationRoleBinding, r1 error) {
+	f.Record("ListRoleBindings", ctx, roleIdentity, request)
+	if f.ListRoleBindingsFunc != nil {
+		return f.ListRoleBindingsFunc(ctx, roleIdentity, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListServiceAccounts records the call and invokes ListServiceAccountsFunc if set.
+func (f *Fake) ListServiceAccounts(ctx context.Context, request *ListServiceAccountsRequest) (r0 []ServiceAccount, r1 error) {
+	f.Record("ListServiceAccounts", ctx, request)
+	if f.ListServiceAccountsFunc != nil {
+		return f.ListServiceAccountsFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListTeams records the call and invokes ListTeamsFunc if set.
+func (f *Fake) ListTeams(ctx context.Context, request *ListTeamsRequest) (r0 []Team, r1 error) {
+	f.Record("ListTeams", ctx, request)
+	if f.ListTeamsFunc != nil {
+		return f.ListTeamsFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// RemoveTeamMember records the call and invokes RemoveTeamMemberFunc if set.
+func (f *Fake) RemoveTeamMember(ctx context.Context, teamID string, memberIdentity string) (r0 error) {
+	f.Record("RemoveTeamMember", ctx, teamID, memberIdentity)
+	if f.RemoveTeamMemberFunc != nil {
+		return f.RemoveTeamMemberFunc(ctx, teamID, memberIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// UpdateFederatedIdentity records the call and invokes UpdateFederatedIdentityFunc if set.
+func (f *Fake) UpdateFederatedIdentity(ctx context.Context, identity string, update UpdateFederatedIdentityRequest) (r0 *FederatedIdentity, r1 error) {
+	f.Record("UpdateFederatedIdentity", ctx, identity, update)
+	if f.UpdateFederatedIdentityFunc != nil {
+		return f.UpdateFederatedIdentityFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateFederatedIdentityProvider records the call and invokes UpdateFederatedIdentityProviderFunc if set.
+func (f *Fake) UpdateFederatedIdentityProvider(ctx context.Context, identity string, update UpdateFederatedIdentityProviderRequest) (r0 *FederatedIdentityProvider, r1 error) {
+	f.Record("UpdateFederatedIdentityProvider", ctx, identity, update)
+	if f.UpdateFederatedIdentityProviderFunc != nil {
+		return f.UpdateFederatedIdentityProviderFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateOrganisationMember records the call and invokes UpdateOrganisationMemberFunc if set.
+func (f *Fake) UpdateOrganisationMember(ctx context.Context, identity string, request UpdateOrganisationMemberRequest) (r0 error) {
+	f.Record("UpdateOrganisationMember", ctx, identity, request)
+	if f.UpdateOrganisationMemberFunc != nil {
+		return f.UpdateOrganisationMemberFunc(ctx, identity, request)
+	}
+	r0 = f.Err
+	return
+}
+
+// UpdateServiceAccount records the call and invokes UpdateServiceAccountFunc if set.
+func (f *Fake) UpdateServiceAccount(ctx context.Context, identity string, update UpdateServiceAccountRequest) (r0 *ServiceAccount, r1 error) {
+	f.Record("UpdateServiceAccount", ctx, identity, update)
+	if f.UpdateServiceAccountFunc != nil {
+		return f.UpdateServiceAccountFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateTeam records the call and invokes UpdateTeamFunc if set.
+func (f *Fake) UpdateTeam(ctx context.Context, identity string, update UpdateTeam) (r0 *Team, r1 error) {
+	f.Record("UpdateTeam", ctx, identity, update)
+	if f.UpdateTeamFunc != nil {
+		return f.UpdateTeamFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,152 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AddRoleRule adds a new permission rule to an organisation role.
+	AddRoleRule(ctx context.Context, roleIdentity string, rule OrganisationRolePermissionRule) (*OrganisationRolePermissionRule, error)
+
+	// AddTeamMember adds a member to a team.
+	AddTeamMember(ctx context.Context, teamID string, request AddTeamMemberRequest) error
+
+	// AllFederatedIdentities returns an iterator over the results of ListFederatedIdentities.
+	AllFederatedIdentities(ctx context.Context, request *ListFederatedIdentitiesRequest) iter.Seq2[FederatedIdentity, error]
+
+	// AllFederatedIdentityProviders returns an iterator over the results of ListFederatedIdentityProviders.
+	AllFederatedIdentityProviders(ctx context.Context, request *ListFederatedIdentityProvidersRequest) iter.Seq2[FederatedIdentityProvider, error]
+
+	// AllOrganisationMemberInvites returns an iterator over the results of ListOrganisationMemberInvites.
+	AllOrganisationMemberInvites(ctx context.Context, request *ListOrganisationMemberInvitesRequest) iter.Seq2[OrganisationMemberInvite, error]
+
+	// AllOrganisationMembers returns an iterator over the results of ListOrganisationMembers.
+	AllOrganisationMembers(ctx context.Context, request *ListMembersRequest) iter.Seq2[OrganisationMember, error]
+
+	// AllOrganisationRoles returns an iterator over the results of ListOrganisationRoles.
+	AllOrganisationRoles(ctx context.Context, request *ListOrganisationRolesRequest) iter.Seq2[OrganisationRole, error]
+
+	// AllRoleBindings returns an iterator over the results of ListRoleBindings.
+	AllRoleBindings(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) iter.Seq2[OrganisationRoleBinding, error]
+
+	// AllServiceAccounts returns an iterator over the results of ListServiceAccounts.
+	AllServiceAccounts(ctx context.Context, request *ListServiceAccountsRequest) iter.Seq2[ServiceAccount, error]
+
+	// AllTeams returns an iterator over the results of ListTeams.
+	AllTeams(ctx context.Context, request *ListTeamsRequest) iter.Seq2[Team, error]
+
+	// CreateFederatedIdentity creates a new federated identity
+	CreateFederatedIdentity(ctx context.Context, create CreateFederatedIdentityRequest) (*FederatedIdentity, error)
+
+	// CreateFederatedIdentityProvider creates a new federated identity provider
+	CreateFederatedIdentityProvider(ctx context.Context, create CreateFederatedIdentityProviderRequest) (*FederatedIdentityProvider, error)
+
+	// CreateOrganisationRole creates a new organisation role.
+	CreateOrganisationRole(ctx context.Context, create CreateOrganisationRoleRequest) (*OrganisationRole, error)
+
+	// CreateRoleBinding creates a new role binding for an organisation role.
+	CreateRoleBinding(ctx context.Context, roleIdentity string, create CreateRoleBinding) (*OrganisationRoleBinding, error)
+
+	// CreateServiceAccount creates a new service account
+	CreateServiceAccount(ctx context.Context, create CreateServiceAccountRequest) (*ServiceAccount, error)
+
+	// CreateServiceAccountAccessCredentials creates access credentials for a service account
+	CreateServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string, request CreateServiceAccountAccessCredentialRequest) (*ServiceAccountCreatedAccessCredential, error)
+
+	// CreateTeam creates a new team.
+	CreateTeam(ctx context.Context, create CreateTeam) (*Team, error)
+
+	// DeleteFederatedIdentity deletes a federated identity
+	DeleteFederatedIdentity(ctx context.Context, identity string) error
+
+	// DeleteFederatedIdentityProvider deletes a federated identity provider
+	DeleteFederatedIdentityProvider(ctx context.Context, identity string) error
+
+	// DeleteOrganisationMember deletes a member from an organisation
+	DeleteOrganisationMember(ctx context.Context, identity string) error
+
+	// DeleteOrganisationRole deletes a specific organisation role by its identity.
+	DeleteOrganisationRole(ctx context.Context, identity string) error
+
+	// DeleteRoleBinding deletes a specific role binding from an organisation role.
+	DeleteRoleBinding(ctx context.Context, roleIdentity string, bindingIdentity string) error
+
+	// DeleteRuleFromRole deletes a permission rule from an organisation role.
+	DeleteRuleFromRole(ctx context.Context, roleIdentity string, ruleIdentity string) error
+
+	// DeleteServiceAccount deletes a service account
+	DeleteServiceAccount(ctx context.Context, identity string) error
+
+	// DeleteServiceAccountAccessCredentials deletes specific access credentials for a service account
+	DeleteServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string, credentialIdentity string) error
+
+	// DeleteTeam deletes a team.
+	DeleteTeam(ctx context.Context, identity string) error
+
+	// GetFederatedIdentity retrieves a specific federated identity
+	GetFederatedIdentity(ctx context.Context, identity string) (*FederatedIdentity, error)
+
+	// GetFederatedIdentityProvider retrieves a specific federated identity provider
+	GetFederatedIdentityProvider(ctx context.Context, identity string) (*FederatedIdentityProvider, error)
+
+	// GetOrganisationRole retrieves a specific organisation role by its identity.
+	GetOrganisationRole(ctx context.Context, identity string) (*OrganisationRole, error)
+
+	// GetServiceAccount retrieves a specific service account
+	GetServiceAccount(ctx context.Context, identity string) (*ServiceAccount, error)
+
+	// GetServiceAccountAccessCredentials lists access credentials for a service account
+	GetServiceAccountAccessCredentials(ctx context.Context, serviceAccountIdentity string) ([]ServiceAccountAccessCredential, error)
+
+	// GetTeam retrieves a specific team by its identity.
+	GetTeam(ctx context.Context, identity string, arg2 *GetTeamRequest) (*Team, error)
+
+	// ListFederatedIdentities lists all federated identities for the organisation
+	ListFederatedIdentities(ctx context.Context, request *ListFederatedIdentitiesRequest) ([]FederatedIdentity, error)
+
+	// ListFederatedIdentityProviders lists all federated identity providers for the organisation
+	ListFederatedIdentityProviders(ctx context.Context, request *ListFederatedIdentityProvidersRequest) ([]FederatedIdentityProvider, error)
+
+	// ListOrganisationMemberInvites lists all invites for an organisation
+	ListOrganisationMemberInvites(ctx context.Context, request *ListOrganisationMemberInvitesRequest) ([]OrganisationMemberInvite, error)
+
+	// ListOrganisationMembers lists all members of an organisation
+	ListOrganisationMembers(ctx context.Context, request *ListMembersRequest) ([]OrganisationMember, error)
+
+	// ListOrganisationRoles lists all organisation roles for a given organisation.
+	ListOrganisationRoles(ctx context.Context, request *ListOrganisationRolesRequest) ([]OrganisationRole, error)
+
+	// ListRoleBindings lists all role bindings for a specific organisation role.
+	ListRoleBindings(ctx context.Context, roleIdentity string, request *ListRoleBindingsRequest) ([]OrganisationRoleBinding, error)
+
+	// ListServiceAccounts lists all service accounts for the organisation
+	ListServiceAccounts(ctx context.Context, request *ListServiceAccountsRequest) ([]ServiceAccount, error)
+
+	// ListTeams lists all teams for a given organisation.
+	ListTeams(ctx context.Context, request *ListTeamsRequest) ([]Team, error)
+
+	// RemoveTeamMember removes a member from a team.
+	RemoveTeamMember(ctx context.Context, teamID string, memberIdentity string) error
+
+	// UpdateFederatedIdentity updates a federated identity (partial update)
+	UpdateFederatedIdentity(ctx context.Context, identity string, update UpdateFederatedIdentityRequest) (*FederatedIdentity, error)
+
+	// UpdateFederatedIdentityProvider updates a federated identity provider (partial update)
+	UpdateFederatedIdentityProvider(ctx context.Context, identity string, update UpdateFederatedIdentityProviderRequest) (*FederatedIdentityProvider, error)
+
+	// UpdateOrganisationMember updates a member of an organisation
+	UpdateOrganisationMember(ctx context.Context, identity string, request UpdateOrganisationMemberRequest) error
+
+	// UpdateServiceAccount updates a service account (partial update)
+	UpdateServiceAccount(ctx context.Context, identity string, update UpdateServiceAccountRequest) (*ServiceAccount, error)
+
+	// UpdateTeam updates a team.
+	UpdateTeam(ctx context.Context, identity string, update UpdateTeam) (*Team, error)
+}
+
+var _ Interface = (*Client)(nil)
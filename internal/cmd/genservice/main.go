@@ -0,0 +1,389 @@
+// Command genservice generates the Interface and Fake of a service package from the
+// exported methods of its Client type.
+//
+// It is run by go generate from the directory of a service package:
+//
+//	//go:generate go run ../internal/cmd/genservice
+//
+// and writes zz_generated_interface.go and zz_generated_fake.go next to the sources.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfaceFile = "zz_generated_interface.go"
+	fakeFile      = "zz_generated_fake.go"
+	header        = "// Code generated by genservice. DO NOT EDIT.\n\n"
+	fakeImport    = "github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genservice: ")
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// method is an exported method of the Client type.
+type method struct {
+	name    string
+	doc     string
+	params  []field
+	results []field
+	// variadic reports whether the last parameter is variadic.
+	variadic bool
+}
+
+type field struct {
+	name string
+	typ  string
+	// iterArgs are the type arguments if the type is an iter.Seq or iter.Seq2.
+	iterArgs []string
+}
+
+// generate parses the package in dir and returns the generated files by name.
+func generate(dir string) (map[string][]byte, error) {
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgName string
+	var methods []method
+	imports := map[string]string{} // local name -> import path
+	used := map[string]bool{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "zz_generated") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkgName = file.Name.Name
+
+		fileImports := map[string]string{}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			local := importName(path)
+			if spec.Name != nil {
+				local = spec.Name.Name
+			}
+			fileImports[local] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || !isClientReceiver(fn.Recv) {
+				continue
+			}
+			m := method{name: fn.Name.Name, doc: fn.Doc.Text()}
+			m.params, m.variadic = fields(fset, fn.Type.Params, "arg")
+			m.results, _ = fields(fset, fn.Type.Results, "r")
+			for i := range m.results {
+				// Results are named r0, r1, ... in the fake so that the zero values can be returned with a bare return.
+				m.results[i].name = "r" + strconv.Itoa(i)
+			}
+			ast.Inspect(fn.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if id, ok := sel.X.(*ast.Ident); ok {
+						if path, ok := fileImports[id.Name]; ok {
+							imports[id.Name] = path
+							used[id.Name] = true
+						}
+					}
+				}
+				return true
+			})
+			methods = append(methods, m)
+		}
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no exported methods on *Client in %s", dir)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	iface, err := renderInterface(pkgName, methods, imports, used)
+	if err != nil {
+		return nil, err
+	}
+	fake, err := renderFake(pkgName, methods, imports, used)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{interfaceFile: iface, fakeFile: fake}, nil
+}
+
+func isClientReceiver(recv *ast.FieldList) bool {
+	if recv == nil || len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+// fields flattens a parameter or result list, naming unnamed entries prefix0, prefix1, ...
+func fields(fset *token.FileSet, list *ast.FieldList, prefix string) ([]field, bool) {
+	if list == nil {
+		return nil, false
+	}
+	var out []field
+	variadic := false
+	for _, f := range list.List {
+		typ := f.Type
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			variadic = true
+			typ = ellipsis.Elt
+		}
+		names := f.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, name := range names {
+			n := name.Name
+			if n == "_" || n == "f" || n == "fake" {
+				n = prefix + strconv.Itoa(len(out))
+			}
+			out = append(out, field{name: n, typ: exprString(fset, typ), iterArgs: iterArgs(fset, typ)})
+		}
+	}
+	return out, variadic
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, expr); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func importName(path string) string {
+	name := path[strings.LastIndex(path, "/")+1:]
+	if strings.HasPrefix(name, "v") {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			trimmed := strings.TrimSuffix(path, "/"+name)
+			name = trimmed[strings.LastIndex(trimmed, "/")+1:]
+		}
+	}
+	return name
+}
+
+// writeImports writes the import block with the standard library imports first, as goimports does.
+func writeImports(buf *bytes.Buffer, imports map[string]string, used map[string]bool, extra ...string) {
+	var std, other []string
+	add := func(local, path string) {
+		spec := strconv.Quote(path)
+		if local != "" && importName(path) != local {
+			spec = local + " " + spec
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	for local := range used {
+		add(local, imports[local])
+	}
+	for _, path := range extra {
+		add("", path)
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	buf.WriteString("import (\n")
+	for _, spec := range std {
+		buf.WriteString("\t" + spec + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, spec := range other {
+		buf.WriteString("\t" + spec + "\n")
+	}
+	buf.WriteString(")\n\n")
+}
+
+func signature(m method, namedResults bool) string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		typ := p.typ
+		if m.variadic && i == len(m.params)-1 {
+			typ = "..." + typ
+		}
+		params[i] = p.name + " " + typ
+	}
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = r.typ
+		if namedResults {
+			results[i] = r.name + " " + r.typ
+		}
+	}
+	sig := m.name + "(" + strings.Join(params, ", ") + ")"
+	switch {
+	case len(results) == 1 && !namedResults:
+		sig += " " + results[0]
+	case len(results) > 0:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+func writeDoc(buf *bytes.Buffer, doc, indent string) {
+	for _, line := range strings.Split(strings.TrimRight(doc, "\n"), "\n") {
+		if line == "" {
+			buf.WriteString(indent + "//\n")
+			continue
+		}
+		buf.WriteString(indent + "// " + line + "\n")
+	}
+}
+
+func renderInterface(pkgName string, methods []method, imports map[string]string, used map[string]bool) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package " + pkgName + "\n\n")
+	writeImports(&buf, imports, used)
+	buf.WriteString("// Interface is implemented by Client and Fake. It covers every exported method of\n")
+	buf.WriteString("// Client except those of the embedded client.Client.\n")
+	buf.WriteString("type Interface interface {\n")
+	for i, m := range methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if m.doc != "" {
+			writeDoc(&buf, m.doc, "\t")
+		}
+		buf.WriteString("\t" + signature(m, false) + "\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("var _ Interface = (*Client)(nil)\n")
+	return format.Source(buf.Bytes())
+}
+
+func renderFake(pkgName string, methods []method, imports map[string]string, used map[string]bool) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package " + pkgName + "\n\n")
+	writeImports(&buf, imports, used, fakeImport)
+	buf.WriteString("// Fake is an in-memory implementation of Interface for unit tests. Every call is\n")
+	buf.WriteString("// recorded, and the response of a method is programmed by setting its <Method>Func\n")
+	buf.WriteString("// field. Methods without a programmed response return zero values and Err, and\n")
+	buf.WriteString("// iterators yield Err once if it is set and nothing otherwise. The fields must be\n")
+	buf.WriteString("// set before the Fake is used concurrently.\n")
+	buf.WriteString("type Fake struct {\n\tfake.Recorder\n\n")
+	buf.WriteString("\t// Err is returned by methods without a programmed response.\n\tErr error\n\n")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t// %sFunc, if set, handles calls to %s.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "\t%sFunc func%s\n", m.name, strings.TrimPrefix(signature(m, false), m.name))
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("var _ Interface = (*Fake)(nil)\n")
+
+	for _, m := range methods {
+		args := make([]string, len(m.params))
+		for i, p := range m.params {
+			args[i] = p.name
+		}
+		callArgs := strings.Join(args, ", ")
+		if m.variadic {
+			callArgs += "..."
+		}
+		fmt.Fprintf(&buf, "\n// %s records the call and invokes %sFunc if set.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "func (f *Fake) %s {\n", signature(m, true))
+		fmt.Fprintf(&buf, "\tf.Record(%s)\n", strings.Join(append([]string{strconv.Quote(m.name)}, args...), ", "))
+		fmt.Fprintf(&buf, "\tif f.%sFunc != nil {\n", m.name)
+		if len(m.results) > 0 {
+			fmt.Fprintf(&buf, "\t\treturn f.%sFunc(%s)\n\t}\n", m.name, callArgs)
+		} else {
+			fmt.Fprintf(&buf, "\t\tf.%sFunc(%s)\n\t}\n", m.name, callArgs)
+		}
+		for _, r := range m.results {
+			switch {
+			case r.iterArgs != nil:
+				fmt.Fprintf(&buf, "\t%s = %s\n", r.name, defaultIterator(r))
+			case r.typ == "error":
+				fmt.Fprintf(&buf, "\t%s = f.Err\n", r.name)
+			}
+		}
+		if len(m.results) > 0 {
+			buf.WriteString("\treturn\n")
+		}
+		buf.WriteString("}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+// iterArgs returns the type arguments of expr if it is an iter.Seq or iter.Seq2.
+func iterArgs(fset *token.FileSet, expr ast.Expr) []string {
+	var base ast.Expr
+	var indices []ast.Expr
+	switch expr := expr.(type) {
+	case *ast.IndexExpr:
+		base, indices = expr.X, []ast.Expr{expr.Index}
+	case *ast.IndexListExpr:
+		base, indices = expr.X, expr.Indices
+	default:
+		return nil
+	}
+	sel, ok := base.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "iter" || (sel.Sel.Name != "Seq" && sel.Sel.Name != "Seq2") {
+		return nil
+	}
+	args := make([]string, len(indices))
+	for i, index := range indices {
+		args[i] = exprString(fset, index)
+	}
+	return args
+}
+
+// defaultIterator returns an iterator literal for the unprogrammed result r, so that
+// ranging over it does not panic. Iterators of (value, error) pairs yield Err once if
+// it is set; other iterators yield nothing.
+func defaultIterator(r field) string {
+	args := strings.Join(r.iterArgs, ", ")
+	if len(r.iterArgs) != 2 || r.iterArgs[1] != "error" {
+		return "func(func(" + args + ") bool) {}"
+	}
+	return "func(yield func(" + args + ") bool) {\n" +
+		"\t\tif f.Err != nil {\n" +
+		"\t\t\tvar zero " + r.iterArgs[0] + "\n" +
+		"\t\t\tyield(zero, f.Err)\n" +
+		"\t\t}\n" +
+		"\t}"
+}
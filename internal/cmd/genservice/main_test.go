@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedFilesUpToDate fails when a service package was changed without running go generate.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	dirs, err := filepath.Glob(filepath.Join(root, "*", interfaceFile))
+	require.NoError(t, err)
+	nested, err := filepath.Glob(filepath.Join(root, "*", "*", interfaceFile))
+	require.NoError(t, err)
+	dirs = append(dirs, nested...)
+	require.NotEmpty(t, dirs)
+
+	for _, path := range dirs {
+		dir := filepath.Dir(path)
+		t.Run(dir, func(t *testing.T) {
+			files, err := generate(dir)
+			require.NoError(t, err)
+			for name, want := range files {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, string(want), string(got), "%s is stale; run go generate ./...", name)
+			}
+		})
+	}
+}
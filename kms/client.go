@@ -4,6 +4,8 @@ import (
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
 
+//go:generate go run ../internal/cmd/genservice
+
 // Client represents the KMS client.
 //
 // Create one via thalassa.NewClient(...).KMS() or kms.New(baseClient).
@@ -14,6 +16,8 @@ type Client struct {
 
 // New creates a new KMS client.
 func New(c client.Client, opts ...client.Option) (*Client, error) {
-	c.WithOptions(opts...)
+	if err := client.ApplyOptions(c, opts...); err != nil {
+		return nil, err
+	}
 	return &Client{c}, nil
 }
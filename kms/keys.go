@@ -2,8 +2,10 @@ package kms
 
 import (
 	"context"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 // ListKeys lists KMS keys in a region.
@@ -27,6 +29,13 @@ func (c *Client) ListKeys(ctx context.Context, region string, req *ListKeysReque
 	return keys, nil
 }
 
+// AllKeys returns an iterator over the results of ListKeys.
+func (c *Client) AllKeys(ctx context.Context, region string, req *ListKeysRequest) iter.Seq2[KmsKey, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KmsKey, error) {
+		return c.ListKeys(ctx, region, req)
+	})
+}
+
 // GetWrappingKey returns the regional wrapping public key for BYOK import.
 func (c *Client) GetWrappingKey(ctx context.Context, region string) (*WrappingKeyResponse, error) {
 	var wrappingKey WrappingKeyResponse
@@ -78,6 +87,21 @@ func (c *Client) DeleteKey(ctx context.Context, region, identity string) error {
 	return c.Check(resp)
 }
 
+// WaitUntilKeyIsStatus waits until a KMS key is in a specific status, for example
+// KmsKeyStatusPendingDeletion after DeleteKey. The user is expected to provide a timeout
+// context.
+func (c *Client) WaitUntilKeyIsStatus(ctx context.Context, region, identity string, status KmsKeyStatus, opts ...wait.Option) (*KmsKey, error) {
+	get := func(ctx context.Context) (*KmsKey, error) {
+		return c.GetKey(ctx, region, identity)
+	}
+	w := wait.UntilStatus("KMS key "+identity, get, func(key *KmsKey) string { return string(key.Status) }, string(status))
+	key, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
 // CancelDeletion cancels a pending KMS key deletion.
 func (c *Client) CancelDeletion(ctx context.Context, region, identity string) error {
 	resp, err := c.Do(ctx, c.R(), client.DELETE, regionPath(region, "keys", identity, "cancel-deletion"))
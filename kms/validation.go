@@ -0,0 +1,29 @@
+package kms
+
+import (
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateKmsKeyRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	if r.KeyType != "" && !r.KeyType.IsValid() {
+		f.Add("keyType", "unsupported key type %q", r.KeyType)
+	}
+	validateRotationPeriod(&f, r.RotationPeriodInDays)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateRotationRequest) Validate() error {
+	var f validate.Fields
+	validateRotationPeriod(&f, r.RotationPeriodInDays)
+	return f.Err()
+}
+
+func validateRotationPeriod(f *validate.Fields, days *int) {
+	if days != nil {
+		f.Check(*days > 0, "rotationPeriodInDays", "must be positive")
+	}
+}
@@ -0,0 +1,50 @@
+package kms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	week, never := 7, 0
+
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid key",
+			req:  CreateKmsKeyRequest{Name: "app", KeyType: KmsKeyTypeAES256GCM96, KeyRotationEnabled: true, RotationPeriodInDays: &week},
+		},
+		{
+			name: "default key type",
+			req:  CreateKmsKeyRequest{Name: "app"},
+		},
+		{
+			name: "invalid key",
+			req:  CreateKmsKeyRequest{KeyType: "des", RotationPeriodInDays: &never},
+			want: []string{"name", "keyType", "rotationPeriodInDays"},
+		},
+		{
+			name: "rotation period",
+			req:  UpdateRotationRequest{RotationPeriodInDays: &never},
+			want: []string{"rotationPeriodInDays"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
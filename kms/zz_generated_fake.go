@@ -0,0 +1,307 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllKeysFunc, if set, handles calls to AllKeys.
+	AllKeysFunc func(ctx context.Context, region string, req *ListKeysRequest) iter.Seq2[KmsKey, error]
+	// CancelDeletionFunc, if set, handles calls to CancelDeletion.
+	CancelDeletionFunc func(ctx context.Context, region string, identity string) error
+	// CreateKeyFunc, if set, handles calls to CreateKey.
+	CreateKeyFunc func(ctx context.Context, region string, create CreateKmsKeyRequest) (*KmsKey, error)
+	// DecryptFunc, if set, handles calls to Decrypt.
+	DecryptFunc func(ctx context.Context, region string, identity string, decrypt DecryptRequest) (*DecryptResponse, error)
+	// DecryptBytesFunc, if set, handles calls to DecryptBytes.
+	DecryptBytesFunc func(ctx context.Context, region string, identity string, ciphertext string) ([]byte, error)
+	// DeleteKeyFunc, if set, handles calls to DeleteKey.
+	DeleteKeyFunc func(ctx context.Context, region string, identity string) error
+	// DisableKeyFunc, if set, handles calls to DisableKey.
+	DisableKeyFunc func(ctx context.Context, region string, identity string) (*KmsKey, error)
+	// EnableKeyFunc, if set, handles calls to EnableKey.
+	EnableKeyFunc func(ctx context.Context, region string, identity string) (*KmsKey, error)
+	// EncryptFunc, if set, handles calls to Encrypt.
+	EncryptFunc func(ctx context.Context, region string, identity string, encrypt EncryptRequest) (*EncryptResponse, error)
+	// EncryptBytesFunc, if set, handles calls to EncryptBytes.
+	EncryptBytesFunc func(ctx context.Context, region string, identity string, plaintext []byte) (*EncryptResponse, error)
+	// ExportKeyFunc, if set, handles calls to ExportKey.
+	ExportKeyFunc func(ctx context.Context, region string, identity string, export ExportKeyRequest) (*ExportKeyResponse, error)
+	// GetKeyFunc, if set, handles calls to GetKey.
+	GetKeyFunc func(ctx context.Context, region string, identity string) (*KmsKey, error)
+	// GetPublicKeyFunc, if set, handles calls to GetPublicKey.
+	GetPublicKeyFunc func(ctx context.Context, region string, identity string, version *int) (*GetPublicKeyResponse, error)
+	// GetSummaryFunc, if set, handles calls to GetSummary.
+	GetSummaryFunc func(ctx context.Context) (*KmsSummary, error)
+	// GetWrappingKeyFunc, if set, handles calls to GetWrappingKey.
+	GetWrappingKeyFunc func(ctx context.Context, region string) (*WrappingKeyResponse, error)
+	// HMACFunc, if set, handles calls to HMAC.
+	HMACFunc func(ctx context.Context, region string, identity string, hmacReq HMACRequest) (*HMACResponse, error)
+	// ListKeysFunc, if set, handles calls to ListKeys.
+	ListKeysFunc func(ctx context.Context, region string, req *ListKeysRequest) ([]KmsKey, error)
+	// RotateKeyFunc, if set, handles calls to RotateKey.
+	RotateKeyFunc func(ctx context.Context, region string, identity string) (*KmsKey, error)
+	// SignFunc, if set, handles calls to Sign.
+	SignFunc func(ctx context.Context, region string, identity string, sign SignRequest) (*SignResponse, error)
+	// UpdateRotationFunc, if set, handles calls to UpdateRotation.
+	UpdateRotationFunc func(ctx context.Context, region string, identity string, update UpdateRotationRequest) (*KmsKey, error)
+	// VerifyHMACFunc, if set, handles calls to VerifyHMAC.
+	VerifyHMACFunc func(ctx context.Context, region string, identity string, verify VerifyHMACRequest) (*VerifyHMACResponse, error)
+	// VerifySignatureFunc, if set, handles calls to VerifySignature.
+	VerifySignatureFunc func(ctx context.Context, region string, identity string, verify VerifySignatureRequest) (*VerifySignatureResponse, error)
+	// WaitUntilKeyIsStatusFunc, if set, handles calls to WaitUntilKeyIsStatus.
+	WaitUntilKeyIsStatusFunc func(ctx context.Context, region string, identity string, status KmsKeyStatus, opts ...wait.Option) (*KmsKey, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllKeys records the call and invokes AllKeysFunc if set.
+func (f *Fake) AllKeys(ctx context.Context, region string, req *ListKeysRequest) (r0 iter.Seq2[KmsKey, error]) {
+	f.Record("AllKeys", ctx, region, req)
+	if f.AllKeysFunc != nil {
+		return f.AllKeysFunc(ctx, region, req)
+	}
+	r0 = func(yield func(KmsKey, error) bool) {
+		if f.Err != nil {
+			var zero KmsKey
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CancelDeletion records the call and invokes CancelDeletionFunc if set.
+func (f *Fake) CancelDeletion(ctx context.Context, region string, identity string) (r0 error) {
+	f.Record("CancelDeletion", ctx, region, identity)
+	if f.CancelDeletionFunc != nil {
+		return f.CancelDeletionFunc(ctx, region, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// CreateKey records the call and invokes CreateKeyFunc if set.
+func (f *Fake) CreateKey(ctx context.Context, region string, create CreateKmsKeyRequest) (r0 *KmsKey, r1 error) {
+	f.Record("CreateKey", ctx, region, create)
+	if f.CreateKeyFunc != nil {
+		return f.CreateKeyFunc(ctx, region, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// Decrypt records the call and invokes DecryptFunc if set.
+func (f *Fake) Decrypt(ctx context.Context, region string, identity string, decrypt DecryptRequest) (r0 *DecryptResponse, r1 error) {
+	f.Record("Decrypt", ctx, region, identity, decrypt)
+	if f.DecryptFunc != nil {
+		return f.DecryptFunc(ctx, region, identity, decrypt)
+	}
+	r1 = f.Err
+	return
+}
+
+// DecryptBytes records the call and invokes DecryptBytesFunc if set.
+func (f *Fake) DecryptBytes(ctx context.Context, region string, identity string, ciphertext string) (r0 []byte, r1 error) {
+	f.Record("DecryptBytes", ctx, region, identity, ciphertext)
+	if f.DecryptBytesFunc != nil {
+		return f.DecryptBytesFunc(ctx, region, identity, ciphertext)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteKey records the call and invokes DeleteKeyFunc if set.
+func (f *Fake) DeleteKey(ctx context.Context, region string, identity string) (r0 error) {
+	f.Record("DeleteKey", ctx, region, identity)
+	if f.DeleteKeyFunc != nil {
+		return f.DeleteKeyFunc(ctx, region, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DisableKey records the call and invokes DisableKeyFunc if set.
+func (f *Fake) DisableKey(ctx context.Context, region string, identity string) (r0 *KmsKey, r1 error) {
+	f.Record("DisableKey", ctx, region, identity)
+	if f.DisableKeyFunc != nil {
+		return f.DisableKeyFunc(ctx, region, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// EnableKey records the call and invokes EnableKeyFunc if set.
+func (f *Fake) EnableKey(ctx context.Context, region string, identity string) (r0 *KmsKey, r1 error) {
+	f.Record("EnableKey", ctx, region, identity)
+	if f.EnableKeyFunc != nil {
+		return f.EnableKeyFunc(ctx, region, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// Encrypt records the call and invokes EncryptFunc if set.
+func (f *Fake) Encrypt(ctx context.Context, region string, identity string, encrypt EncryptRequest) (r0 *EncryptResponse, r1 error) {
+	f.Record("Encrypt", ctx, region, identity, encrypt)
+	if f.EncryptFunc != nil {
+		return f.EncryptFunc(ctx, region, identity, encrypt)
+	}
+	r1 = f.Err
+	return
+}
+
+// EncryptBytes records the call and invokes EncryptBytesFunc if set.
+func (f *Fake) EncryptBytes(ctx context.Context, region string, identity string, plaintext []byte) (r0 *EncryptResponse, r1 error) {
+	f.Record("EncryptBytes", ctx, region, identity, plaintext)
+	if f.EncryptBytesFunc != nil {
+		return f.EncryptBytesFunc(ctx, region, identity, plaintext)
+	}
+	r1 = f.Err
+	return
+}
+
+// ExportKey records the call and invokes ExportKeyFunc if set.
+func (f *Fake) ExportKey(ctx context.Context, region string, identity string, export ExportKeyRequest) (r0 *ExportKeyResponse, r1 error) {
+	f.Record("ExportKey", ctx, region, identity, export)
+	if f.ExportKeyFunc != nil {
+		return f.ExportKeyFunc(ctx, region, identity, export)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetKey records the call and invokes GetKeyFunc if set.
+func (f *Fake) GetKey(ctx context.Context, region string, identity string) (r0 *KmsKey, r1 error) {
+	f.Record("GetKey", ctx, region, identity)
+	if f.GetKeyFunc != nil {
+		return f.GetKeyFunc(ctx, region, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetPublicKey records the call and invokes GetPublicKeyFunc if set.
+func (f *Fake) GetPublicKey(ctx context.Context, region string, identity string, version *int) (r0 *GetPublicKeyResponse, r1 error) {
+	f.Record("GetPublicKey", ctx, region, identity, version)
+	if f.GetPublicKeyFunc != nil {
+		return f.GetPublicKeyFunc(ctx, region, identity, version)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSummary records the call and invokes GetSummaryFunc if set.
+func (f *Fake) GetSummary(ctx context.Context) (r0 *KmsSummary, r1 error) {
+	f.Record("GetSummary", ctx)
+	if f.GetSummaryFunc != nil {
+		return f.GetSummaryFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetWrappingKey records the call and invokes GetWrappingKeyFunc if set.
+func (f *Fake) GetWrappingKey(ctx context.Context, region string) (r0 *WrappingKeyResponse, r1 error) {
+	f.Record("GetWrappingKey", ctx, region)
+	if f.GetWrappingKeyFunc != nil {
+		return f.GetWrappingKeyFunc(ctx, region)
+	}
+	r1 = f.Err
+	return
+}
+
+// HMAC records the call and invokes HMACFunc if set.
+func (f *Fake) HMAC(ctx context.Context, region string, identity string, hmacReq HMACRequest) (r0 *HMACResponse, r1 error) {
+	f.Record("HMAC", ctx, region, identity, hmacReq)
+	if f.HMACFunc != nil {
+		return f.HMACFunc(ctx, region, identity, hmacReq)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListKeys records the call and invokes ListKeysFunc if set.
+func (f *Fake) ListKeys(ctx context.Context, region string, req *ListKeysRequest) (r0 []KmsKey, r1 error) {
+	f.Record("ListKeys", ctx, region, req)
+	if f.ListKeysFunc != nil {
+		return f.ListKeysFunc(ctx, region, req)
+	}
+	r1 = f.Err
+	return
+}
+
+// RotateKey records the call and invokes RotateKeyFunc if set.
+func (f *Fake) RotateKey(ctx context.Context, region string, identity string) (r0 *KmsKey, r1 error) {
+	f.Record("RotateKey", ctx, region, identity)
+	if f.RotateKeyFunc != nil {
+		return f.RotateKeyFunc(ctx, region, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// Sign records the call and invokes SignFunc if set.
+func (f *Fake) Sign(ctx context.Context, region string, identity string, sign SignRequest) (r0 *SignResponse, r1 error) {
+	f.Record("Sign", ctx, region, identity, sign)
+	if f.SignFunc != nil {
+		return f.SignFunc(ctx, region, identity, sign)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateRotation records the call and invokes UpdateRotationFunc if set.
+func (f *Fake) UpdateRotation(ctx context.Context, region string, identity string, update UpdateRotationRequest) (r0 *KmsKey, r1 error) {
+	f.Record("UpdateRotation", ctx, region, identity, update)
+	if f.UpdateRotationFunc != nil {
+		return f.UpdateRotationFunc(ctx, region, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// VerifyHMAC records the call and invokes VerifyHMACFunc if set.
+func (f *Fake) VerifyHMAC(ctx context.Context, region string, identity string, verify VerifyHMACRequest) (r0 *VerifyHMACResponse, r1 error) {
+	f.Record("VerifyHMAC", ctx, region, identity, verify)
+	if f.VerifyHMACFunc != nil {
+		return f.VerifyHMACFunc(ctx, region, identity, verify)
+	}
+	r1 = f.Err
+	return
+}
+
+// VerifySignature records the call and invokes VerifySignatureFunc if set.
+func (f *Fake) VerifySignature(ctx context.Context, region string, identity string, verify VerifySignatureRequest) (r0 *VerifySignatureResponse, r1 error) {
+	f.Record("VerifySignature", ctx, region, identity, verify)
+	if f.VerifySignatureFunc != nil {
+		return f.VerifySignatureFunc(ctx, region, identity, verify)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilKeyIsStatus records the call and invokes WaitUntilKeyIsStatusFunc if set.
+func (f *Fake) WaitUntilKeyIsStatus(ctx context.Context, region string, identity string, status KmsKeyStatus, opts ...wait.Option) (r0 *KmsKey, r1 error) {
+	f.Record("WaitUntilKeyIsStatus", ctx, region, identity, status, opts)
+	if f.WaitUntilKeyIsStatusFunc != nil {
+		return f.WaitUntilKeyIsStatusFunc(ctx, region, identity, status, opts...)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,89 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllKeys returns an iterator over the results of ListKeys.
+	AllKeys(ctx context.Context, region string, req *ListKeysRequest) iter.Seq2[KmsKey, error]
+
+	// CancelDeletion cancels a pending KMS key deletion.
+	CancelDeletion(ctx context.Context, region string, identity string) error
+
+	// CreateKey creates a new KMS key in a region.
+	CreateKey(ctx context.Context, region string, create CreateKmsKeyRequest) (*KmsKey, error)
+
+	// Decrypt decrypts ciphertext using a KMS key.
+	// Callers must not log or persist decrypted material from the response.
+	Decrypt(ctx context.Context, region string, identity string, decrypt DecryptRequest) (*DecryptResponse, error)
+
+	// DecryptBytes decrypts ciphertext and returns the decoded plaintext bytes.
+	DecryptBytes(ctx context.Context, region string, identity string, ciphertext string) ([]byte, error)
+
+	// DeleteKey schedules a KMS key for deletion.
+	DeleteKey(ctx context.Context, region string, identity string) error
+
+	// DisableKey disables a KMS key.
+	DisableKey(ctx context.Context, region string, identity string) (*KmsKey, error)
+
+	// EnableKey enables a KMS key.
+	EnableKey(ctx context.Context, region string, identity string) (*KmsKey, error)
+
+	// Encrypt encrypts plaintext using a KMS key. Plaintext must be base64-encoded.
+	Encrypt(ctx context.Context, region string, identity string, encrypt EncryptRequest) (*EncryptResponse, error)
+
+	// EncryptBytes encrypts raw bytes using a KMS key.
+	EncryptBytes(ctx context.Context, region string, identity string, plaintext []byte) (*EncryptResponse, error)
+
+	// ExportKey exports key material when export is allowed on the key.
+	// Callers must not log or persist exported key material from the response.
+	ExportKey(ctx context.Context, region string, identity string, export ExportKeyRequest) (*ExportKeyResponse, error)
+
+	// GetKey retrieves a KMS key by identity.
+	GetKey(ctx context.Context, region string, identity string) (*KmsKey, error)
+
+	// GetPublicKey returns the public key material for an asymmetric KMS key.
+	GetPublicKey(ctx context.Context, region string, identity string, version *int) (*GetPublicKeyResponse, error)
+
+	// GetSummary returns KMS availability and regional configuration for the organisation.
+	GetSummary(ctx context.Context) (*KmsSummary, error)
+
+	// GetWrappingKey returns the regional wrapping public key for BYOK import.
+	GetWrappingKey(ctx context.Context, region string) (*WrappingKeyResponse, error)
+
+	// HMAC computes an HMAC using an HMAC KMS key.
+	HMAC(ctx context.Context, region string, identity string, hmacReq HMACRequest) (*HMACResponse, error)
+
+	// ListKeys lists KMS keys in a region.
+	ListKeys(ctx context.Context, region string, req *ListKeysRequest) ([]KmsKey, error)
+
+	// RotateKey rotates a KMS key on demand.
+	RotateKey(ctx context.Context, region string, identity string) (*KmsKey, error)
+
+	// Sign signs a message using an asymmetric KMS key.
+	Sign(ctx context.Context, region string, identity string, sign SignRequest) (*SignResponse, error)
+
+	// UpdateRotation updates automatic key rotation settings.
+	UpdateRotation(ctx context.Context, region string, identity string, update UpdateRotationRequest) (*KmsKey, error)
+
+	// VerifyHMAC verifies an HMAC using an HMAC KMS key.
+	VerifyHMAC(ctx context.Context, region string, identity string, verify VerifyHMACRequest) (*VerifyHMACResponse, error)
+
+	// VerifySignature verifies a signature using an asymmetric KMS key.
+	VerifySignature(ctx context.Context, region string, identity string, verify VerifySignatureRequest) (*VerifySignatureResponse, error)
+
+	// WaitUntilKeyIsStatus waits until a KMS key is in a specific status, for example
+	// KmsKeyStatusPendingDeletion after DeleteKey. The user is expected to provide a timeout
+	// context.
+	WaitUntilKeyIsStatus(ctx context.Context, region string, identity string, status KmsKeyStatus, opts ...wait.Option) (*KmsKey, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -3,10 +3,11 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -40,6 +41,13 @@ func (c *Client) ListKubernetesClusters(ctx context.Context, request *ListKubern
 	return subnets, nil
 }
 
+// AllKubernetesClusters returns an iterator over the results of ListKubernetesClusters.
+func (c *Client) AllKubernetesClusters(ctx context.Context, request *ListKubernetesClustersRequest) iter.Seq2[KubernetesCluster, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesCluster, error) {
+		return c.ListKubernetesClusters(ctx, request)
+	})
+}
+
 // GetKubernetesCluster retrieves a specific KubernetesCluster by its identity.
 func (c *Client) GetKubernetesCluster(ctx context.Context, identity string) (*KubernetesCluster, error) {
 	var subnet *KubernetesCluster
@@ -71,7 +79,7 @@ func (c *Client) CreateKubernetesCluster(ctx context.Context, create CreateKuber
 }
 
 // WaitUntilKubernetesClusterReady waits until the KubernetesCluster is ready.
-// It returns the KubernetesCluster when it is ready or an error if the KubernetesCluster is not being ready or if the context is cancelled.
+// It returns the KubernetesCluster when it is ready or an error if the KubernetesCluster failed, is being deleted or if the context is cancelled.
 // You are responsible for providing a context that can be cancelled, and for handling the error case.
 // Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 // defer cancel()
@@ -80,31 +88,44 @@ func (c *Client) CreateKubernetesCluster(ctx context.Context, create CreateKuber
 //	if err != nil {
 //		log.Fatalf("Failed to wait for KubernetesCluster to be ready: %v", err)
 //	}
-func (c *Client) WaitUntilKubernetesClusterReady(ctx context.Context, identity string) (*KubernetesCluster, error) {
-	kubernetesCluster, err := c.GetKubernetesCluster(ctx, identity)
+func (c *Client) WaitUntilKubernetesClusterReady(ctx context.Context, identity string, opts ...wait.Option) (*KubernetesCluster, error) {
+	w := wait.UntilStatus("KubernetesCluster "+identity, c.kubernetesClusterGetter(identity), kubernetesClusterStatus, "ready",
+		"failed", "deleting", "deleted")
+	// Provisioning is polled at a fixed interval rather than with backoff.
+	w.InitialInterval, w.MaxInterval = DefaultPollIntervalForWaiting, DefaultPollIntervalForWaiting
+	kubernetesCluster, err := w.Wait(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
-	if kubernetesCluster.Status == "ready" {
-		return kubernetesCluster, nil
-	}
+	return kubernetesCluster, nil
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(5 * time.Second):
-			kubernetesCluster, err = c.GetKubernetesCluster(ctx, identity)
-			if err != nil {
-				return nil, err
-			}
-			if kubernetesCluster.Status == "ready" {
-				return kubernetesCluster, nil
-			}
-		}
+// WaitUntilKubernetesClusterDeleted waits until the KubernetesCluster is deleted.
+// It returns an error if the KubernetesCluster is not being deleted or if the context is cancelled.
+// You are responsible for providing a context that can be cancelled, and for handling the error case.
+// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+// defer cancel()
+// err := c.WaitUntilKubernetesClusterDeleted(ctxt, "kubernetes-cluster-identity1234")
+//
+//	if err != nil {
+//		log.Fatalf("Failed to wait for KubernetesCluster to be deleted: %v", err)
+//	}
+func (c *Client) WaitUntilKubernetesClusterDeleted(ctx context.Context, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("KubernetesCluster "+identity, c.kubernetesClusterGetter(identity), kubernetesClusterStatus,
+		"deleting", "deleted", "failed").Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) kubernetesClusterGetter(identity string) func(context.Context) (*KubernetesCluster, error) {
+	return func(ctx context.Context) (*KubernetesCluster, error) {
+		return c.GetKubernetesCluster(ctx, identity)
 	}
 }
 
+func kubernetesClusterStatus(kubernetesCluster *KubernetesCluster) string {
+	return kubernetesCluster.Status
+}
+
 // UpdateKubernetesCluster updates an existing KubernetesCluster.
 func (c *Client) UpdateKubernetesCluster(ctx context.Context, identity string, update UpdateKubernetesCluster) (*KubernetesCluster, error) {
 	var subnet *KubernetesCluster
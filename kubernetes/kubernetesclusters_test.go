@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+func TestWaitUntilKubernetesClusterReadyPollsAtFixedInterval(t *testing.T) {
+	interval := DefaultPollIntervalForWaiting
+	DefaultPollIntervalForWaiting = 5 * time.Millisecond
+	defer func() { DefaultPollIntervalForWaiting = interval }()
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "provisioning"
+		if polls == 4 {
+			status = "ready"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(KubernetesCluster{Identity: "k8s-1", Status: status})
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.WithBaseURL(server.URL), client.WithAuthNone())
+	require.NoError(t, err)
+	k8s, err := New(c)
+	require.NoError(t, err)
+
+	var intervals []time.Duration
+	cluster, err := k8s.WaitUntilKubernetesClusterReady(context.Background(), "k8s-1", wait.WithProgress(func(p wait.Progress) {
+		if p.NextPoll > 0 {
+			intervals = append(intervals, p.NextPoll)
+		}
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "ready", cluster.Status)
+	assert.Equal(t, []time.Duration{5 * time.Millisecond, 5 * time.Millisecond, 5 * time.Millisecond}, intervals)
+}
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
@@ -27,6 +28,13 @@ func (c *Client) ListKubernetesVersions(ctx context.Context) ([]KubernetesVersio
 	return items, nil
 }
 
+// AllKubernetesVersions returns an iterator over the results of ListKubernetesVersions.
+func (c *Client) AllKubernetesVersions(ctx context.Context) iter.Seq2[KubernetesVersion, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesVersion, error) {
+		return c.ListKubernetesVersions(ctx)
+	})
+}
+
 // GetKubernetesVersion retrieves a specific KubernetesVersion by its identity.
 func (c *Client) GetKubernetesVersion(ctx context.Context, identity string) (*KubernetesVersion, error) {
 	var subnet *KubernetesVersion
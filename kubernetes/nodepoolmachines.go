@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
@@ -26,6 +27,13 @@ func (c *Client) ListNodePoolMachines(ctx context.Context, clusterIdentity strin
 	return machines, nil
 }
 
+// AllNodePoolMachines returns an iterator over the results of ListNodePoolMachines.
+func (c *Client) AllNodePoolMachines(ctx context.Context, clusterIdentity string, nodePoolIdentity string) iter.Seq2[KubernetesNodePoolMachine, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesNodePoolMachine, error) {
+		return c.ListNodePoolMachines(ctx, clusterIdentity, nodePoolIdentity)
+	})
+}
+
 // DeleteNodePoolMachine deletes a specific machine from a node pool in a cluster.
 // If scaleDown is true, the node pool will be scaled down by one as part of the deletion.
 func (c *Client) DeleteNodePoolMachine(ctx context.Context, clusterIdentity string, nodePoolIdentity string, machineIdentity string, scaleDown bool) error {
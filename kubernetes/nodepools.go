@@ -2,12 +2,12 @@ package kubernetes
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -45,6 +45,13 @@ func (c *Client) ListKubernetesNodePools(ctx context.Context, clusterIdentity st
 	return subnets, nil
 }
 
+// AllKubernetesNodePools returns an iterator over the results of ListKubernetesNodePools.
+func (c *Client) AllKubernetesNodePools(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) iter.Seq2[KubernetesNodePool, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesNodePool, error) {
+		return c.ListKubernetesNodePools(ctx, clusterIdentity, request)
+	})
+}
+
 // GetKubernetesNodePool retrieves a specific KubernetesNodePool by its identity.
 func (c *Client) GetKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string) (*KubernetesNodePool, error) {
 	var subnet *KubernetesNodePool
@@ -106,7 +113,7 @@ func (c *Client) DeleteKubernetesNodePool(ctx context.Context, clusterIdentity s
 }
 
 // WaitUntilKubernetesNodePoolReady waits until the node pool is ready. A node pool is ready when all nodes within the node pool are up-to-date and running.
-// It returns the node pool when it is ready or an error if the node pool is not being ready or if the context is cancelled.
+// It returns the node pool when it is ready or an error if the node pool failed, is being deleted or if the context is cancelled.
 // You are responsible for providing a context that can be cancelled, and for handling the error case.
 // Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 // defer cancel()
@@ -115,29 +122,15 @@ func (c *Client) DeleteKubernetesNodePool(ctx context.Context, clusterIdentity s
 //	if err != nil {
 //		log.Fatalf("Failed to wait for node pool to be ready: %v", err)
 //	}
-func (c *Client) WaitUntilKubernetesNodePoolReady(ctx context.Context, clusterIdentity string, identity string) (*KubernetesNodePool, error) {
-	nodePool, err := c.GetKubernetesNodePool(ctx, clusterIdentity, identity)
+func (c *Client) WaitUntilKubernetesNodePoolReady(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) (*KubernetesNodePool, error) {
+	w := wait.UntilStatus("node pool "+identity, c.nodePoolGetter(clusterIdentity, identity), nodePoolStatus,
+		string(KubernetesNodePoolStatusReady), string(KubernetesNodePoolStatusFailed),
+		string(KubernetesNodePoolStatusDeleting), string(KubernetesNodePoolStatusDeleted))
+	nodePool, err := w.Wait(ctx, waitOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
-	if nodePool.Status == KubernetesNodePoolStatusReady {
-		return nodePool, nil
-	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(5 * time.Second):
-			nodePool, err = c.GetKubernetesNodePool(ctx, clusterIdentity, identity)
-			if err != nil {
-				return nil, err
-			}
-			if nodePool.Status == KubernetesNodePoolStatusReady {
-				return nodePool, nil
-			}
-		}
-	}
+	return nodePool, nil
 }
 
 // WaitUntilKubernetesNodePoolDeleted waits until the node pool is deleted.
@@ -150,39 +143,23 @@ func (c *Client) WaitUntilKubernetesNodePoolReady(ctx context.Context, clusterId
 //	if err != nil {
 //		log.Fatalf("Failed to wait for node pool to be deleted: %v", err)
 //	}
-func (c *Client) WaitUntilKubernetesNodePoolDeleted(ctx context.Context, clusterIdentity string, identity string) error {
-	nodePool, err := c.GetKubernetesNodePool(ctx, clusterIdentity, identity)
-	if err != nil {
-		return err
-	}
-	if nodePool == nil {
-		return nil
-	}
-	if nodePool.Status == KubernetesNodePoolStatusDeleted {
-		return nil
-	}
-	if nodePool.Status != KubernetesNodePoolStatusDeleting {
-		return fmt.Errorf("node pool %s is not being deleted", identity)
-	}
+func (c *Client) WaitUntilKubernetesNodePoolDeleted(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("node pool "+identity, c.nodePoolGetter(clusterIdentity, identity), nodePoolStatus,
+		string(KubernetesNodePoolStatusDeleting), string(KubernetesNodePoolStatusDeleted)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(5 * time.Second):
-			nodePool, err := c.GetKubernetesNodePool(ctx, clusterIdentity, identity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if nodePool.Status == KubernetesNodePoolStatusDeleted {
-				return nil
-			}
-			if nodePool.Status != KubernetesNodePoolStatusDeleting {
-				return fmt.Errorf("node pool %s is not being deleted", identity)
-			}
+func (c *Client) nodePoolGetter(clusterIdentity string, identity string) func(context.Context) (*KubernetesNodePool, error) {
+	return func(ctx context.Context) (*KubernetesNodePool, error) {
+		nodePool, err := c.GetKubernetesNodePool(ctx, clusterIdentity, identity)
+		if err == nil && nodePool == nil {
+			// An empty response means the node pool no longer exists.
+			return nil, client.ErrNotFound
 		}
+		return nodePool, err
 	}
 }
+
+func nodePoolStatus(nodePool *KubernetesNodePool) string {
+	return string(nodePool.Status)
+}
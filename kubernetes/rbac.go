@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -39,6 +40,13 @@ func (c *Client) ListKubernetesClusterRoles(ctx context.Context, request *ListKu
 	return roles, nil
 }
 
+// AllKubernetesClusterRoles returns an iterator over the results of ListKubernetesClusterRoles.
+func (c *Client) AllKubernetesClusterRoles(ctx context.Context, request *ListKubernetesClusterRolesRequest) iter.Seq2[KubernetesClusterRole, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesClusterRole, error) {
+		return c.ListKubernetesClusterRoles(ctx, request)
+	})
+}
+
 // CreateKubernetesClusterRole creates a new KubernetesClusterRole.
 func (c *Client) CreateKubernetesClusterRole(ctx context.Context, create CreateKubernetesClusterRoleRequest) (*KubernetesClusterRole, error) {
 	var role *KubernetesClusterRole
@@ -128,6 +136,13 @@ func (c *Client) ListClusterRoleBindings(ctx context.Context, identity string) (
 	return bindings, nil
 }
 
+// AllClusterRoleBindings returns an iterator over the results of ListClusterRoleBindings.
+func (c *Client) AllClusterRoleBindings(ctx context.Context, identity string) iter.Seq2[KubernetesClusterRoleBinding, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]KubernetesClusterRoleBinding, error) {
+		return c.ListClusterRoleBindings(ctx, identity)
+	})
+}
+
 // CreateClusterRoleBinding creates a new binding for a KubernetesClusterRole.
 func (c *Client) CreateClusterRoleBinding(ctx context.Context, identity string, create CreateKubernetesClusterRoleBinding) (*KubernetesClusterRoleBinding, error) {
 	var binding *KubernetesClusterRoleBinding
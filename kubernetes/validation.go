@@ -0,0 +1,221 @@
+package kubernetes
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+var (
+	autoUpgradePolicies = []KubernetesClusterAutoUpgradePolicy{
+		KubernetesClusterAutoUpgradePolicyNone,
+		KubernetesClusterAutoUpgradePolicyLatestVersion,
+		KubernetesClusterAutoUpgradePolicyLatestStable,
+	}
+	podSecurityStandards = []KubernetesClusterPodSecurityStandards{
+		KubernetesClusterPodSecurityStandardRestricted,
+		KubernetesClusterPodSecurityStandardBaseline,
+		KubernetesClusterPodSecurityStandardPrivileged,
+	}
+	auditLogProfiles = []KubernetesClusterAuditLoggingProfile{
+		KubernetesClusterAuditLoggingProfileNone,
+		KubernetesClusterAuditLoggingProfileBasic,
+		KubernetesClusterAuditLoggingProfileAdvanced,
+	}
+	defaultNetworkPolicies = []KubernetesDefaultNetworkPolicies{
+		KubernetesDefaultNetworkPolicyAllowAll,
+		KubernetesDefaultNetworkPolicyDenyAll,
+	}
+	upgradeStrategies = []KubernetesNodePoolUpgradeStrategy{
+		KubernetesNodePoolUpgradeStrategyManual,
+		KubernetesNodePoolUpgradeStrategyAuto,
+		KubernetesNodePoolUpgradeStrategyMinorOnly,
+		KubernetesNodePoolUpgradeStrategyAlways,
+		KubernetesNodePoolUpgradeStrategyOnDelete,
+		KubernetesNodePoolUpgradeStrategyInplace,
+		KubernetesNodePoolUpgradeStrategyNever,
+	}
+)
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateKubernetesCluster) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	validate.OneOf(&f, "clusterType", r.ClusterType, Managed, HostedControlPlane)
+	f.Nested("networking", r.Networking.Validate())
+	validate.OneOf(&f, "podSecurityStandardsProfile", r.PodSecurityStandardsProfile, podSecurityStandards...)
+	validate.OneOf(&f, "auditLogProfile", r.AuditLogProfile, auditLogProfiles...)
+	validate.OneOf(&f, "defaultNetworkPolicy", r.DefaultNetworkPolicy, defaultNetworkPolicies...)
+	f.Nested("apiServerACL", r.ApiServerACLs.Validate())
+	validateKubeProxy(&f, r.KubeProxyMode, r.KubeProxyDeployment)
+	validateMaintenance(&f, r.AutoUpgradePolicy, r.MaintenanceDay, r.MaintenanceStartAt)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateKubernetesCluster) Validate() error {
+	var f validate.Fields
+	if r.Name != nil {
+		f.Required("name", *r.Name)
+	}
+	if r.KubernetesVersionIdentity != nil {
+		f.Required("kubernetesVersionIdentity", *r.KubernetesVersionIdentity)
+	}
+	if r.PodSecurityStandardsProfile != nil {
+		validate.OneOf(&f, "podSecurityStandardsProfile", *r.PodSecurityStandardsProfile, podSecurityStandards...)
+	}
+	if r.AuditLogProfile != nil {
+		validate.OneOf(&f, "auditLogProfile", *r.AuditLogProfile, auditLogProfiles...)
+	}
+	if r.DefaultNetworkPolicy != nil {
+		validate.OneOf(&f, "defaultNetworkPolicy", *r.DefaultNetworkPolicy, defaultNetworkPolicies...)
+	}
+	f.Nested("apiServerACL", r.ApiServerACLs.Validate())
+	validateKubeProxy(&f, r.KubeProxyMode, r.KubeProxyDeployment)
+	validateMaintenance(&f, r.AutoUpgradePolicy, r.MaintenanceDay, r.MaintenanceStartAt)
+	return f.Err()
+}
+
+// Validate checks the fields of the networking configuration before it is sent.
+func (n KubernetesClusterNetworking) Validate() error {
+	var f validate.Fields
+	f.CIDR("serviceCidr", n.ServiceCIDR)
+	f.CIDR("podCidr", n.PodCIDR)
+	if n.ServiceCIDR != "" {
+		f.Check(n.ServiceCIDR != n.PodCIDR, "podCidr", "must not be the same as serviceCidr")
+	}
+	validateKubeProxy(&f, n.KubeProxyMode, n.KubeProxyDeployment)
+	return f.Err()
+}
+
+// Validate checks the fields of the API server ACLs before they are sent.
+func (a KubernetesApiServerACLs) Validate() error {
+	var f validate.Fields
+	for i, cidr := range a.AllowedCIDRs {
+		f.Required(validate.Index("allowedCIDRs", i), cidr)
+		f.IPOrCIDR(validate.Index("allowedCIDRs", i), cidr)
+	}
+	return f.Err()
+}
+
+func validateKubeProxy(f *validate.Fields, mode *KubernetesClusterKubeProxyMode, deployment *KubeProxyDeployment) {
+	if mode != nil {
+		validate.OneOf(f, "kubeProxyMode", *mode, KubernetesClusterKubeProxyModeIPVS, KubernetesClusterKubeProxyModeIptables)
+	}
+	if deployment != nil {
+		validate.OneOf(f, "kubeProxyDeployment", *deployment, KubeProxyDeploymentCustom, KubeProxyDeploymentManaged, KubeProxyDeploymentDisabled)
+	}
+}
+
+func validateMaintenance(f *validate.Fields, policy KubernetesClusterAutoUpgradePolicy, day, startAt *uint) {
+	validate.OneOf(f, "autoUpgradePolicy", policy, autoUpgradePolicies...)
+	if day != nil {
+		validate.Range(f, "maintenanceDay", *day, 0, 6)
+	}
+	if startAt != nil {
+		validate.Range(f, "maintenanceStartAt", *startAt, 0, 23)
+	}
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateKubernetesNodePool) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	validateReplicas(&f, &r.Replicas, &r.MinReplicas, &r.MaxReplicas, r.EnableAutoscaling)
+	if r.UpgradeStrategy != nil {
+		validate.OneOf(&f, "upgradeStrategy", *r.UpgradeStrategy, upgradeStrategies...)
+	}
+	f.Nested("nodeSettings", r.NodeSettings.Validate())
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateKubernetesNodePool) Validate() error {
+	var f validate.Fields
+	validateReplicas(&f, r.Replicas, r.MinReplicas, r.MaxReplicas, r.EnableAutoscaling != nil && *r.EnableAutoscaling)
+	if r.UpgradeStrategy != nil {
+		validate.OneOf(&f, "upgradeStrategy", *r.UpgradeStrategy, upgradeStrategies...)
+	}
+	if r.NodeSettings != nil {
+		f.Nested("nodeSettings", r.NodeSettings.Validate())
+	}
+	return f.Err()
+}
+
+func validateReplicas(f *validate.Fields, replicas, minReplicas, maxReplicas *int, autoscaling bool) {
+	if replicas != nil {
+		f.Check(*replicas >= 0, "replicas", "must not be negative")
+	}
+	if minReplicas != nil {
+		f.Check(*minReplicas >= 0, "minReplicas", "must not be negative")
+	}
+	if autoscaling && minReplicas != nil && maxReplicas != nil {
+		f.Check(*minReplicas <= *maxReplicas, "maxReplicas", "must not be less than minReplicas")
+	}
+}
+
+// Validate checks that the node labels, annotations and taints are valid in Kubernetes.
+func (s KubernetesNodeSettings) Validate() error {
+	var f validate.Fields
+	f.Labels("nodeLabels", s.Labels)
+	for _, key := range slices.Sorted(maps.Keys(s.Annotations)) {
+		f.LabelKey(validate.Key("nodeAnnotations", key), key)
+	}
+	for i, taint := range s.Taints {
+		f.Nested(validate.Index("nodeTaints", i), taint.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the taint before it is sent.
+func (t NodeTaint) Validate() error {
+	var f validate.Fields
+	f.Required("key", t.Key)
+	if t.Key != "" {
+		f.LabelKey("key", t.Key)
+	}
+	f.LabelValue("value", t.Value)
+	validate.OneOf(&f, "operator", t.Operator, "Equal", "Exists")
+	if t.Operator == "Exists" {
+		f.Check(t.Value == "", "value", "must be empty for operator Exists")
+	}
+	f.Required("effect", t.Effect)
+	validate.OneOf(&f, "effect", t.Effect, "NoSchedule", "PreferNoSchedule", "NoExecute")
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateKubernetesClusterRoleRequest) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateKubernetesClusterRoleBinding) Validate() error {
+	var f validate.Fields
+	f.Required("name", r.Name)
+	f.Check(r.UserIdentity != nil || r.TeamIdentity != nil || r.ServiceAccountIdentity != nil, "userIdentity", "one of userIdentity, teamIdentity or serviceAccountIdentity is required")
+	return f.Err()
+}
+
+// Validate checks the fields of the rule before it is sent.
+func (r AddKubernetesClusterRolePermissionRule) Validate() error {
+	var f validate.Fields
+	f.Check(len(r.Resources) > 0 || len(r.NonResourceURLs) > 0, "resources", "is required")
+	f.Check(len(r.Verbs) > 0, "verbs", "is required")
+	for i, verb := range r.Verbs {
+		validate.OneOf(&f, validate.Index("verbs", i), verb,
+			KubernetesClusterRolePermissionVerbWildcard,
+			KubernetesClusterRolePermissionVerbGet,
+			KubernetesClusterRolePermissionVerbList,
+			KubernetesClusterRolePermissionVerbWatch,
+			KubernetesClusterRolePermissionVerbCreate,
+			KubernetesClusterRolePermissionVerbUpdate,
+			KubernetesClusterRolePermissionVerbDelete,
+			KubernetesClusterRolePermissionVerbPatch,
+		)
+	}
+	return f.Err()
+}
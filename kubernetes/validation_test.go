@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	day := uint(7)
+	hour := uint(3)
+	mode := KubernetesClusterKubeProxyMode("nftables")
+	strategy := KubernetesNodePoolUpgradeStrategy("rolling")
+	one, two := 1, 2
+	enabled := true
+
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid cluster",
+			req: CreateKubernetesCluster{
+				Name:          "k8s",
+				ClusterType:   Managed,
+				Networking:    KubernetesClusterNetworking{ServiceCIDR: "172.16.0.0/16", PodCIDR: "192.168.0.0/16"},
+				ApiServerACLs: KubernetesApiServerACLs{AllowedCIDRs: []string{"203.0.113.7", "198.51.100.0/24"}},
+			},
+		},
+		{
+			name: "cluster reports every invalid field",
+			req: CreateKubernetesCluster{
+				ClusterType:        "kind",
+				Networking:         KubernetesClusterNetworking{ServiceCIDR: "172.16.0.0/16", PodCIDR: "172.16.0.0/16", KubeProxyMode: &mode},
+				ApiServerACLs:      KubernetesApiServerACLs{AllowedCIDRs: []string{"everywhere"}},
+				AutoUpgradePolicy:  "nightly",
+				MaintenanceDay:     &day,
+				MaintenanceStartAt: &hour,
+			},
+			want: []string{
+				"name",
+				"clusterType",
+				"networking.podCidr",
+				"networking.kubeProxyMode",
+				"apiServerACL.allowedCIDRs[0]",
+				"autoUpgradePolicy",
+				"maintenanceDay",
+			},
+		},
+		{
+			name: "node pool labels and taints",
+			req: CreateKubernetesNodePool{
+				Name:            "pool",
+				UpgradeStrategy: &strategy,
+				NodeSettings: KubernetesNodeSettings{
+					Labels:      map[string]string{"node.thalassa.cloud/role": "worker", "role": "has spaces"},
+					Annotations: map[string]string{"example.com/owner": "team a", "not a key": ""},
+					Taints: []NodeTaint{
+						{Key: "dedicated", Value: "gpu", Operator: "Equal", Effect: "NoSchedule"},
+						{Key: "dedicated", Value: "gpu", Operator: "Exists", Effect: "Never"},
+					},
+				},
+			},
+			want: []string{
+				"upgradeStrategy",
+				"nodeSettings.nodeLabels[role]",
+				"nodeSettings.nodeAnnotations[not a key]",
+				"nodeSettings.nodeTaints[1].value",
+				"nodeSettings.nodeTaints[1].effect",
+			},
+		},
+		{
+			name: "autoscaling bounds",
+			req:  UpdateKubernetesNodePool{MinReplicas: &two, MaxReplicas: &one, EnableAutoscaling: &enabled},
+			want: []string{"maxReplicas"},
+		},
+		{
+			name: "role binding subject",
+			req:  CreateKubernetesClusterRoleBinding{Name: "admins"},
+			want: []string{"userIdentity"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
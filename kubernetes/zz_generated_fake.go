@@ -0,0 +1,464 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AddClusterRoleRuleFunc, if set, handles calls to AddClusterRoleRule.
+	AddClusterRoleRuleFunc func(ctx context.Context, identity string, rule AddKubernetesClusterRolePermissionRule) (*KubernetesClusterRolePermissionRule, error)
+	// AllClusterRoleBindingsFunc, if set, handles calls to AllClusterRoleBindings.
+	AllClusterRoleBindingsFunc func(ctx context.Context, identity string) iter.Seq2[KubernetesClusterRoleBinding, error]
+	// AllKubernetesClusterRolesFunc, if set, handles calls to AllKubernetesClusterRoles.
+	AllKubernetesClusterRolesFunc func(ctx context.Context, request *ListKubernetesClusterRolesRequest) iter.Seq2[KubernetesClusterRole, error]
+	// AllKubernetesClustersFunc, if set, handles calls to AllKubernetesClusters.
+	AllKubernetesClustersFunc func(ctx context.Context, request *ListKubernetesClustersRequest) iter.Seq2[KubernetesCluster, error]
+	// AllKubernetesNodePoolsFunc, if set, handles calls to AllKubernetesNodePools.
+	AllKubernetesNodePoolsFunc func(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) iter.Seq2[KubernetesNodePool, error]
+	// AllKubernetesVersionsFunc, if set, handles calls to AllKubernetesVersions.
+	AllKubernetesVersionsFunc func(ctx context.Context) iter.Seq2[KubernetesVersion, error]
+	// AllNodePoolMachinesFunc, if set, handles calls to AllNodePoolMachines.
+	AllNodePoolMachinesFunc func(ctx context.Context, clusterIdentity string, nodePoolIdentity string) iter.Seq2[KubernetesNodePoolMachine, error]
+	// CreateClusterRoleBindingFunc, if set, handles calls to CreateClusterRoleBinding.
+	CreateClusterRoleBindingFunc func(ctx context.Context, identity string, create CreateKubernetesClusterRoleBinding) (*KubernetesClusterRoleBinding, error)
+	// CreateKubernetesClusterFunc, if set, handles calls to CreateKubernetesCluster.
+	CreateKubernetesClusterFunc func(ctx context.Context, create CreateKubernetesCluster) (*KubernetesCluster, error)
+	// CreateKubernetesClusterRoleFunc, if set, handles calls to CreateKubernetesClusterRole.
+	CreateKubernetesClusterRoleFunc func(ctx context.Context, create CreateKubernetesClusterRoleRequest) (*KubernetesClusterRole, error)
+	// CreateKubernetesNodePoolFunc, if set, handles calls to CreateKubernetesNodePool.
+	CreateKubernetesNodePoolFunc func(ctx context.Context, clusterIdentity string, create CreateKubernetesNodePool) (*KubernetesNodePool, error)
+	// DeleteClusterRoleFunc, if set, handles calls to DeleteClusterRole.
+	DeleteClusterRoleFunc func(ctx context.Context, identity string) error
+	// DeleteClusterRoleBindingFunc, if set, handles calls to DeleteClusterRoleBinding.
+	DeleteClusterRoleBindingFunc func(ctx context.Context, identity string, roleBindingIdentity string) error
+	// DeleteClusterRoleRuleFunc, if set, handles calls to DeleteClusterRoleRule.
+	DeleteClusterRoleRuleFunc func(ctx context.Context, identity string, ruleIdentity string) error
+	// DeleteKubernetesClusterFunc, if set, handles calls to DeleteKubernetesCluster.
+	DeleteKubernetesClusterFunc func(ctx context.Context, identity string) error
+	// DeleteKubernetesNodePoolFunc, if set, handles calls to DeleteKubernetesNodePool.
+	DeleteKubernetesNodePoolFunc func(ctx context.Context, clusterIdentity string, identity string) error
+	// DeleteNodePoolMachineFunc, if set, handles calls to DeleteNodePoolMachine.
+	DeleteNodePoolMachineFunc func(ctx context.Context, clusterIdentity string, nodePoolIdentity string, machineIdentity string, scaleDown bool) error
+	// GetKubernetesClusterFunc, if set, handles calls to GetKubernetesCluster.
+	GetKubernetesClusterFunc func(ctx context.Context, identity string) (*KubernetesCluster, error)
+	// GetKubernetesClusterKubeconfigFunc, if set, handles calls to GetKubernetesClusterKubeconfig.
+	GetKubernetesClusterKubeconfigFunc func(ctx context.Context, identity string) (*KubernetesClusterSessionToken, error)
+	// GetKubernetesClusterRoleFunc, if set, handles calls to GetKubernetesClusterRole.
+	GetKubernetesClusterRoleFunc func(ctx context.Context, identity string) (*KubernetesClusterRole, error)
+	// GetKubernetesNodePoolFunc, if set, handles calls to GetKubernetesNodePool.
+	GetKubernetesNodePoolFunc func(ctx context.Context, clusterIdentity string, identity string) (*KubernetesNodePool, error)
+	// GetKubernetesVersionFunc, if set, handles calls to GetKubernetesVersion.
+	GetKubernetesVersionFunc func(ctx context.Context, identity string) (*KubernetesVersion, error)
+	// ListClusterRoleBindingsFunc, if set, handles calls to ListClusterRoleBindings.
+	ListClusterRoleBindingsFunc func(ctx context.Context, identity string) ([]KubernetesClusterRoleBinding, error)
+	// ListKubernetesClusterRolesFunc, if set, handles calls to ListKubernetesClusterRoles.
+	ListKubernetesClusterRolesFunc func(ctx context.Context, request *ListKubernetesClusterRolesRequest) ([]KubernetesClusterRole, error)
+	// ListKubernetesClustersFunc, if set, handles calls to ListKubernetesClusters.
+	ListKubernetesClustersFunc func(ctx context.Context, request *ListKubernetesClustersRequest) ([]KubernetesCluster, error)
+	// ListKubernetesNodePoolsFunc, if set, handles calls to ListKubernetesNodePools.
+	ListKubernetesNodePoolsFunc func(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) ([]KubernetesNodePool, error)
+	// ListKubernetesVersionsFunc, if set, handles calls to ListKubernetesVersions.
+	ListKubernetesVersionsFunc func(ctx context.Context) ([]KubernetesVersion, error)
+	// ListNodePoolMachinesFunc, if set, handles calls to ListNodePoolMachines.
+	ListNodePoolMachinesFunc func(ctx context.Context, clusterIdentity string, nodePoolIdentity string) ([]KubernetesNodePoolMachine, error)
+	// UpdateKubernetesClusterFunc, if set, handles calls to UpdateKubernetesCluster.
+	UpdateKubernetesClusterFunc func(ctx context.Context, identity string, update UpdateKubernetesCluster) (*KubernetesCluster, error)
+	// UpdateKubernetesNodePoolFunc, if set, handles calls to UpdateKubernetesNodePool.
+	UpdateKubernetesNodePoolFunc func(ctx context.Context, clusterIdentity string, identity string, update UpdateKubernetesNodePool) (*KubernetesNodePool, error)
+	// WaitUntilKubernetesClusterDeletedFunc, if set, handles calls to WaitUntilKubernetesClusterDeleted.
+	WaitUntilKubernetesClusterDeletedFunc func(ctx context.Context, identity string, opts ...wait.Option) error
+	// WaitUntilKubernetesClusterReadyFunc, if set, handles calls to WaitUntilKubernetesClusterReady.
+	WaitUntilKubernetesClusterReadyFunc func(ctx context.Context, identity string, opts ...wait.Option) (*KubernetesCluster, error)
+	// WaitUntilKubernetesNodePoolDeletedFunc, if set, handles calls to WaitUntilKubernetesNodePoolDeleted.
+	WaitUntilKubernetesNodePoolDeletedFunc func(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) error
+	// WaitUntilKubernetesNodePoolReadyFunc, if set, handles calls to WaitUntilKubernetesNodePoolReady.
+	WaitUntilKubernetesNodePoolReadyFunc func(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) (*KubernetesNodePool, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AddClusterRoleRule records the call and invokes AddClusterRoleRuleFunc if set.
+func (f *Fake) AddClusterRoleRule(ctx context.Context, identity string, rule AddKubernetesClusterRolePermissionRule) (r0 *KubernetesClusterRolePermissionRule, r1 error) {
+	f.Record("AddClusterRoleRule", ctx, identity, rule)
+	if f.AddClusterRoleRuleFunc != nil {
+		return f.AddClusterRoleRuleFunc(ctx, identity, rule)
+	}
+	r1 = f.Err
+	return
+}
+
+// AllClusterRoleBindings records the call and invokes AllClusterRoleBindingsFunc if set.
+func (f *Fake) AllClusterRoleBindings(ctx context.Context, identity string) (r0 iter.Seq2[KubernetesClusterRoleBinding, error]) {
+	f.Record("AllClusterRoleBindings", ctx, identity)
+	if f.AllClusterRoleBindingsFunc != nil {
+		return f.AllClusterRoleBindingsFunc(ctx, identity)
+	}
+	r0 = func(yield func(KubernetesClusterRoleBinding, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesClusterRoleBinding
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllKubernetesClusterRoles records the call and invokes AllKubernetesClusterRolesFunc if set.
+func (f *Fake) AllKubernetesClusterRoles(ctx context.Context, request *ListKubernetesClusterRolesRequest) (r0 iter.Seq2[KubernetesClusterRole, error]) {
+	f.Record("AllKubernetesClusterRoles", ctx, request)
+	if f.AllKubernetesClusterRolesFunc != nil {
+		return f.AllKubernetesClusterRolesFunc(ctx, request)
+	}
+	r0 = func(yield func(KubernetesClusterRole, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesClusterRole
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllKubernetesClusters records the call and invokes AllKubernetesClustersFunc if set.
+func (f *Fake) AllKubernetesClusters(ctx context.Context, request *ListKubernetesClustersRequest) (r0 iter.Seq2[KubernetesCluster, error]) {
+	f.Record("AllKubernetesClusters", ctx, request)
+	if f.AllKubernetesClustersFunc != nil {
+		return f.AllKubernetesClustersFunc(ctx, request)
+	}
+	r0 = func(yield func(KubernetesCluster, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesCluster
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllKubernetesNodePools records the call and invokes AllKubernetesNodePoolsFunc if set.
+func (f *Fake) AllKubernetesNodePools(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) (r0 iter.Seq2[KubernetesNodePool, error]) {
+	f.Record("AllKubernetesNodePools", ctx, clusterIdentity, request)
+	if f.AllKubernetesNodePoolsFunc != nil {
+		return f.AllKubernetesNodePoolsFunc(ctx, clusterIdentity, request)
+	}
+	r0 = func(yield func(KubernetesNodePool, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesNodePool
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllKubernetesVersions records the call and invokes AllKubernetesVersionsFunc if set.
+func (f *Fake) AllKubernetesVersions(ctx context.Context) (r0 iter.Seq2[KubernetesVersion, error]) {
+	f.Record("AllKubernetesVersions", ctx)
+	if f.AllKubernetesVersionsFunc != nil {
+		return f.AllKubernetesVersionsFunc(ctx)
+	}
+	r0 = func(yield func(KubernetesVersion, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesVersion
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllNodePoolMachines records the call and invokes AllNodePoolMachinesFunc if set.
+func (f *Fake) AllNodePoolMachines(ctx context.Context, clusterIdentity string, nodePoolIdentity string) (r0 iter.Seq2[KubernetesNodePoolMachine, error]) {
+	f.Record("AllNodePoolMachines", ctx, clusterIdentity, nodePoolIdentity)
+	if f.AllNodePoolMachinesFunc != nil {
+		return f.AllNodePoolMachinesFunc(ctx, clusterIdentity, nodePoolIdentity)
+	}
+	r0 = func(yield func(KubernetesNodePoolMachine, error) bool) {
+		if f.Err != nil {
+			var zero KubernetesNodePoolMachine
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateClusterRoleBinding records the call and invokes CreateClusterRoleBindingFunc if set.
+func (f *Fake) CreateClusterRoleBinding(ctx context.Context, identity string, create CreateKubernetesClusterRoleBinding) (r0 *KubernetesClusterRoleBinding, r1 error) {
+	f.Record("CreateClusterRoleBinding", ctx, identity, create)
+	if f.CreateClusterRoleBindingFunc != nil {
+		return f.CreateClusterRoleBindingFunc(ctx, identity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateKubernetesCluster records the call and invokes CreateKubernetesClusterFunc if set.
+func (f *Fake) CreateKubernetesCluster(ctx context.Context, create CreateKubernetesCluster) (r0 *KubernetesCluster, r1 error) {
+	f.Record("CreateKubernetesCluster", ctx, create)
+	if f.CreateKubernetesClusterFunc != nil {
+		return f.CreateKubernetesClusterFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateKubernetesClusterRole records the call and invokes CreateKubernetesClusterRoleFunc if set.
+func (f *Fake) CreateKubernetesClusterRole(ctx context.Context, create CreateKubernetesClusterRoleRequest) (r0 *KubernetesClusterRole, r1 error) {
+	f.Record("CreateKubernetesClusterRole", ctx, create)
+	if f.CreateKubernetesClusterRoleFunc != nil {
+		return f.CreateKubernetesClusterRoleFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateKubernetesNodePool records the call and invokes CreateKubernetesNodePoolFunc if set.
+func (f *Fake) CreateKubernetesNodePool(ctx context.Context, clusterIdentity string, create CreateKubernetesNodePool) (r0 *KubernetesNodePool, r1 error) {
+	f.Record("CreateKubernetesNodePool", ctx, clusterIdentity, create)
+	if f.CreateKubernetesNodePoolFunc != nil {
+		return f.CreateKubernetesNodePoolFunc(ctx, clusterIdentity, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteClusterRole records the call and invokes DeleteClusterRoleFunc if set.
+func (f *Fake) DeleteClusterRole(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteClusterRole", ctx, identity)
+	if f.DeleteClusterRoleFunc != nil {
+		return f.DeleteClusterRoleFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteClusterRoleBinding records the call and invokes DeleteClusterRoleBindingFunc if set.
+func (f *Fake) DeleteClusterRoleBinding(ctx context.Context, identity string, roleBindingIdentity string) (r0 error) {
+	f.Record("DeleteClusterRoleBinding", ctx, identity, roleBindingIdentity)
+	if f.DeleteClusterRoleBindingFunc != nil {
+		return f.DeleteClusterRoleBindingFunc(ctx, identity, roleBindingIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteClusterRoleRule records the call and invokes DeleteClusterRoleRuleFunc if set.
+func (f *Fake) DeleteClusterRoleRule(ctx context.Context, identity string, ruleIdentity string) (r0 error) {
+	f.Record("DeleteClusterRoleRule", ctx, identity, ruleIdentity)
+	if f.DeleteClusterRoleRuleFunc != nil {
+		return f.DeleteClusterRoleRuleFunc(ctx, identity, ruleIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteKubernetesCluster records the call and invokes DeleteKubernetesClusterFunc if set.
+func (f *Fake) DeleteKubernetesCluster(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteKubernetesCluster", ctx, identity)
+	if f.DeleteKubernetesClusterFunc != nil {
+		return f.DeleteKubernetesClusterFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteKubernetesNodePool records the call and invokes DeleteKubernetesNodePoolFunc if set.
+func (f *Fake) DeleteKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string) (r0 error) {
+	f.Record("DeleteKubernetesNodePool", ctx, clusterIdentity, identity)
+	if f.DeleteKubernetesNodePoolFunc != nil {
+		return f.DeleteKubernetesNodePoolFunc(ctx, clusterIdentity, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteNodePoolMachine records the call and invokes DeleteNodePoolMachineFunc if set.
+func (f *Fake) DeleteNodePoolMachine(ctx context.Context, clusterIdentity string, nodePoolIdentity string, machineIdentity string, scaleDown bool) (r0 error) {
+	f.Record("DeleteNodePoolMachine", ctx, clusterIdentity, nodePoolIdentity, machineIdentity, scaleDown)
+	if f.DeleteNodePoolMachineFunc != nil {
+		return f.DeleteNodePoolMachineFunc(ctx, clusterIdentity, nodePoolIdentity, machineIdentity, scaleDown)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetKubernetesCluster records the call and invokes GetKubernetesClusterFunc if set.
+func (f *Fake) GetKubernetesCluster(ctx context.Context, identity string) (r0 *KubernetesCluster, r1 error) {
+	f.Record("GetKubernetesCluster", ctx, identity)
+	if f.GetKubernetesClusterFunc != nil {
+		return f.GetKubernetesClusterFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetKubernetesClusterKubeconfig records the call and invokes GetKubernetesClusterKubeconfigFunc if set.
+func (f *Fake) GetKubernetesClusterKubeconfig(ctx context.Context, identity string) (r0 *KubernetesClusterSessionToken, r1 error) {
+	f.Record("GetKubernetesClusterKubeconfig", ctx, identity)
+	if f.GetKubernetesClusterKubeconfigFunc != nil {
+		return f.GetKubernetesClusterKubeconfigFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetKubernetesClusterRole records the call and invokes GetKubernetesClusterRoleFunc if set.
+func (f *Fake) GetKubernetesClusterRole(ctx context.Context, identity string) (r0 *KubernetesClusterRole, r1 error) {
+	f.Record("GetKubernetesClusterRole", ctx, identity)
+	if f.GetKubernetesClusterRoleFunc != nil {
+		return f.GetKubernetesClusterRoleFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetKubernetesNodePool records the call and invokes GetKubernetesNodePoolFunc if set.
+func (f *Fake) GetKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string) (r0 *KubernetesNodePool, r1 error) {
+	f.Record("GetKubernetesNodePool", ctx, clusterIdentity, identity)
+	if f.GetKubernetesNodePoolFunc != nil {
+		return f.GetKubernetesNodePoolFunc(ctx, clusterIdentity, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetKubernetesVersion records the call and invokes GetKubernetesVersionFunc if set.
+func (f *Fake) GetKubernetesVersion(ctx context.Context, identity string) (r0 *KubernetesVersion, r1 error) {
+	f.Record("GetKubernetesVersion", ctx, identity)
+	if f.GetKubernetesVersionFunc != nil {
+		return f.GetKubernetesVersionFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListClusterRoleBindings records the call and invokes ListClusterRoleBindingsFunc if set.
+func (f *Fake) ListClusterRoleBindings(ctx context.Context, identity string) (r0 []KubernetesClusterRoleBinding, r1 error) {
+	f.Record("ListClusterRoleBindings", ctx, identity)
+	if f.ListClusterRoleBindingsFunc != nil {
+		return f.ListClusterRoleBindingsFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListKubernetesClusterRoles records the call and invokes ListKubernetesClusterRolesFunc if set.
+func (f *Fake) ListKubernetesClusterRoles(ctx context.Context, request *ListKubernetesClusterRolesRequest) (r0 []KubernetesClusterRole, r1 error) {
+	f.Record("ListKubernetesClusterRoles", ctx, request)
+	if f.ListKubernetesClusterRolesFunc != nil {
+		return f.ListKubernetesClusterRolesFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListKubernetesClusters records the call and invokes ListKubernetesClustersFunc if set.
+func (f *Fake) ListKubernetesClusters(ctx context.Context, request *ListKubernetesClustersRequest) (r0 []KubernetesCluster, r1 error) {
+	f.Record("ListKubernetesClusters", ctx, request)
+	if f.ListKubernetesClustersFunc != nil {
+		return f.ListKubernetesClustersFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListKubernetesNodePools records the call and invokes ListKubernetesNodePoolsFunc if set.
+func (f *Fake) ListKubernetesNodePools(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) (r0 []KubernetesNodePool, r1 error) {
+	f.Record("ListKubernetesNodePools", ctx, clusterIdentity, request)
+	if f.ListKubernetesNodePoolsFunc != nil {
+		return f.ListKubernetesNodePoolsFunc(ctx, clusterIdentity, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListKubernetesVersions records the call and invokes ListKubernetesVersionsFunc if set.
+func (f *Fake) ListKubernetesVersions(ctx context.Context) (r0 []KubernetesVersion, r1 error) {
+	f.Record("ListKubernetesVersions", ctx)
+	if f.ListKubernetesVersionsFunc != nil {
+		return f.ListKubernetesVersionsFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListNodePoolMachines records the call and invokes ListNodePoolMachinesFunc if set.
+func (f *Fake) ListNodePoolMachines(ctx context.Context, clusterIdentity string, nodePoolIdentity string) (r0 []KubernetesNodePoolMachine, r1 error) {
+	f.Record("ListNodePoolMachines", ctx, clusterIdentity, nodePoolIdentity)
+	if f.ListNodePoolMachinesFunc != nil {
+		return f.ListNodePoolMachinesFunc(ctx, clusterIdentity, nodePoolIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateKubernetesCluster records the call and invokes UpdateKubernetesClusterFunc if set.
+func (f *Fake) UpdateKubernetesCluster(ctx context.Context, identity string, update UpdateKubernetesCluster) (r0 *KubernetesCluster, r1 error) {
+	f.Record("UpdateKubernetesCluster", ctx, identity, update)
+	if f.UpdateKubernetesClusterFunc != nil {
+		return f.UpdateKubernetesClusterFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateKubernetesNodePool records the call and invokes UpdateKubernetesNodePoolFunc if set.
+func (f *Fake) UpdateKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string, update UpdateKubernetesNodePool) (r0 *KubernetesNodePool, r1 error) {
+	f.Record("UpdateKubernetesNodePool", ctx, clusterIdentity, identity, update)
+	if f.UpdateKubernetesNodePoolFunc != nil {
+		return f.UpdateKubernetesNodePoolFunc(ctx, clusterIdentity, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilKubernetesClusterDeleted records the call and invokes WaitUntilKubernetesClusterDeletedFunc if set.
+func (f *Fake) WaitUntilKubernetesClusterDeleted(ctx context.Context, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilKubernetesClusterDeleted", ctx, identity, opts)
+	if f.WaitUntilKubernetesClusterDeletedFunc != nil {
+		return f.WaitUntilKubernetesClusterDeletedFunc(ctx, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilKubernetesClusterReady records the call and invokes WaitUntilKubernetesClusterReadyFunc if set.
+func (f *Fake) WaitUntilKubernetesClusterReady(ctx context.Context, identity string, opts ...wait.Option) (r0 *KubernetesCluster, r1 error) {
+	f.Record("WaitUntilKubernetesClusterReady", ctx, identity, opts)
+	if f.WaitUntilKubernetesClusterReadyFunc != nil {
+		return f.WaitUntilKubernetesClusterReadyFunc(ctx, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilKubernetesNodePoolDeleted records the call and invokes WaitUntilKubernetesNodePoolDeletedFunc if set.
+func (f *Fake) WaitUntilKubernetesNodePoolDeleted(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilKubernetesNodePoolDeleted", ctx, clusterIdentity, identity, opts)
+	if f.WaitUntilKubernetesNodePoolDeletedFunc != nil {
+		return f.WaitUntilKubernetesNodePoolDeletedFunc(ctx, clusterIdentity, identity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilKubernetesNodePoolReady records the call and invokes WaitUntilKubernetesNodePoolReadyFunc if set.
+func (f *Fake) WaitUntilKubernetesNodePoolReady(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) (r0 *KubernetesNodePool, r1 error) {
+	f.Record("WaitUntilKubernetesNodePoolReady", ctx, clusterIdentity, identity, opts)
+	if f.WaitUntilKubernetesNodePoolReadyFunc != nil {
+		return f.WaitUntilKubernetesNodePoolReadyFunc(ctx, clusterIdentity, identity, opts...)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,175 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AddClusterRoleRule adds a permission rule to a KubernetesClusterRole.
+	AddClusterRoleRule(ctx context.Context, identity string, rule AddKubernetesClusterRolePermissionRule) (*KubernetesClusterRolePermissionRule, error)
+
+	// AllClusterRoleBindings returns an iterator over the results of ListClusterRoleBindings.
+	AllClusterRoleBindings(ctx context.Context, identity string) iter.Seq2[KubernetesClusterRoleBinding, error]
+
+	// AllKubernetesClusterRoles returns an iterator over the results of ListKubernetesClusterRoles.
+	AllKubernetesClusterRoles(ctx context.Context, request *ListKubernetesClusterRolesRequest) iter.Seq2[KubernetesClusterRole, error]
+
+	// AllKubernetesClusters returns an iterator over the results of ListKubernetesClusters.
+	AllKubernetesClusters(ctx context.Context, request *ListKubernetesClustersRequest) iter.Seq2[KubernetesCluster, error]
+
+	// AllKubernetesNodePools returns an iterator over the results of ListKubernetesNodePools.
+	AllKubernetesNodePools(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) iter.Seq2[KubernetesNodePool, error]
+
+	// AllKubernetesVersions returns an iterator over the results of ListKubernetesVersions.
+	AllKubernetesVersions(ctx context.Context) iter.Seq2[KubernetesVersion, error]
+
+	// AllNodePoolMachines returns an iterator over the results of ListNodePoolMachines.
+	AllNodePoolMachines(ctx context.Context, clusterIdentity string, nodePoolIdentity string) iter.Seq2[KubernetesNodePoolMachine, error]
+
+	// CreateClusterRoleBinding creates a new binding for a KubernetesClusterRole.
+	CreateClusterRoleBinding(ctx context.Context, identity string, create CreateKubernetesClusterRoleBinding) (*KubernetesClusterRoleBinding, error)
+
+	// CreateKubernetesCluster creates a new KubernetesCluster.
+	CreateKubernetesCluster(ctx context.Context, create CreateKubernetesCluster) (*KubernetesCluster, error)
+
+	// CreateKubernetesClusterRole creates a new KubernetesClusterRole.
+	CreateKubernetesClusterRole(ctx context.Context, create CreateKubernetesClusterRoleRequest) (*KubernetesClusterRole, error)
+
+	// CreateKubernetesNodePool creates a new KubernetesNodePool.
+	CreateKubernetesNodePool(ctx context.Context, clusterIdentity string, create CreateKubernetesNodePool) (*KubernetesNodePool, error)
+
+	// DeleteClusterRole deletes a specific KubernetesClusterRole by its identity.
+	DeleteClusterRole(ctx context.Context, identity string) error
+
+	// DeleteClusterRoleBinding deletes a specific binding from a KubernetesClusterRole.
+	DeleteClusterRoleBinding(ctx context.Context, identity string, roleBindingIdentity string) error
+
+	// DeleteClusterRoleRule deletes a permission rule from a KubernetesClusterRole.
+	DeleteClusterRoleRule(ctx context.Context, identity string, ruleIdentity string) error
+
+	// DeleteKubernetesCluster deletes a specific KubernetesCluster by its identity.
+	DeleteKubernetesCluster(ctx context.Context, identity string) error
+
+	// DeleteKubernetesNodePool deletes a specific KubernetesNodePool by its identity.
+	DeleteKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string) error
+
+	// DeleteNodePoolMachine deletes a specific machine from a node pool in a cluster.
+	// If scaleDown is true, the node pool will be scaled down by one as part of the deletion.
+	DeleteNodePoolMachine(ctx context.Context, clusterIdentity string, nodePoolIdentity string, machineIdentity string, scaleDown bool) error
+
+	// GetKubernetesCluster retrieves a specific KubernetesCluster by its identity.
+	GetKubernetesCluster(ctx context.Context, identity string) (*KubernetesCluster, error)
+
+	// GetKubernetesClusterKubeconfig retrieves the kubeconfig and authentication details for a Kubernetes cluster.
+	// This method returns a KubernetesClusterSessionToken containing all necessary information to connect to the cluster,
+	// including the API server URL, authentication token, CA certificate, and complete kubeconfig file content.
+	//
+	// Parameters:
+	//   - ctx: Context for the request, supporting cancellation and timeouts
+	//   - identity: The unique identifier of the Kubernetes cluster
+	//
+	// Returns:
+	//   - *KubernetesClusterSessionToken: Contains all cluster connection details
+	//   - error: Returns an error if the request fails, the cluster is not found, or the identity is invalid
+	//
+	// Example usage:
+	//
+	// 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	// 	defer cancel()
+	//
+	// 	session, err := client.GetKubernetesClusterKubeconfig(ctx, "cluster-123")
+	// 	if err != nil {
+	// 	    log.Fatalf("Failed to get kubeconfig: %v", err)
+	// 	}
+	GetKubernetesClusterKubeconfig(ctx context.Context, identity string) (*KubernetesClusterSessionToken, error)
+
+	// GetKubernetesClusterRole retrieves a specific KubernetesClusterRole by its identity.
+	GetKubernetesClusterRole(ctx context.Context, identity string) (*KubernetesClusterRole, error)
+
+	// GetKubernetesNodePool retrieves a specific KubernetesNodePool by its identity.
+	GetKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string) (*KubernetesNodePool, error)
+
+	// GetKubernetesVersion retrieves a specific KubernetesVersion by its identity.
+	GetKubernetesVersion(ctx context.Context, identity string) (*KubernetesVersion, error)
+
+	// ListClusterRoleBindings lists all bindings for a specific KubernetesClusterRole.
+	ListClusterRoleBindings(ctx context.Context, identity string) ([]KubernetesClusterRoleBinding, error)
+
+	// ListKubernetesClusterRoles lists all KubernetesClusterRoles for a given organisation.
+	ListKubernetesClusterRoles(ctx context.Context, request *ListKubernetesClusterRolesRequest) ([]KubernetesClusterRole, error)
+
+	// ListKubernetesClusters lists all KubernetesClusters for a given organisation.
+	ListKubernetesClusters(ctx context.Context, request *ListKubernetesClustersRequest) ([]KubernetesCluster, error)
+
+	// ListKubernetesNodePools lists all KubernetesNodePools for a given organisation.
+	ListKubernetesNodePools(ctx context.Context, clusterIdentity string, request *ListKubernetesNodePoolsRequest) ([]KubernetesNodePool, error)
+
+	// ListKubernetesVersions lists all KubernetesVersions for a given organisation.
+	ListKubernetesVersions(ctx context.Context) ([]KubernetesVersion, error)
+
+	// ListNodePoolMachines lists all machines for a specific node pool in a cluster
+	ListNodePoolMachines(ctx context.Context, clusterIdentity string, nodePoolIdentity string) ([]KubernetesNodePoolMachine, error)
+
+	// UpdateKubernetesCluster updates an existing KubernetesCluster.
+	UpdateKubernetesCluster(ctx context.Context, identity string, update UpdateKubernetesCluster) (*KubernetesCluster, error)
+
+	// UpdateKubernetesNodePool updates an existing KubernetesNodePool.
+	UpdateKubernetesNodePool(ctx context.Context, clusterIdentity string, identity string, update UpdateKubernetesNodePool) (*KubernetesNodePool, error)
+
+	// WaitUntilKubernetesClusterDeleted waits until the KubernetesCluster is deleted.
+	// It returns an error if the KubernetesCluster is not being deleted or if the context is cancelled.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	// defer cancel()
+	// err := c.WaitUntilKubernetesClusterDeleted(ctxt, "kubernetes-cluster-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for KubernetesCluster to be deleted: %v", err)
+	// 	}
+	WaitUntilKubernetesClusterDeleted(ctx context.Context, identity string, opts ...wait.Option) error
+
+	// WaitUntilKubernetesClusterReady waits until the KubernetesCluster is ready.
+	// It returns the KubernetesCluster when it is ready or an error if the KubernetesCluster failed, is being deleted or if the context is cancelled.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	// defer cancel()
+	// kubernetesCluster, err := c.WaitUntilKubernetesClusterReady(ctxt, "kubernetes-cluster-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for KubernetesCluster to be ready: %v", err)
+	// 	}
+	WaitUntilKubernetesClusterReady(ctx context.Context, identity string, opts ...wait.Option) (*KubernetesCluster, error)
+
+	// WaitUntilKubernetesNodePoolDeleted waits until the node pool is deleted.
+	// It returns an error if the node pool is not being deleted or if the context is cancelled.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	// defer cancel()
+	// err := c.WaitUntilKubernetesNodePoolDeleted(ctxt, "cluster-identity1234", "node-pool-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for node pool to be deleted: %v", err)
+	// 	}
+	WaitUntilKubernetesNodePoolDeleted(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) error
+
+	// WaitUntilKubernetesNodePoolReady waits until the node pool is ready. A node pool is ready when all nodes within the node pool are up-to-date and running.
+	// It returns the node pool when it is ready or an error if the node pool failed, is being deleted or if the context is cancelled.
+	// You are responsible for providing a context that can be cancelled, and for handling the error case.
+	// Example: ctxt, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	// defer cancel()
+	// nodePool, err := c.WaitUntilKubernetesNodePoolReady(ctxt, "cluster-identity1234", "node-pool-identity1234")
+	//
+	// 	if err != nil {
+	// 		log.Fatalf("Failed to wait for node pool to be ready: %v", err)
+	// 	}
+	WaitUntilKubernetesNodePoolReady(ctx context.Context, clusterIdentity string, identity string, opts ...wait.Option) (*KubernetesNodePool, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -2,6 +2,7 @@ package me
 
 import (
 	"context"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/base"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -25,6 +26,13 @@ func (c *Client) ListMyMemberships(ctx context.Context) ([]base.OrganisationMemb
 	return memberships, nil
 }
 
+// AllMyMemberships returns an iterator over the results of ListMyMemberships.
+func (c *Client) AllMyMemberships(ctx context.Context) iter.Seq2[base.OrganisationMember, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]base.OrganisationMember, error) {
+		return c.ListMyMemberships(ctx)
+	})
+}
+
 // ListMyOrganisations lists all organisations for the current user.
 func (c *Client) ListMyOrganisations(ctx context.Context) ([]base.Organisation, error) {
 	memberships, err := c.ListMyMemberships(ctx)
@@ -39,3 +47,10 @@ func (c *Client) ListMyOrganisations(ctx context.Context) ([]base.Organisation,
 	}
 	return organisations, nil
 }
+
+// AllMyOrganisations returns an iterator over the results of ListMyOrganisations.
+func (c *Client) AllMyOrganisations(ctx context.Context) iter.Seq2[base.Organisation, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]base.Organisation, error) {
+		return c.ListMyOrganisations(ctx)
+	})
+}
@@ -0,0 +1,84 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package me
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/base"
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllMyMembershipsFunc, if set, handles calls to AllMyMemberships.
+	AllMyMembershipsFunc func(ctx context.Context) iter.Seq2[base.OrganisationMember, error]
+	// AllMyOrganisationsFunc, if set, handles calls to AllMyOrganisations.
+	AllMyOrganisationsFunc func(ctx context.Context) iter.Seq2[base.Organisation, error]
+	// ListMyMembershipsFunc, if set, handles calls to ListMyMemberships.
+	ListMyMembershipsFunc func(ctx context.Context) ([]base.OrganisationMember, error)
+	// ListMyOrganisationsFunc, if set, handles calls to ListMyOrganisations.
+	ListMyOrganisationsFunc func(ctx context.Context) ([]base.Organisation, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllMyMemberships records the call and invokes AllMyMembershipsFunc if set.
+func (f *Fake) AllMyMemberships(ctx context.Context) (r0 iter.Seq2[base.OrganisationMember, error]) {
+	f.Record("AllMyMemberships", ctx)
+	if f.AllMyMembershipsFunc != nil {
+		return f.AllMyMembershipsFunc(ctx)
+	}
+	r0 = func(yield func(base.OrganisationMember, error) bool) {
+		if f.Err != nil {
+			var zero base.OrganisationMember
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// AllMyOrganisations records the call and invokes AllMyOrganisationsFunc if set.
+func (f *Fake) AllMyOrganisations(ctx context.Context) (r0 iter.Seq2[base.Organisation, error]) {
+	f.Record("AllMyOrganisations", ctx)
+	if f.AllMyOrganisationsFunc != nil {
+		return f.AllMyOrganisationsFunc(ctx)
+	}
+	r0 = func(yield func(base.Organisation, error) bool) {
+		if f.Err != nil {
+			var zero base.Organisation
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// ListMyMemberships records the call and invokes ListMyMembershipsFunc if set.
+func (f *Fake) ListMyMemberships(ctx context.Context) (r0 []base.OrganisationMember, r1 error) {
+	f.Record("ListMyMemberships", ctx)
+	if f.ListMyMembershipsFunc != nil {
+		return f.ListMyMembershipsFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListMyOrganisations records the call and invokes ListMyOrganisationsFunc if set.
+func (f *Fake) ListMyOrganisations(ctx context.Context) (r0 []base.Organisation, r1 error) {
+	f.Record("ListMyOrganisations", ctx)
+	if f.ListMyOrganisationsFunc != nil {
+		return f.ListMyOrganisationsFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
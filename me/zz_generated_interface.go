@@ -0,0 +1,28 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package me
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/base"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllMyMemberships returns an iterator over the results of ListMyMemberships.
+	AllMyMemberships(ctx context.Context) iter.Seq2[base.OrganisationMember, error]
+
+	// AllMyOrganisations returns an iterator over the results of ListMyOrganisations.
+	AllMyOrganisations(ctx context.Context) iter.Seq2[base.Organisation, error]
+
+	// ListMyMemberships lists all memberships for the current user.
+	ListMyMemberships(ctx context.Context) ([]base.OrganisationMember, error)
+
+	// ListMyOrganisations lists all organisations for the current user.
+	ListMyOrganisations(ctx context.Context) ([]base.Organisation, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -3,6 +3,7 @@ package objectstorage
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
@@ -27,6 +28,13 @@ func (c *Client) ListBuckets(ctx context.Context) ([]ObjectStorageBucket, error)
 	return buckets, nil
 }
 
+// AllBuckets returns an iterator over the results of ListBuckets.
+func (c *Client) AllBuckets(ctx context.Context) iter.Seq2[ObjectStorageBucket, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]ObjectStorageBucket, error) {
+		return c.ListBuckets(ctx)
+	})
+}
+
 func (c *Client) GetBucket(ctx context.Context, bucketName string) (*ObjectStorageBucket, error) {
 	bucket := ObjectStorageBucket{}
 	req := c.R().SetResult(&bucket)
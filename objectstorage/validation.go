@@ -0,0 +1,108 @@
+package objectstorage
+
+import (
+	"regexp"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+var bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+var versionings = []ObjectStorageBucketVersioning{
+	ObjectStorageBucketVersioningDisabled,
+	ObjectStorageBucketVersioningEnabled,
+	ObjectStorageBucketVersioningSuspended,
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateBucketRequest) Validate() error {
+	var f validate.Fields
+	f.Required("bucketName", r.BucketName)
+	if r.BucketName != "" {
+		f.Check(bucketName.MatchString(r.BucketName), "bucketName", "must be 3 to 63 lowercase letters, digits, '.' or '-', starting and ending with a letter or digit")
+	}
+	f.Required("region", r.Region)
+	validate.OneOf(&f, "versioning", r.Versioning, versionings...)
+	if r.PolicyDocument != nil {
+		f.Nested("policy", r.PolicyDocument.Validate())
+	}
+	if r.Lifecycle != nil {
+		f.Nested("lifecycle", r.Lifecycle.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateBucketRequest) Validate() error {
+	var f validate.Fields
+	validate.OneOf(&f, "versioning", r.Versioning, versionings...)
+	if r.PolicyDocument != nil {
+		f.Nested("policy", r.PolicyDocument.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the statements of the policy document.
+func (p PolicyDocument) Validate() error {
+	var f validate.Fields
+	for i, statement := range p.Statement {
+		field := validate.Index("Statement", i)
+		f.Required(field+".Effect", statement.Effect)
+		validate.OneOf(&f, field+".Effect", statement.Effect, "Allow", "Deny")
+		f.Check(statement.Action != nil, field+".Action", "is required")
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r SetBucketLifecycleRequest) Validate() error {
+	var f validate.Fields
+	ids := map[string]bool{}
+	for i, rule := range r.Rules {
+		field := validate.Index("rules", i)
+		f.Nested(field, rule.Validate())
+		if rule.ID != "" {
+			f.Check(!ids[rule.ID], field+".id", "must be unique")
+			ids[rule.ID] = true
+		}
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the lifecycle rule before it is sent.
+func (r BucketLifecycleRule) Validate() error {
+	var f validate.Fields
+	f.MaxLength("id", r.ID, 255)
+	validate.OneOf(&f, "status", r.Status, BucketLifecycleRuleStatusEnabled, BucketLifecycleRuleStatusDisabled)
+	f.Check(r.Expiration != nil || len(r.Transitions) > 0 || r.NoncurrentVersionExpiration != nil ||
+		len(r.NoncurrentVersionTransitions) > 0 || r.AbortIncompleteMultipartUpload != nil,
+		"expiration", "a rule needs at least one action")
+	if r.Expiration != nil {
+		f.Check(r.Expiration.Days == nil || r.Expiration.Date == nil, "expiration", "days and date are mutually exclusive")
+		checkDays(&f, "expiration.days", r.Expiration.Days)
+	}
+	for i, transition := range r.Transitions {
+		field := validate.Index("transitions", i)
+		f.Required(field+".storageClass", transition.StorageClass)
+		f.Check(transition.Days == nil || transition.Date == nil, field, "days and date are mutually exclusive")
+		checkDays(&f, field+".days", transition.Days)
+	}
+	if r.NoncurrentVersionExpiration != nil {
+		checkDays(&f, "noncurrentVersionExpiration.noncurrentDays", r.NoncurrentVersionExpiration.NoncurrentDays)
+	}
+	for i, transition := range r.NoncurrentVersionTransitions {
+		field := validate.Index("noncurrentVersionTransitions", i)
+		f.Required(field+".storageClass", transition.StorageClass)
+		checkDays(&f, field+".noncurrentDays", transition.NoncurrentDays)
+	}
+	if r.AbortIncompleteMultipartUpload != nil {
+		checkDays(&f, "abortIncompleteMultipartUpload.daysAfterInitiation", r.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+	}
+	return f.Err()
+}
+
+func checkDays(f *validate.Fields, field string, days *int64) {
+	if days != nil {
+		f.Check(*days > 0, field, "must be positive")
+	}
+}
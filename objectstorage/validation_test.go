@@ -0,0 +1,65 @@
+package objectstorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid bucket",
+			req: CreateBucketRequest{
+				BucketName: "app-assets",
+				Region:     "nl-01",
+				Versioning: ObjectStorageBucketVersioningEnabled,
+				Lifecycle: &SetBucketLifecycleRequest{Rules: []BucketLifecycleRule{
+					{ID: "expire-logs", Prefix: "logs/", Status: BucketLifecycleRuleStatusEnabled, Expiration: &BucketLifecycleRuleExpiration{Days: int64Ptr(30)}},
+				}},
+			},
+		},
+		{
+			name: "invalid bucket",
+			req: CreateBucketRequest{
+				BucketName:     "App_Assets",
+				Versioning:     "On",
+				PolicyDocument: &PolicyDocument{Statement: []Statement{{Effect: "allow", Action: "s3:GetObject"}}},
+			},
+			want: []string{"bucketName", "region", "versioning", "policy.Statement[0].Effect"},
+		},
+		{
+			name: "lifecycle rules",
+			req: SetBucketLifecycleRequest{Rules: []BucketLifecycleRule{
+				{ID: "cleanup", Status: "Paused", AbortIncompleteMultipartUpload: &BucketLifecycleRuleAbortIncompleteMultipartUpload{DaysAfterInitiation: int64Ptr(0)}},
+				{ID: "cleanup", Transitions: []BucketLifecycleRuleTransition{{Days: int64Ptr(30)}}},
+				{ID: "noop"},
+			}},
+			want: []string{
+				"rules[0].status",
+				"rules[0].abortIncompleteMultipartUpload.daysAfterInitiation",
+				"rules[1].transitions[0].storageClass",
+				"rules[1].id",
+				"rules[2].expiration",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package objectstorage
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllBucketsFunc, if set, handles calls to AllBuckets.
+	AllBucketsFunc func(ctx context.Context) iter.Seq2[ObjectStorageBucket, error]
+	// CreateBucketFunc, if set, handles calls to CreateBucket.
+	CreateBucketFunc func(ctx context.Context, create CreateBucketRequest) (*ObjectStorageBucket, error)
+	// DeleteBucketFunc, if set, handles calls to DeleteBucket.
+	DeleteBucketFunc func(ctx context.Context, bucketName string) error
+	// DeleteBucketLifecycleFunc, if set, handles calls to DeleteBucketLifecycle.
+	DeleteBucketLifecycleFunc func(ctx context.Context, bucketName string) error
+	// GetBucketFunc, if set, handles calls to GetBucket.
+	GetBucketFunc func(ctx context.Context, bucketName string) (*ObjectStorageBucket, error)
+	// GetBucketLifecycleFunc, if set, handles calls to GetBucketLifecycle.
+	GetBucketLifecycleFunc func(ctx context.Context, bucketName string) (*BucketLifecycle, error)
+	// ListBucketsFunc, if set, handles calls to ListBuckets.
+	ListBucketsFunc func(ctx context.Context) ([]ObjectStorageBucket, error)
+	// SetBucketLifecycleFunc, if set, handles calls to SetBucketLifecycle.
+	SetBucketLifecycleFunc func(ctx context.Context, bucketName string, set SetBucketLifecycleRequest) (*BucketLifecycle, error)
+	// UpdateBucketFunc, if set, handles calls to UpdateBucket.
+	UpdateBucketFunc func(ctx context.Context, bucketName string, update UpdateBucketRequest) (*ObjectStorageBucket, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllBuckets records the call and invokes AllBucketsFunc if set.
+func (f *Fake) AllBuckets(ctx context.Context) (r0 iter.Seq2[ObjectStorageBucket, error]) {
+	f.Record("AllBuckets", ctx)
+	if f.AllBucketsFunc != nil {
+		return f.AllBucketsFunc(ctx)
+	}
+	r0 = func(yield func(ObjectStorageBucket, error) bool) {
+		if f.Err != nil {
+			var zero ObjectStorageBucket
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateBucket records the call and invokes CreateBucketFunc if set.
+func (f *Fake) CreateBucket(ctx context.Context, create CreateBucketRequest) (r0 *ObjectStorageBucket, r1 error) {
+	f.Record("CreateBucket", ctx, create)
+	if f.CreateBucketFunc != nil {
+		return f.CreateBucketFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteBucket records the call and invokes DeleteBucketFunc if set.
+func (f *Fake) DeleteBucket(ctx context.Context, bucketName string) (r0 error) {
+	f.Record("DeleteBucket", ctx, bucketName)
+	if f.DeleteBucketFunc != nil {
+		return f.DeleteBucketFunc(ctx, bucketName)
+	}
+	r0 = f.Err
+	return
+}
+
+// DeleteBucketLifecycle records the call and invokes DeleteBucketLifecycleFunc if set.
+func (f *Fake) DeleteBucketLifecycle(ctx context.Context, bucketName string) (r0 error) {
+	f.Record("DeleteBucketLifecycle", ctx, bucketName)
+	if f.DeleteBucketLifecycleFunc != nil {
+		return f.DeleteBucketLifecycleFunc(ctx, bucketName)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetBucket records the call and invokes GetBucketFunc if set.
+func (f *Fake) GetBucket(ctx context.Context, bucketName string) (r0 *ObjectStorageBucket, r1 error) {
+	f.Record("GetBucket", ctx, bucketName)
+	if f.GetBucketFunc != nil {
+		return f.GetBucketFunc(ctx, bucketName)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetBucketLifecycle records the call and invokes GetBucketLifecycleFunc if set.
+func (f *Fake) GetBucketLifecycle(ctx context.Context, bucketName string) (r0 *BucketLifecycle, r1 error) {
+	f.Record("GetBucketLifecycle", ctx, bucketName)
+	if f.GetBucketLifecycleFunc != nil {
+		return f.GetBucketLifecycleFunc(ctx, bucketName)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListBuckets records the call and invokes ListBucketsFunc if set.
+func (f *Fake) ListBuckets(ctx context.Context) (r0 []ObjectStorageBucket, r1 error) {
+	f.Record("ListBuckets", ctx)
+	if f.ListBucketsFunc != nil {
+		return f.ListBucketsFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// SetBucketLifecycle records the call and invokes SetBucketLifecycleFunc if set.
+func (f *Fake) SetBucketLifecycle(ctx context.Context, bucketName string, set SetBucketLifecycleRequest) (r0 *BucketLifecycle, r1 error) {
+	f.Record("SetBucketLifecycle", ctx, bucketName, set)
+	if f.SetBucketLifecycleFunc != nil {
+		return f.SetBucketLifecycleFunc(ctx, bucketName, set)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateBucket records the call and invokes UpdateBucketFunc if set.
+func (f *Fake) UpdateBucket(ctx context.Context, bucketName string, update UpdateBucketRequest) (r0 *ObjectStorageBucket, r1 error) {
+	f.Record("UpdateBucket", ctx, bucketName, update)
+	if f.UpdateBucketFunc != nil {
+		return f.UpdateBucketFunc(ctx, bucketName, update)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,36 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package objectstorage
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllBuckets returns an iterator over the results of ListBuckets.
+	AllBuckets(ctx context.Context) iter.Seq2[ObjectStorageBucket, error]
+
+	CreateBucket(ctx context.Context, create CreateBucketRequest) (*ObjectStorageBucket, error)
+
+	DeleteBucket(ctx context.Context, bucketName string) error
+
+	// DeleteBucketLifecycle removes all lifecycle rules from a bucket.
+	DeleteBucketLifecycle(ctx context.Context, bucketName string) error
+
+	GetBucket(ctx context.Context, bucketName string) (*ObjectStorageBucket, error)
+
+	// GetBucketLifecycle returns the lifecycle configuration for a bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName string) (*BucketLifecycle, error)
+
+	ListBuckets(ctx context.Context) ([]ObjectStorageBucket, error)
+
+	// SetBucketLifecycle replaces all lifecycle rules on a bucket.
+	SetBucketLifecycle(ctx context.Context, bucketName string, set SetBucketLifecycleRequest) (*BucketLifecycle, error)
+
+	UpdateBucket(ctx context.Context, bucketName string, update UpdateBucketRequest) (*ObjectStorageBucket, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -3,9 +3,11 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 // ListPrometheusTenants lists all Prometheus tenants for the organisation.
@@ -31,6 +33,13 @@ func (c *Client) ListPrometheusTenants(ctx context.Context, listRequest *ListPro
 	return tenants, nil
 }
 
+// AllPrometheusTenants returns an iterator over the results of ListPrometheusTenants.
+func (c *Client) AllPrometheusTenants(ctx context.Context, listRequest *ListPrometheusTenantsRequest) iter.Seq2[PrometheusTenant, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]PrometheusTenant, error) {
+		return c.ListPrometheusTenants(ctx, listRequest)
+	})
+}
+
 // GetPrometheusTenant retrieves a specific Prometheus tenant by its identity.
 func (c *Client) GetPrometheusTenant(ctx context.Context, tenantIdentity string) (*PrometheusTenant, error) {
 	if tenantIdentity == "" {
@@ -113,6 +122,39 @@ func (c *Client) DeletePrometheusTenant(ctx context.Context, tenantIdentity stri
 	return c.Check(resp)
 }
 
+// WaitUntilPrometheusTenantReady waits until the Prometheus tenant is ready.
+// It returns an error if the tenant failed or is being deleted instead.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilPrometheusTenantReady(ctx context.Context, tenantIdentity string, opts ...wait.Option) (*PrometheusTenant, error) {
+	w := wait.UntilStatus("Prometheus tenant "+tenantIdentity, c.prometheusTenantGetter(tenantIdentity), prometheusTenantStatus,
+		string(PrometheusTenantStatusReady), string(PrometheusTenantStatusFailed),
+		string(PrometheusTenantStatusDeleting), string(PrometheusTenantStatusDeleted))
+	tenant, err := w.Wait(ctx, waitOptions(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// WaitUntilPrometheusTenantDeleted waits until the Prometheus tenant is deleted.
+// The user is expected to provide a timeout context.
+func (c *Client) WaitUntilPrometheusTenantDeleted(ctx context.Context, tenantIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("Prometheus tenant "+tenantIdentity, c.prometheusTenantGetter(tenantIdentity), prometheusTenantStatus,
+		string(PrometheusTenantStatusDeleting), string(PrometheusTenantStatusDeleted), string(PrometheusTenantStatusFailed)).
+		Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) prometheusTenantGetter(identity string) func(context.Context) (*PrometheusTenant, error) {
+	return func(ctx context.Context) (*PrometheusTenant, error) {
+		return c.GetPrometheusTenant(ctx, identity)
+	}
+}
+
+func prometheusTenantStatus(tenant *PrometheusTenant) string {
+	return string(tenant.Status)
+}
+
 // ListPrometheusTenantsRequest is the request for listing Prometheus tenants.
 type ListPrometheusTenantsRequest struct {
 	Filters []filters.Filter
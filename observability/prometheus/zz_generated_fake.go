@@ -0,0 +1,127 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package prometheus
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllPrometheusTenantsFunc, if set, handles calls to AllPrometheusTenants.
+	AllPrometheusTenantsFunc func(ctx context.Context, listRequest *ListPrometheusTenantsRequest) iter.Seq2[PrometheusTenant, error]
+	// CreatePrometheusTenantFunc, if set, handles calls to CreatePrometheusTenant.
+	CreatePrometheusTenantFunc func(ctx context.Context, create CreatePrometheusTenantRequest) (*PrometheusTenant, error)
+	// DeletePrometheusTenantFunc, if set, handles calls to DeletePrometheusTenant.
+	DeletePrometheusTenantFunc func(ctx context.Context, tenantIdentity string) error
+	// GetPrometheusTenantFunc, if set, handles calls to GetPrometheusTenant.
+	GetPrometheusTenantFunc func(ctx context.Context, tenantIdentity string) (*PrometheusTenant, error)
+	// ListPrometheusTenantsFunc, if set, handles calls to ListPrometheusTenants.
+	ListPrometheusTenantsFunc func(ctx context.Context, listRequest *ListPrometheusTenantsRequest) ([]PrometheusTenant, error)
+	// UpdatePrometheusTenantFunc, if set, handles calls to UpdatePrometheusTenant.
+	UpdatePrometheusTenantFunc func(ctx context.Context, tenantIdentity string, update UpdatePrometheusTenantRequest) (*PrometheusTenant, error)
+	// WaitUntilPrometheusTenantDeletedFunc, if set, handles calls to WaitUntilPrometheusTenantDeleted.
+	WaitUntilPrometheusTenantDeletedFunc func(ctx context.Context, tenantIdentity string, opts ...wait.Option) error
+	// WaitUntilPrometheusTenantReadyFunc, if set, handles calls to WaitUntilPrometheusTenantReady.
+	WaitUntilPrometheusTenantReadyFunc func(ctx context.Context, tenantIdentity string, opts ...wait.Option) (*PrometheusTenant, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllPrometheusTenants records the call and invokes AllPrometheusTenantsFunc if set.
+func (f *Fake) AllPrometheusTenants(ctx context.Context, listRequest *ListPrometheusTenantsRequest) (r0 iter.Seq2[PrometheusTenant, error]) {
+	f.Record("AllPrometheusTenants", ctx, listRequest)
+	if f.AllPrometheusTenantsFunc != nil {
+		return f.AllPrometheusTenantsFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(PrometheusTenant, error) bool) {
+		if f.Err != nil {
+			var zero PrometheusTenant
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreatePrometheusTenant records the call and invokes CreatePrometheusTenantFunc if set.
+func (f *Fake) CreatePrometheusTenant(ctx context.Context, create CreatePrometheusTenantRequest) (r0 *PrometheusTenant, r1 error) {
+	f.Record("CreatePrometheusTenant", ctx, create)
+	if f.CreatePrometheusTenantFunc != nil {
+		return f.CreatePrometheusTenantFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeletePrometheusTenant records the call and invokes DeletePrometheusTenantFunc if set.
+func (f *Fake) DeletePrometheusTenant(ctx context.Context, tenantIdentity string) (r0 error) {
+	f.Record("DeletePrometheusTenant", ctx, tenantIdentity)
+	if f.DeletePrometheusTenantFunc != nil {
+		return f.DeletePrometheusTenantFunc(ctx, tenantIdentity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetPrometheusTenant records the call and invokes GetPrometheusTenantFunc if set.
+func (f *Fake) GetPrometheusTenant(ctx context.Context, tenantIdentity string) (r0 *PrometheusTenant, r1 error) {
+	f.Record("GetPrometheusTenant", ctx, tenantIdentity)
+	if f.GetPrometheusTenantFunc != nil {
+		return f.GetPrometheusTenantFunc(ctx, tenantIdentity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListPrometheusTenants records the call and invokes ListPrometheusTenantsFunc if set.
+func (f *Fake) ListPrometheusTenants(ctx context.Context, listRequest *ListPrometheusTenantsRequest) (r0 []PrometheusTenant, r1 error) {
+	f.Record("ListPrometheusTenants", ctx, listRequest)
+	if f.ListPrometheusTenantsFunc != nil {
+		return f.ListPrometheusTenantsFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdatePrometheusTenant records the call and invokes UpdatePrometheusTenantFunc if set.
+func (f *Fake) UpdatePrometheusTenant(ctx context.Context, tenantIdentity string, update UpdatePrometheusTenantRequest) (r0 *PrometheusTenant, r1 error) {
+	f.Record("UpdatePrometheusTenant", ctx, tenantIdentity, update)
+	if f.UpdatePrometheusTenantFunc != nil {
+		return f.UpdatePrometheusTenantFunc(ctx, tenantIdentity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilPrometheusTenantDeleted records the call and invokes WaitUntilPrometheusTenantDeletedFunc if set.
+func (f *Fake) WaitUntilPrometheusTenantDeleted(ctx context.Context, tenantIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilPrometheusTenantDeleted", ctx, tenantIdentity, opts)
+	if f.WaitUntilPrometheusTenantDeletedFunc != nil {
+		return f.WaitUntilPrometheusTenantDeletedFunc(ctx, tenantIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilPrometheusTenantReady records the call and invokes WaitUntilPrometheusTenantReadyFunc if set.
+func (f *Fake) WaitUntilPrometheusTenantReady(ctx context.Context, tenantIdentity string, opts ...wait.Option) (r0 *PrometheusTenant, r1 error) {
+	f.Record("WaitUntilPrometheusTenantReady", ctx, tenantIdentity, opts)
+	if f.WaitUntilPrometheusTenantReadyFunc != nil {
+		return f.WaitUntilPrometheusTenantReadyFunc(ctx, tenantIdentity, opts...)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,43 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package prometheus
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllPrometheusTenants returns an iterator over the results of ListPrometheusTenants.
+	AllPrometheusTenants(ctx context.Context, listRequest *ListPrometheusTenantsRequest) iter.Seq2[PrometheusTenant, error]
+
+	// CreatePrometheusTenant creates a new Prometheus tenant.
+	CreatePrometheusTenant(ctx context.Context, create CreatePrometheusTenantRequest) (*PrometheusTenant, error)
+
+	// DeletePrometheusTenant deletes a specific Prometheus tenant by its identity.
+	DeletePrometheusTenant(ctx context.Context, tenantIdentity string) error
+
+	// GetPrometheusTenant retrieves a specific Prometheus tenant by its identity.
+	GetPrometheusTenant(ctx context.Context, tenantIdentity string) (*PrometheusTenant, error)
+
+	// ListPrometheusTenants lists all Prometheus tenants for the organisation.
+	ListPrometheusTenants(ctx context.Context, listRequest *ListPrometheusTenantsRequest) ([]PrometheusTenant, error)
+
+	// UpdatePrometheusTenant updates an existing Prometheus tenant.
+	UpdatePrometheusTenant(ctx context.Context, tenantIdentity string, update UpdatePrometheusTenantRequest) (*PrometheusTenant, error)
+
+	// WaitUntilPrometheusTenantDeleted waits until the Prometheus tenant is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilPrometheusTenantDeleted(ctx context.Context, tenantIdentity string, opts ...wait.Option) error
+
+	// WaitUntilPrometheusTenantReady waits until the Prometheus tenant is ready.
+	// It returns an error if the tenant failed or is being deleted instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilPrometheusTenantReady(ctx context.Context, tenantIdentity string, opts ...wait.Option) (*PrometheusTenant, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -0,0 +1,300 @@
+// Package bulk runs an operation over many resources concurrently and reports the
+// outcome for every resource.
+//
+//	report, err := bulk.Do(ctx, machineIDs, func(ctx context.Context, id string) error {
+//		return tc.IaaS().DeleteMachine(ctx, id)
+//	}, bulk.Options{Concurrency: 10})
+//	for _, item := range report.Failed() {
+//		log.Printf("%s: %v", item.Identity, item.Err)
+//	}
+//
+// The operations share the client, so every request still waits for the client's rate
+// limiter: concurrency bounds the requests in flight, while the limiter bounds the
+// request rate.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// DefaultConcurrency is the number of operations run concurrently when
+// Options.Concurrency is not set.
+const DefaultConcurrency = 8
+
+var (
+	// ErrSkipped can be returned, optionally wrapped, by an operation to report that
+	// there was nothing to do for an item.
+	ErrSkipped = errors.New("skipped")
+	// ErrStopped is the error of items that were skipped because an earlier item
+	// failed and Options.StopOnError is set.
+	ErrStopped = errors.New("stopped after an earlier failure")
+)
+
+// Status is the outcome of an operation for one item.
+type Status string
+
+const (
+	Succeeded Status = "succeeded"
+	Failed    Status = "failed"
+	Skipped   Status = "skipped"
+)
+
+// Limiter paces the start of operations. *rate.Limiter implements it.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Options configure Run and Do.
+type Options struct {
+	// Concurrency is the maximum number of operations in flight. Defaults to
+	// DefaultConcurrency.
+	Concurrency int
+	// StopOnError stops starting operations after the first failure. Operations in
+	// flight complete, and the remaining items are skipped with ErrStopped.
+	StopOnError bool
+	// Limiter, if set, is waited on before every operation, in addition to the rate
+	// limiter of the client.
+	Limiter Limiter
+	// OnProgress is called after every item, one call at a time.
+	OnProgress func(Progress)
+}
+
+// Progress describes how far a bulk operation has come.
+type Progress struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Skipped   int
+	// Item is the item that completed last.
+	Item ItemResult[any]
+}
+
+// Completed is the number of items that have an outcome.
+func (p Progress) Completed() int {
+	return p.Succeeded + p.Failed + p.Skipped
+}
+
+// ItemResult is the outcome of an operation for one item.
+type ItemResult[T any] struct {
+	Identity string
+	Status   Status
+	// Result is the value returned by a successful operation.
+	Result T
+	// Err is the error of a failed or skipped item.
+	Err      error
+	Duration time.Duration
+}
+
+// Report holds the outcome for every item, in the order of the items.
+type Report[T any] struct {
+	Items []ItemResult[T]
+}
+
+// Succeeded returns the items that succeeded.
+func (r *Report[T]) Succeeded() []ItemResult[T] {
+	return r.filter(Succeeded)
+}
+
+// Failed returns the items that failed.
+func (r *Report[T]) Failed() []ItemResult[T] {
+	return r.filter(Failed)
+}
+
+// Skipped returns the items that were skipped.
+func (r *Report[T]) Skipped() []ItemResult[T] {
+	return r.filter(Skipped)
+}
+
+func (r *Report[T]) filter(status Status) []ItemResult[T] {
+	var items []ItemResult[T]
+	for _, item := range r.Items {
+		if item.Status == status {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Err returns an *Error listing the failed items, or nil if none failed.
+func (r *Report[T]) Err() error {
+	var bulkErr Error
+	for _, item := range r.Items {
+		if item.Status == Failed {
+			bulkErr.Failures = append(bulkErr.Failures, &ItemError{Identity: item.Identity, Err: item.Err})
+		}
+	}
+	if len(bulkErr.Failures) == 0 {
+		return nil
+	}
+	return &bulkErr
+}
+
+// ItemError is the failure of an operation for one item.
+type ItemError struct {
+	Identity string
+	Err      error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Identity, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// Error aggregates the items for which an operation failed. errors.Is and errors.As
+// match the errors of the individual items.
+type Error struct {
+	Failures []*ItemError
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		msgs[i] = failure.Error()
+	}
+	return fmt.Sprintf("%d item(s) failed: %s", len(e.Failures), strings.Join(msgs, "; "))
+}
+
+func (e *Error) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure
+	}
+	return errs
+}
+
+// Run runs op for every identity, with at most opts.Concurrency operations in flight,
+// and returns the outcome for every identity. Operations that return ErrSkipped are
+// reported as skipped. When ctx is done, operations that have not started are skipped
+// with the context's error.
+//
+// The returned error is the report's Err: an *Error if any operation failed.
+func Run[T any](ctx context.Context, identities []string, op func(ctx context.Context, identity string) (T, error), opts Options) (*Report[T], error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	report := &Report[T]{Items: make([]ItemResult[T], len(identities))}
+	progress := Progress{Total: len(identities)}
+	var mu sync.Mutex
+	stopped := false
+	complete := func(i int, item ItemResult[T]) {
+		mu.Lock()
+		defer mu.Unlock()
+		report.Items[i] = item
+		switch item.Status {
+		case Succeeded:
+			progress.Succeeded++
+		case Failed:
+			progress.Failed++
+			stopped = stopped || opts.StopOnError
+		case Skipped:
+			progress.Skipped++
+		}
+		if opts.OnProgress != nil {
+			progress.Item = ItemResult[any]{Identity: item.Identity, Status: item.Status, Result: item.Result, Err: item.Err, Duration: item.Duration}
+			opts.OnProgress(progress)
+		}
+	}
+	isStopped := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return stopped
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, identity := range identities {
+		if err := acquire(ctx, sem, opts.Limiter, isStopped); err != nil {
+			status := Failed
+			if ctx.Err() != nil || errors.Is(err, ErrStopped) {
+				status = Skipped
+			}
+			complete(i, ItemResult[T]{Identity: identity, Status: status, Err: err})
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			complete(i, runItem(ctx, identity, op))
+		}()
+	}
+	wg.Wait()
+	return report, report.Err()
+}
+
+// Do is Run for operations without a result.
+func Do(ctx context.Context, identities []string, op func(ctx context.Context, identity string) error, opts Options) (*Report[struct{}], error) {
+	return Run(ctx, identities, func(ctx context.Context, identity string) (struct{}, error) {
+		return struct{}{}, op(ctx, identity)
+	}, opts)
+}
+
+// SkipNotFound returns op, reporting items that do not exist as skipped rather than
+// failed. This makes bulk deletions with Do idempotent.
+func SkipNotFound(op func(ctx context.Context, identity string) error) func(ctx context.Context, identity string) error {
+	return func(ctx context.Context, identity string) error {
+		err := op(ctx, identity)
+		if client.IsNotFound(err) {
+			return fmt.Errorf("%w: %w", ErrSkipped, err)
+		}
+		return err
+	}
+}
+
+// acquire takes a slot from sem once the limiter allows it. It returns ErrStopped
+// without waiting for the limiter once stopped reports true.
+func acquire(ctx context.Context, sem chan struct{}, limiter Limiter, stopped func() bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if stopped() {
+		return ErrStopped
+	}
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if stopped() {
+		<-sem
+		return ErrStopped
+	}
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			<-sem
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("limiter: %w", err)
+		}
+		if stopped() {
+			<-sem
+			return ErrStopped
+		}
+	}
+	return nil
+}
+
+func runItem[T any](ctx context.Context, identity string, op func(ctx context.Context, identity string) (T, error)) ItemResult[T] {
+	start := time.Now()
+	result, err := op(ctx, identity)
+	item := ItemResult[T]{Identity: identity, Status: Succeeded, Result: result, Err: err, Duration: time.Since(start)}
+	switch {
+	case errors.Is(err, ErrSkipped):
+		item.Status = Skipped
+	case err != nil:
+		item.Status = Failed
+	}
+	return item
+}
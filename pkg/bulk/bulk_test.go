@@ -0,0 +1,172 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
+	"github.com/thalassa-cloud/client-go/thalassatest"
+)
+
+func identities(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("item-%d", i)
+	}
+	return ids
+}
+
+func TestRun(t *testing.T) {
+	errBoom := errors.New("boom")
+	op := func(ctx context.Context, identity string) (string, error) {
+		switch identity {
+		case "item-1":
+			return "", errBoom
+		case "item-2":
+			return "", fmt.Errorf("already done: %w", ErrSkipped)
+		}
+		return "done " + identity, nil
+	}
+	report, err := Run(context.Background(), identities(4), op, Options{})
+
+	var bulkErr *Error
+	require.ErrorAs(t, err, &bulkErr)
+	assert.ErrorIs(t, err, errBoom)
+	require.Len(t, bulkErr.Failures, 1)
+	assert.Equal(t, "item-1", bulkErr.Failures[0].Identity)
+
+	statuses := make([]Status, len(report.Items))
+	for i, item := range report.Items {
+		statuses[i] = item.Status
+	}
+	assert.Equal(t, []Status{Succeeded, Failed, Skipped, Succeeded}, statuses)
+	assert.Equal(t, "done item-3", report.Items[3].Result)
+	assert.Len(t, report.Succeeded(), 2)
+	assert.Len(t, report.Failed(), 1)
+	assert.Len(t, report.Skipped(), 1)
+}
+
+func TestRunConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	op := func(ctx context.Context, identity string) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		return nil
+	}
+	report, err := Do(context.Background(), identities(20), op, Options{Concurrency: 3})
+	require.NoError(t, err)
+	assert.Len(t, report.Succeeded(), 20)
+	assert.Equal(t, int32(3), maxInFlight.Load())
+}
+
+func TestRunStopOnError(t *testing.T) {
+	var calls atomic.Int32
+	op := func(ctx context.Context, identity string) error {
+		calls.Add(1)
+		if identity == "item-2" {
+			return errors.New("boom")
+		}
+		return nil
+	}
+	report, err := Do(context.Background(), identities(10), op, Options{Concurrency: 1, StopOnError: true})
+	require.Error(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Len(t, report.Succeeded(), 2)
+	assert.Len(t, report.Failed(), 1)
+	skipped := report.Skipped()
+	require.Len(t, skipped, 7)
+	assert.ErrorIs(t, skipped[0].Err, ErrStopped)
+}
+
+func TestRunStopOnErrorSkipsLimiter(t *testing.T) {
+	op := func(ctx context.Context, identity string) error {
+		return errors.New("boom")
+	}
+	start := time.Now()
+	report, err := Do(context.Background(), identities(20), op, Options{
+		Concurrency: 1,
+		StopOnError: true,
+		Limiter:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
+	})
+	require.Error(t, err)
+	assert.Len(t, report.Failed(), 1)
+	assert.Len(t, report.Skipped(), 19)
+	assert.Less(t, time.Since(start), 500*time.Millisecond, "skipped items do not wait for the limiter")
+}
+
+func TestRunCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	op := func(ctx context.Context, identity string) error {
+		if identity == "item-1" {
+			cancel()
+		}
+		return nil
+	}
+	report, err := Do(ctx, identities(5), op, Options{Concurrency: 1})
+	require.NoError(t, err)
+	assert.Len(t, report.Succeeded(), 2)
+	skipped := report.Skipped()
+	require.Len(t, skipped, 3)
+	assert.ErrorIs(t, skipped[0].Err, context.Canceled)
+}
+
+func TestRunProgressAndLimiter(t *testing.T) {
+	var mu sync.Mutex
+	var completed []int
+	start := time.Now()
+	_, err := Do(context.Background(), identities(5), func(ctx context.Context, identity string) error { return nil }, Options{
+		Limiter: rate.NewLimiter(rate.Every(10*time.Millisecond), 1),
+		OnProgress: func(p Progress) {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, 5, p.Total)
+			completed = append(completed, p.Completed())
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, completed)
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "the limiter paces the operations")
+}
+
+func TestDeleteWithFakeAPI(t *testing.T) {
+	server := thalassatest.NewServer()
+	defer server.Close()
+	c, err := thalassa.NewClient(server.ClientOptions(client.WithRateLimit(100, 2))...)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var ids []string
+	for i := range 3 {
+		vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: fmt.Sprintf("vpc-%d", i), VpcCidrs: []string{"10.0.0.0/16"}})
+		require.NoError(t, err)
+		ids = append(ids, vpc.Identity)
+	}
+	ids = append(ids, "vpc-missing")
+
+	report, err := Do(ctx, ids, SkipNotFound(c.IaaS().DeleteVpc), Options{Concurrency: 2})
+	require.NoError(t, err)
+	assert.Len(t, report.Succeeded(), 3)
+	skipped := report.Skipped()
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "vpc-missing", skipped[0].Identity)
+	assert.True(t, client.IsNotFound(skipped[0].Err))
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -53,6 +52,7 @@ func WithAuthOIDCTokenExchange(cfg OIDCTokenExchangeConfig) Option {
 		c.authType = AuthOIDCTokenExchange
 		cfgCopy := cfg
 		c.oidcTokenExchange = &cfgCopy
+		c.tokens = &tokenCache{}
 		return nil
 	}
 }
@@ -76,6 +76,7 @@ func withAuthOIDC(clientID, clientSecret, tokenURL string, insecure bool, scopes
 			Scopes:       scopes,
 		}
 		c.allowInsecureOIDC = insecure
+		c.tokens = &tokenCache{}
 		return nil
 	}
 }
@@ -83,7 +84,7 @@ func withAuthOIDC(clientID, clientSecret, tokenURL string, insecure bool, scopes
 func WithToken(token string) Option {
 	return func(c *thalassaCloudClient) error {
 		c.authType = AuthToken
-		c.oidcToken = &oauth2.Token{AccessToken: token}
+		c.tokens = &tokenCache{token: &oauth2.Token{AccessToken: token}}
 		return nil
 	}
 }
@@ -115,48 +116,36 @@ func WithAuthNone() Option {
 func (c *thalassaCloudClient) configureAuth() error {
 	switch c.authType {
 	case AuthToken:
-		if c.oidcToken == nil {
+		if c.tokens.current() == nil {
 			return ErrMissingToken
 		}
-		c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
-			if c.oidcToken == nil || !c.oidcToken.Valid() {
-				return fmt.Errorf("token is not valid")
-			}
-			req.SetAuthToken(c.oidcToken.AccessToken)
-			return nil
-		})
+		c.resty.OnBeforeRequest(c.setBearerToken)
 	case AuthOIDC:
 		if c.oidcConfig == nil {
 			return ErrMissingOIDCConfig
 		}
-		// For each request, ensure token is valid or refresh it.
-		c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
-			if c.oidcToken == nil || !c.oidcToken.Valid() {
-				ctx := req.Context()
-				if c.allowInsecureOIDC || c.rootCAs != nil {
-					ctx = context.WithValue(ctx, oauth2.HTTPClient, c.httpClientWithTLS())
-				}
-				tok, err := c.oidcConfig.Token(ctx)
-				if err != nil {
-					return fmt.Errorf("failed to fetch OIDC token: %w", err)
-				}
-				c.oidcToken = tok
-			}
-			req.SetAuthToken(c.oidcToken.AccessToken)
-			return nil
-		})
+		c.tokens.init(c.fetchOIDCToken, c.refreshSkew(), c.tokenStore,
+			TokenCacheKey("client-credentials", c.oidcConfig.TokenURL, c.oidcConfig.ClientID, strings.Join(c.oidcConfig.Scopes, " ")))
+		c.resty.OnBeforeRequest(c.setBearerToken)
 
 	case AuthOIDCTokenExchange:
 		if c.oidcTokenExchange == nil {
 			return ErrOIDCTokenExchangeConfig
 		}
-		c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
-			if err := c.ensureOIDCTokenExchange(req.Context()); err != nil {
-				return err
-			}
-			req.SetAuthToken(c.oidcToken.AccessToken)
-			return nil
-		})
+		cfg := c.oidcTokenExchange
+		c.tokens.init(c.fetchOIDCTokenExchange, c.refreshSkew(), c.tokenStore,
+			TokenCacheKey("token-exchange", cfg.TokenURL, cfg.OrganisationID, cfg.ServiceAccountID))
+		c.resty.OnBeforeRequest(c.setBearerToken)
+
+	case AuthTokenSource:
+		if c.tokenSource == nil {
+			return ErrMissingTokenSource
+		}
+		ts := c.tokenSource
+		c.tokens.init(func(context.Context) (*oauth2.Token, error) {
+			return ts.Token()
+		}, c.refreshSkew(), nil, "")
+		c.resty.OnBeforeRequest(c.setBearerToken)
 
 	case AuthPersonalAccessToken:
 		if c.personalToken == "" {
@@ -182,38 +171,32 @@ func (c *thalassaCloudClient) configureAuth() error {
 	return nil
 }
 
-func (c *thalassaCloudClient) ensureOIDCTokenExchange(ctx context.Context) error {
-	c.oidcTokenExchangeMu.Lock()
-	defer c.oidcTokenExchangeMu.Unlock()
-	if c.oidcToken != nil && c.oidcToken.Valid() {
-		return nil
-	}
-	tok, err := c.fetchOIDCTokenExchange(ctx)
+// setBearerToken is the request hook for all bearer token based authentication types.
+// It refreshes the cached token when needed.
+func (c *thalassaCloudClient) setBearerToken(_ *resty.Client, req *resty.Request) error {
+	tok, err := c.tokens.valid(req.Context())
 	if err != nil {
 		return err
 	}
-	c.oidcToken = tok
+	req.SetAuthToken(tok.AccessToken)
 	return nil
 }
 
-func (c *thalassaCloudClient) tokenExchangeHTTPClient() *http.Client {
-	if c.insecure || c.rootCAs != nil {
-		return c.httpClientWithTLS()
+func (c *thalassaCloudClient) refreshSkew() time.Duration {
+	if c.tokenRefreshSkew != nil {
+		return *c.tokenRefreshSkew
 	}
-	return http.DefaultClient
+	return DefaultTokenRefreshSkew
 }
 
-func (c *thalassaCloudClient) httpClientWithTLS() *http.Client {
-	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
-	if c.insecure {
-		tlsConfig.InsecureSkipVerify = true //nolint:gosec // dev-only, matches WithInsecure
-	}
-	if c.rootCAs != nil {
-		tlsConfig.RootCAs = c.rootCAs
-	}
-	return &http.Client{
-		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+// fetchOIDCToken fetches a new token using the OIDC client credentials flow.
+func (c *thalassaCloudClient) fetchOIDCToken(ctx context.Context) (*oauth2.Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.tokenHTTPClient(c.allowInsecureOIDC))
+	tok, err := c.oidcConfig.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC token: %w", err)
 	}
+	return tok, nil
 }
 
 func resolveOIDCSubjectToken(cfg *OIDCTokenExchangeConfig) (string, error) {
@@ -259,7 +242,7 @@ func (c *thalassaCloudClient) fetchOIDCTokenExchange(ctx context.Context) (*oaut
 		httpReq.Header.Set("User-Agent", ua)
 	}
 
-	resp, err := c.tokenExchangeHTTPClient().Do(httpReq)
+	resp, err := c.tokenHTTPClient(false).Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("OIDC token exchange: request failed: %w", err)
 	}
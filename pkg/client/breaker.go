@@ -0,0 +1,268 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"weak"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/sony/gobreaker"
+)
+
+// DefaultBreakerFailureStatusCodes are the status codes counted as failures by
+// DefaultCircuitBreakerPolicy and WithCircuitBreaker.
+var DefaultBreakerFailureStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RegionalServicePrefixes lists the path prefixes of services that take the region as
+// the next path segment. DefaultBreakerKey keeps the region in their breaker keys.
+var RegionalServicePrefixes = []string{"/v1/kms", "/v1/secrets"}
+
+// CircuitBreakerPolicy configures a set of circuit breakers keyed by request path, so
+// that a failing service or region does not block requests to the others.
+type CircuitBreakerPolicy struct {
+	// Settings configure every breaker. Name is set to the breaker key and IsSuccessful
+	// is derived from the policy. OnStateChange is still called.
+	Settings gobreaker.Settings
+	// Key maps a request path to the key of its breaker. Requests with an empty key
+	// bypass the breakers. Defaults to DefaultBreakerKey.
+	Key func(path string) string
+	// FailureStatusCodes lists the response status codes that count as failures.
+	FailureStatusCodes []int
+	// CountTimeouts counts timed out requests as failures. Other transport errors always
+	// count, cancelled requests never do.
+	CountTimeouts bool
+	// ProbeMethods restricts the requests let through while a breaker is half-open to
+	// these methods, such as GET, so a recovering service is probed with safe requests
+	// only. Other requests fail with gobreaker.ErrOpenState until the breaker closes.
+	// Empty allows any method. Settings.MaxRequests sets the number of probes.
+	ProbeMethods []string
+}
+
+// DefaultCircuitBreakerPolicy returns a policy with one breaker per service and region,
+// counting 429, 5xx responses and timeouts as failures.
+func DefaultCircuitBreakerPolicy() CircuitBreakerPolicy {
+	return CircuitBreakerPolicy{
+		Key:                DefaultBreakerKey,
+		FailureStatusCodes: slices.Clone(DefaultBreakerFailureStatusCodes),
+		CountTimeouts:      true,
+	}
+}
+
+// WithCircuitBreakerPolicy configures circuit breakers according to p, replacing a
+// breaker set with WithCircuitBreaker. Clones share the breakers.
+func WithCircuitBreakerPolicy(p CircuitBreakerPolicy) Option {
+	return func(c *thalassaCloudClient) error {
+		if p.Key == nil {
+			p.Key = DefaultBreakerKey
+		}
+		c.breaker = nil
+		c.breakerSubscribers = newBreakerSubscribers(c)
+		c.breakers = &breakerSet{policy: p, notify: c.breakerSubscribers.notify}
+		return nil
+	}
+}
+
+// DefaultBreakerKey returns the API version and service of path, such as "/v1/vpcs",
+// followed by the region for RegionalServicePrefixes, such as "/v1/kms/nl-01".
+func DefaultBreakerKey(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	n := min(2, len(segments))
+	if slices.Contains(RegionalServicePrefixes, "/"+strings.Join(segments[:n], "/")) {
+		n = min(3, len(segments))
+	}
+	return "/" + strings.Join(segments[:n], "/")
+}
+
+// BreakerStateObserver can be implemented by a RequestObserver to be notified when a
+// circuit breaker changes state. Observers of clones sharing the breaker are notified
+// as well, each once.
+type BreakerStateObserver interface {
+	BreakerStateChanged(key, from, to string)
+}
+
+// breakerSet holds the breakers of a CircuitBreakerPolicy, created on first use.
+type breakerSet struct {
+	policy CircuitBreakerPolicy
+	notify func(key string, from, to gobreaker.State)
+
+	mu       sync.Mutex
+	breakers map[string]*gobreaker.CircuitBreaker
+}
+
+func (s *breakerSet) get(path string) *gobreaker.CircuitBreaker {
+	key := s.policy.Key(path)
+	if key == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cb, ok := s.breakers[key]; ok {
+		return cb
+	}
+	st := s.policy.Settings
+	st.Name = key
+	st.IsSuccessful = s.policy.isSuccessful
+	st.OnStateChange = chainStateChange(st.OnStateChange, s.notify)
+	cb := gobreaker.NewCircuitBreaker(st)
+	if s.breakers == nil {
+		s.breakers = map[string]*gobreaker.CircuitBreaker{}
+	}
+	s.breakers[key] = cb
+	return cb
+}
+
+func chainStateChange(first func(name string, from, to gobreaker.State), second func(name string, from, to gobreaker.State)) func(name string, from, to gobreaker.State) {
+	return func(name string, from, to gobreaker.State) {
+		if first != nil {
+			first(name, from, to)
+		}
+		second(name, from, to)
+	}
+}
+
+// breakerFailure marks a response that counts as a failure for the breaker.
+type breakerFailure struct {
+	statusCode int
+}
+
+func (e *breakerFailure) Error() string {
+	return fmt.Sprintf("status code %d", e.statusCode)
+}
+
+func (p *CircuitBreakerPolicy) isSuccessful(err error) bool {
+	var failure *breakerFailure
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return true
+	case errors.As(err, &failure):
+		return false
+	case isTimeout(err):
+		return !p.CountTimeouts
+	}
+	return false
+}
+
+func (p *CircuitBreakerPolicy) isProbe(method httpMethod) bool {
+	return len(p.ProbeMethods) == 0 || slices.ContainsFunc(p.ProbeMethods, func(m string) bool {
+		return strings.EqualFold(m, string(method))
+	})
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// legacyBreakerPolicy classifies the outcomes for a breaker set with WithCircuitBreaker.
+var legacyBreakerPolicy = CircuitBreakerPolicy{
+	FailureStatusCodes: DefaultBreakerFailureStatusCodes,
+	CountTimeouts:      true,
+}
+
+// breakerFor returns the circuit breaker for path and its policy, if any.
+func (c *thalassaCloudClient) breakerFor(path string) (*gobreaker.CircuitBreaker, *CircuitBreakerPolicy) {
+	if c.breakers != nil {
+		return c.breakers.get(path), &c.breakers.policy
+	}
+	if c.breaker != nil {
+		return c.breaker, &legacyBreakerPolicy
+	}
+	return nil, nil
+}
+
+// breakerSubscribers notifies the clients sharing circuit breakers of their state
+// changes. Clients are referenced weakly, so discarded clones are not kept alive.
+type breakerSubscribers struct {
+	mu          sync.Mutex
+	subscribers []breakerSubscriber
+}
+
+type breakerSubscriber struct {
+	client weak.Pointer[thalassaCloudClient]
+	// inherited is the number of observers the client shares with its parent, which
+	// the parent notifies.
+	inherited int
+}
+
+func newBreakerSubscribers(c *thalassaCloudClient) *breakerSubscribers {
+	s := &breakerSubscribers{}
+	s.subscribe(c, 0)
+	return s
+}
+
+func (s *breakerSubscribers) subscribe(c *thalassaCloudClient, inherited int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, breakerSubscriber{client: weak.Make(c), inherited: inherited})
+}
+
+func (s *breakerSubscribers) notify(key string, from, to gobreaker.State) {
+	type target struct {
+		client    *thalassaCloudClient
+		inherited int
+	}
+	var targets []target
+	s.mu.Lock()
+	live := s.subscribers[:0]
+	for _, sub := range s.subscribers {
+		if c := sub.client.Value(); c != nil {
+			live = append(live, sub)
+			targets = append(targets, target{c, sub.inherited})
+		}
+	}
+	clear(s.subscribers[len(live):])
+	s.subscribers = live
+	s.mu.Unlock()
+
+	for _, t := range targets {
+		t.client.breakerStateChanged(t.inherited, key, from, to)
+	}
+}
+
+// breakerStateChanged notifies the observers implementing BreakerStateObserver,
+// skipping the first inherited observers.
+func (c *thalassaCloudClient) breakerStateChanged(inherited int, key string, from, to gobreaker.State) {
+	for _, o := range c.observers[inherited:] {
+		if bo, ok := o.(BreakerStateObserver); ok {
+			bo.BreakerStateChanged(key, from.String(), to.String())
+		}
+	}
+}
+
+// executeWithBreaker runs the request through cb. Responses with a failure status are
+// returned as they are, but count as failures for the breaker.
+func (c *thalassaCloudClient) executeWithBreaker(ctx context.Context, cb *gobreaker.CircuitBreaker, policy *CircuitBreakerPolicy, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
+	if cb.State() == gobreaker.StateHalfOpen && !policy.isProbe(method) {
+		return nil, fmt.Errorf("circuit breaker error for %s %s: %w", method, url, gobreaker.ErrOpenState)
+	}
+	result, err := cb.Execute(func() (any, error) {
+		resp, err := c.executeRequest(ctx, req, method, url)
+		if err == nil && slices.Contains(policy.FailureStatusCodes, resp.StatusCode()) {
+			return resp, &breakerFailure{statusCode: resp.StatusCode()}
+		}
+		return resp, err
+	})
+	var failure *breakerFailure
+	if errors.As(err, &failure) {
+		return result.(*resty.Response), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("circuit breaker error for %s %s: %w", method, url, err)
+	}
+	return result.(*resty.Response), nil
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breakerStates records breaker state changes.
+type breakerStates struct {
+	ObserverFuncs
+	mu      sync.Mutex
+	changes []string
+}
+
+func (b *breakerStates) BreakerStateChanged(key, from, to string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.changes = append(b.changes, key+": "+from+" -> "+to)
+}
+
+func (b *breakerStates) get() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.changes...)
+}
+
+func TestDefaultBreakerKey(t *testing.T) {
+	tests := map[string]string{
+		"/v1/vpcs":                          "/v1/vpcs",
+		"/v1/vpcs/vpc-1?force=true":         "/v1/vpcs",
+		"/v1/kms/nl-01/keys/key-1/rotate":   "/v1/kms/nl-01",
+		"/v1/secrets/nl-02/secret/app/db":   "/v1/secrets/nl-02",
+		"/v1/kubernetes/clusters/cluster-1": "/v1/kubernetes",
+		"/v1/kms":                           "/v1/kms",
+		"/status":                           "/status",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, DefaultBreakerKey(in), in)
+	}
+}
+
+func TestBreakerClassification(t *testing.T) {
+	p := DefaultCircuitBreakerPolicy()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "success", want: true},
+		{name: "failure status", err: &breakerFailure{statusCode: 503}, want: false},
+		{name: "cancelled", err: context.Canceled, want: true},
+		{name: "timeout", err: context.DeadlineExceeded, want: false},
+		{name: "transport error", err: errors.New("connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.isSuccessful(tt.err))
+		})
+	}
+	p.CountTimeouts = false
+	assert.True(t, p.isSuccessful(context.DeadlineExceeded), "timeouts can be ignored")
+}
+
+func TestCircuitBreakerPolicy(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		healthy bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if strings.HasPrefix(r.URL.Path, "/v1/kms/nl-01") && !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	states := &breakerStates{}
+	var finished []*RequestInfo
+	states.OnFinish = func(ctx context.Context, info *RequestInfo) { finished = append(finished, info) }
+	p := DefaultCircuitBreakerPolicy()
+	p.Settings = gobreaker.Settings{
+		Timeout:     20 * time.Millisecond,
+		ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 2 },
+	}
+	p.ProbeMethods = []string{"GET"}
+	c, err := NewClient(WithBaseURL(server.URL), WithCircuitBreakerPolicy(p), WithRequestObserver(states))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for range 2 {
+		resp, err := c.Do(ctx, c.R(), GET, "/v1/kms/nl-01/keys")
+		require.NoError(t, err, "failure responses are returned to the caller")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+	}
+	_, err = c.Do(ctx, c.R(), GET, "/v1/kms/nl-01/keys")
+	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+	assert.Equal(t, "/v1/kms/nl-01", finished[2].BreakerKey)
+	assert.Equal(t, "open", finished[2].BreakerState)
+
+	// Other regions and services are not affected.
+	_, err = c.Do(ctx, c.R(), GET, "/v1/kms/nl-02/keys")
+	require.NoError(t, err)
+	_, err = c.Do(ctx, c.R(), POST, "/v1/vpcs")
+	require.NoError(t, err)
+	assert.Equal(t, "closed", finished[4].BreakerState)
+
+	// Once half-open, only probe methods are let through.
+	mu.Lock()
+	healthy = true
+	mu.Unlock()
+	time.Sleep(30 * time.Millisecond)
+	_, err = c.Do(ctx, c.R(), POST, "/v1/kms/nl-01/keys")
+	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+	_, err = c.Do(ctx, c.R(), GET, "/v1/kms/nl-01/keys")
+	require.NoError(t, err)
+	_, err = c.Do(ctx, c.R(), POST, "/v1/kms/nl-01/keys")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/v1/kms/nl-01: closed -> open",
+		"/v1/kms/nl-01: open -> half-open",
+		"/v1/kms/nl-01: half-open -> closed",
+	}, states.get())
+}
+
+func TestCircuitBreakerNotifiesClones(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	p := DefaultCircuitBreakerPolicy()
+	p.Settings = gobreaker.Settings{
+		ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 1 },
+	}
+	tests := []struct {
+		name   string
+		option Option
+	}{
+		{name: "policy", option: WithCircuitBreakerPolicy(p)},
+		{name: "single breaker", option: WithCircuitBreaker("api", p.Settings)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentStates, cloneStates := &breakerStates{}, &breakerStates{}
+			parent, err := NewClient(WithBaseURL(server.URL), tt.option, WithRequestObserver(parentStates))
+			require.NoError(t, err)
+			clone, err := parent.Clone(WithRequestObserver(cloneStates))
+			require.NoError(t, err)
+
+			_, err = clone.Do(context.Background(), clone.R(), GET, "/v1/vpcs")
+			require.NoError(t, err)
+
+			assert.Len(t, parentStates.get(), 1, "observers shared with the parent are notified once")
+			assert.Len(t, cloneStates.get(), 1)
+			assert.Equal(t, parentStates.get(), cloneStates.get())
+			runtime.KeepAlive(parent)
+		})
+	}
+}
+
+func TestCircuitBreakerCountsFailureStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(WithBaseURL(server.URL), WithCircuitBreaker("api", gobreaker.Settings{
+		ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 3 },
+	}))
+	require.NoError(t, err)
+	for range 3 {
+		_, err = c.Do(context.Background(), c.R(), GET, "/v1/vpcs")
+		require.NoError(t, err)
+	}
+	_, err = c.Do(context.Background(), c.R(), GET, "/v1/vpcs")
+	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+
+	clone, err := c.Clone()
+	require.NoError(t, err)
+	_, err = clone.Do(context.Background(), clone.R(), GET, "/v1/vpcs")
+	assert.ErrorIs(t, err, gobreaker.ErrOpenState, "clones share the breaker")
+}
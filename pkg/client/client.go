@@ -2,12 +2,16 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/gorilla/websocket"
@@ -46,6 +50,7 @@ const (
 	AuthBasic
 	AuthCustom
 	AuthOIDCTokenExchange
+	AuthTokenSource
 )
 
 func IsNotFound(err error) bool {
@@ -62,8 +67,16 @@ type Client interface {
 
 	R() *resty.Request
 
+	// WithOptions applies opts to the client in place. It must not be called while the
+	// client is in use by other goroutines; use Clone to derive a client instead.
+	// Options that fail are skipped; use ApplyOptions or Clone to handle their errors.
 	WithOptions(opts ...Option) Client
 
+	// Clone returns a new client with the same configuration, with opts applied on top.
+	// The clone shares the parent's token cache, rate limiter and circuit breaker, but
+	// changes to either client do not affect the other.
+	Clone(opts ...Option) (Client, error)
+
 	// GetOrganisationIdentity returns the organisation identity for the client, if set
 	GetOrganisationIdentity() string
 
@@ -79,6 +92,10 @@ type Client interface {
 	// DialWebsocket creates a websocket connection to the specified URL
 	DialWebsocket(ctx context.Context, wsURL string) (*websocket.Conn, error)
 
+	// DialWebsocketSession opens a websocket session to the specified URL, with
+	// keepalive and optional reconnects.
+	DialWebsocketSession(ctx context.Context, wsURL string, opts WebsocketOptions) (*WebsocketSession, error)
+
 	// RawRequest performs an HTTP request using the client's base URL, authentication,
 	// and configuration (rate limiting, organisation/project headers, etc.).
 	// method is the HTTP method (GET, POST, PUT, PATCH, DELETE). path is the request path
@@ -90,16 +107,47 @@ type Client interface {
 
 // NewClient applies all options, configures authentication, and returns the client.
 func NewClient(opts ...Option) (Client, error) {
+	return newClient(opts, nil)
+}
+
+// newClient applies opts to a fresh client. When parent is set, the clone shares the
+// parent's token cache, rate limiter and circuit breaker unless opts replace them.
+func newClient(opts []Option, parent *thalassaCloudClient) (*thalassaCloudClient, error) {
 	c := &thalassaCloudClient{
 		resty:     resty.New(),
 		userAgent: DefaultUserAgent,
+		tokens:    &tokenCache{},
+	}
+	c.restyTransport = c.resty.GetClient().Transport
+
+	var (
+		parentOpts         []Option
+		inheritedObservers int
+	)
+	if parent != nil {
+		parentOpts = parent.options()
+		for _, opt := range parentOpts {
+			if err := opt(c); err != nil {
+				return nil, err
+			}
+		}
+		c.tokens = parent.tokens
+		c.limiter = parent.limiter
+		c.rateLimits = parent.rateLimits
+		c.breaker = parent.breaker
+		c.breakers = parent.breakers
+		c.breakerSubscribers = parent.breakerSubscribers
+		inheritedObservers = len(c.observers)
 	}
-
 	for _, opt := range opts {
 		if err := opt(c); err != nil {
 			return nil, err
 		}
 	}
+	c.opts = append(parentOpts, opts...)
+	if err := c.configureTransport(); err != nil {
+		return nil, err
+	}
 	if c.resty.BaseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
 	}
@@ -109,6 +157,9 @@ func NewClient(opts ...Option) (Client, error) {
 		return nil, err
 	}
 
+	if parent != nil && c.breakerSubscribers != nil && c.breakerSubscribers == parent.breakerSubscribers {
+		c.breakerSubscribers.subscribe(c, inheritedObservers)
+	}
 	return c, nil
 }
 
@@ -119,6 +170,11 @@ type thalassaCloudClient struct {
 	baseURL   string
 	userAgent string
 
+	// opts are the options the client was built with, replayed by Clone.
+	opts []Option
+
+	// mu guards the organisation and project identities, which SetOrganisation may change.
+	mu                   sync.RWMutex
 	organisationIdentity *string
 	projectIdentity      *string
 
@@ -127,12 +183,20 @@ type thalassaCloudClient struct {
 
 	// OIDC (client credentials).
 	oidcConfig        *clientcredentials.Config
-	oidcToken         *oauth2.Token // cached token
 	allowInsecureOIDC bool
 
 	// OIDC token exchange (RFC 8693-style) for IdP JWT → Thalassa bearer token.
-	oidcTokenExchange   *OIDCTokenExchangeConfig
-	oidcTokenExchangeMu sync.Mutex
+	oidcTokenExchange *OIDCTokenExchangeConfig
+
+	// Custom token source (AuthTokenSource).
+	tokenSource      oauth2.TokenSource
+	tokenRefreshSkew *time.Duration
+
+	// Optional persistent token store for the OIDC flows.
+	tokenStore TokenStore
+
+	// Cached access token for AuthToken, AuthOIDC, AuthOIDCTokenExchange and AuthTokenSource.
+	tokens *tokenCache
 
 	// Personal Access Token.
 	personalToken string
@@ -143,19 +207,97 @@ type thalassaCloudClient struct {
 
 	// Rate limiting.
 	limiter *rate.Limiter
+	// Adaptive rate limiting; replaces limiter when set.
+	rateLimits *rateLimits
 
 	// Optional circuit breaker
 	breaker *gobreaker.CircuitBreaker
-
-	insecure bool
-	rootCAs  *x509.CertPool
+	// Circuit breakers per service and region; replaces breaker when set.
+	breakers *breakerSet
+	// Clients notified of state changes of breaker or breakers.
+	breakerSubscribers *breakerSubscribers
+
+	// Retry policy; nil disables retries.
+	retryPolicy *RetryPolicy
+
+	// Generate Idempotency-Key headers for POST requests.
+	autoIdempotencyKeys bool
+
+	// Plan capturing mutating requests in dry-run mode; nil sends them.
+	dryRun *Plan
+
+	// Validate request bodies implementing Validator before sending them.
+	validateRequests bool
+
+	// Observers notified of every request.
+	observers []RequestObserver
+
+	// Transport options, shared by API requests, token requests and websocket dials.
+	httpClient         *http.Client
+	transport          http.RoundTripper
+	insecure           bool
+	rootCAs            *x509.CertPool
+	clientCertificates []tls.Certificate
+	proxy              func(*http.Request) (*url.URL, error)
+	dialContext        func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// Wrappers of the HTTP transport, applied around baseTransport.
+	transportWrappers []func(http.RoundTripper) http.RoundTripper
+	baseTransport     http.RoundTripper
+	// restyTransport is the transport resty was created with, used when no transport
+	// is configured.
+	restyTransport http.RoundTripper
 }
 
 func (c *thalassaCloudClient) WithOptions(opts ...Option) Client {
+	_ = c.applyOptions(opts)
+	return c
+}
+
+// ApplyOptions applies opts to c in place like WithOptions, but returns the errors
+// of the options that could not be applied instead of ignoring them.
+func ApplyOptions(c Client, opts ...Option) error {
+	if tc, ok := c.(*thalassaCloudClient); ok {
+		return tc.applyOptions(opts)
+	}
+	c.WithOptions(opts...)
+	return nil
+}
+
+// applyOptions applies opts, skipping those that fail, and returns their errors.
+func (c *thalassaCloudClient) applyOptions(opts []Option) error {
+	if len(opts) == 0 {
+		return nil
+	}
+	var errs []error
 	for _, opt := range opts {
-		opt(c)
+		if err := opt(c); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return c
+	if err := c.configureTransport(); err != nil {
+		errs = append(errs, err)
+	}
+	c.mu.Lock()
+	c.opts = append(c.opts, opts...)
+	c.mu.Unlock()
+	return errors.Join(errs...)
+}
+
+func (c *thalassaCloudClient) Clone(opts ...Option) (Client, error) {
+	return newClient(opts, c)
+}
+
+// options returns the options needed to rebuild the client, including the
+// current organisation.
+func (c *thalassaCloudClient) options() []Option {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	opts := slices.Clone(c.opts)
+	if c.organisationIdentity != nil {
+		opts = append(opts, WithOrganisation(*c.organisationIdentity))
+	}
+	return opts
 }
 
 func (c *thalassaCloudClient) R() *resty.Request {
@@ -163,21 +305,34 @@ func (c *thalassaCloudClient) R() *resty.Request {
 }
 
 func (c *thalassaCloudClient) GetOrganisationIdentity() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	if c.organisationIdentity != nil {
 		return *c.organisationIdentity
 	}
 	return ""
 }
 
+func (c *thalassaCloudClient) getProjectIdentity() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.projectIdentity != nil {
+		return *c.projectIdentity
+	}
+	return ""
+}
+
 func (c *thalassaCloudClient) SetOrganisation(organisation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.organisationIdentity = &organisation
 }
 
 func (c *thalassaCloudClient) GetAuthToken() string {
 	switch c.authType {
-	case AuthOIDC, AuthOIDCTokenExchange:
-		if c.oidcToken != nil && c.oidcToken.Valid() {
-			return c.oidcToken.AccessToken
+	case AuthOIDC, AuthOIDCTokenExchange, AuthTokenSource:
+		if tok := c.tokens.current(); tok != nil && tok.Valid() {
+			return tok.AccessToken
 		}
 	case AuthPersonalAccessToken:
 		return c.personalToken
@@ -185,48 +340,6 @@ func (c *thalassaCloudClient) GetAuthToken() string {
 	return ""
 }
 
-// DialWebsocket creates a websocket connection to the specified URL, with authentication
-// and organization headers from the client.
-func (c *thalassaCloudClient) DialWebsocket(ctx context.Context, wsURL string) (*websocket.Conn, error) {
-
-	wsUrlWithToken := wsURL + "?token=" + c.GetAuthToken()
-
-	// Parse the WebSocket URL
-	parsedURL, err := url.Parse(wsUrlWithToken)
-	if err != nil {
-		return nil, fmt.Errorf("invalid websocket URL: %w", err)
-	}
-
-	// Create dialer with any needed options
-	dialer := websocket.DefaultDialer
-
-	// Prepare headers
-	header := http.Header{}
-
-	// Apply authentication
-	if token := c.GetAuthToken(); token != "" {
-		header.Add("Authorization", "Token "+token)
-	}
-
-	// Apply organization identity
-	if orgIdentity := c.GetOrganisationIdentity(); orgIdentity != "" {
-		header.Add("X-Organisation-Identity", orgIdentity)
-	}
-
-	// Apply project identity if available
-	if c.projectIdentity != nil {
-		header.Add("X-Project-Identity", *c.projectIdentity)
-	}
-
-	// Connect to WebSocket
-	conn, _, err := dialer.DialContext(ctx, parsedURL.String(), header)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to websocket: %w", err)
-	}
-
-	return conn, nil
-}
-
 func (c *thalassaCloudClient) GetBaseURL() string {
 	return c.baseURL
 }
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// IfMatchHeader carries the object version a conditional request expects.
+const IfMatchHeader = "If-Match"
+
+// DefaultUpdateAttempts is the number of attempts UpdateWithRetry makes before giving up.
+const DefaultUpdateAttempts = 5
+
+// ErrVersionConflict is matched by errors for conditional requests that were rejected
+// because the object changed since it was read.
+var ErrVersionConflict = errors.New("object version conflict")
+
+// VersionConflictError is returned by Check when a conditional request was rejected
+// because the object's version no longer matches the expected version. It matches
+// both ErrVersionConflict and ErrConflict.
+type VersionConflictError struct {
+	// ExpectedVersion is the version the request expected.
+	ExpectedVersion int
+	// Err is the error response of the server.
+	Err *APIError
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("object version %d is outdated: %v", e.ExpectedVersion, e.Err)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return e.Err
+}
+
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict || target == ErrConflict
+}
+
+func IsVersionConflict(err error) bool {
+	return errors.Is(err, ErrVersionConflict)
+}
+
+// WithExpectedVersion returns a context that makes PUT, PATCH and DELETE requests
+// conditional: they are sent with an If-Match header carrying version.
+//
+// The protection relies on the server: it must compare If-Match with the object's
+// ObjectVersion and reject a mismatch with 409 Conflict or 412 Precondition Failed,
+// which Check reports as a *VersionConflictError. An endpoint that ignores If-Match
+// applies the request unconditionally.
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey, version)
+}
+
+// ExpectedVersionFromContext returns the version set with WithExpectedVersion.
+func ExpectedVersionFromContext(ctx context.Context) (int, bool) {
+	version, ok := ctx.Value(expectedVersionKey).(int)
+	return version, ok
+}
+
+// setExpectedVersion makes modifying requests conditional on the version in ctx.
+func setExpectedVersion(ctx context.Context, req *resty.Request, method httpMethod) {
+	if method != PUT && method != PATCH && method != DELETE {
+		return
+	}
+	if version, ok := ExpectedVersionFromContext(ctx); ok {
+		req.SetHeader(IfMatchHeader, strconv.Quote(strconv.Itoa(version)))
+	}
+}
+
+// versionConflict returns a *VersionConflictError if resp rejects a conditional request.
+func versionConflict(resp *resty.Response, apiErr *APIError) error {
+	if apiErr.StatusCode != http.StatusConflict && apiErr.StatusCode != http.StatusPreconditionFailed {
+		return nil
+	}
+	if resp.Request == nil {
+		return nil
+	}
+	ifMatch := resp.Request.Header.Get(IfMatchHeader)
+	if ifMatch == "" {
+		return nil
+	}
+	version, err := strconv.Atoi(strings.Trim(ifMatch, `"`))
+	if err != nil {
+		return nil
+	}
+	return &VersionConflictError{ExpectedVersion: version, Err: apiErr}
+}
+
+// ObjectVersionOf returns the ObjectVersion field of v, which must be a struct or a
+// pointer to one.
+func ObjectVersionOf(v any) (int, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return 0, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return 0, false
+	}
+	field := rv.FieldByName("ObjectVersion")
+	if !field.IsValid() || !field.CanInt() {
+		return 0, false
+	}
+	return int(field.Int()), true
+}
+
+// UpdateWithRetry performs an optimistic read-modify-write cycle. It reads the object
+// with get, applies mutate and writes it back with update, using a context that makes
+// the update conditional on the ObjectVersion that was read. When the update fails
+// with a version conflict, the cycle is repeated on a fresh copy, up to
+// DefaultUpdateAttempts times.
+//
+// T is typically a pointer such as *kubernetes.KubernetesCluster, which mutate
+// changes in place. An error from mutate aborts the cycle and is returned as is.
+// Like WithExpectedVersion, UpdateWithRetry only prevents lost updates on endpoints
+// that honour If-Match.
+//
+//	cluster, err := client.UpdateWithRetry(ctx,
+//		func(ctx context.Context) (*kubernetes.KubernetesCluster, error) {
+//			return k8s.GetKubernetesCluster(ctx, identity)
+//		},
+//		func(cluster *kubernetes.KubernetesCluster) error {
+//			if cluster.Labels == nil {
+//				cluster.Labels = map[string]string{}
+//			}
+//			cluster.Labels["team"] = "platform"
+//			return nil
+//		},
+//		func(ctx context.Context, cluster *kubernetes.KubernetesCluster) (*kubernetes.KubernetesCluster, error) {
+//			return k8s.UpdateKubernetesCluster(ctx, cluster.Identity, kubernetes.UpdateKubernetesCluster{Labels: cluster.Labels})
+//		})
+func UpdateWithRetry[T any](ctx context.Context, get func(ctx context.Context) (T, error), mutate func(T) error, update func(ctx context.Context, current T) (T, error)) (T, error) {
+	var zero T
+	var lastErr error
+	for attempt := 0; attempt < DefaultUpdateAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, time.Duration(attempt)*100*time.Millisecond); err != nil {
+				return zero, err
+			}
+		}
+		current, err := get(ctx)
+		if err != nil {
+			return zero, err
+		}
+		if err := mutate(current); err != nil {
+			return zero, err
+		}
+		updateCtx := ctx
+		if version, ok := ObjectVersionOf(current); ok {
+			updateCtx = WithExpectedVersion(ctx, version)
+		}
+		updated, err := update(updateCtx, current)
+		if err == nil {
+			return updated, nil
+		}
+		if !IsVersionConflict(err) {
+			return zero, err
+		}
+		lastErr = err
+	}
+	return zero, fmt.Errorf("giving up after %d attempts: %w", DefaultUpdateAttempts, lastErr)
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type versionedObject struct {
+	Labels        map[string]string `json:"labels"`
+	ObjectVersion int               `json:"objectVersion"`
+}
+
+// versionedServer serves a single object at /object that honours If-Match.
+type versionedServer struct {
+	mu      sync.Mutex
+	object  versionedObject
+	ifMatch []string
+	// beforeUpdate runs before an update is applied, e.g. to simulate a concurrent writer.
+	beforeUpdate func(*versionedObject)
+}
+
+func (s *versionedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(s.object)
+		return
+	}
+	ifMatch := r.Header.Get(IfMatchHeader)
+	s.ifMatch = append(s.ifMatch, ifMatch)
+	if s.beforeUpdate != nil {
+		s.beforeUpdate(&s.object)
+	}
+	if ifMatch != "" && strings.Trim(ifMatch, `"`) != strconv.Itoa(s.object.ObjectVersion) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, _ = fmt.Fprintf(w, `{"message":"object version is %d"}`, s.object.ObjectVersion)
+		return
+	}
+	var update versionedObject
+	_ = json.NewDecoder(r.Body).Decode(&update)
+	s.object.Labels = update.Labels
+	s.object.ObjectVersion++
+	_ = json.NewEncoder(w).Encode(s.object)
+}
+
+func getObject(c Client) func(ctx context.Context) (*versionedObject, error) {
+	return func(ctx context.Context) (*versionedObject, error) {
+		var obj *versionedObject
+		resp, err := c.Do(ctx, c.R().SetResult(&obj), GET, "/object")
+		if err != nil {
+			return nil, err
+		}
+		return obj, c.Check(resp)
+	}
+}
+
+func updateObject(c Client) func(ctx context.Context, obj *versionedObject) (*versionedObject, error) {
+	return func(ctx context.Context, obj *versionedObject) (*versionedObject, error) {
+		var updated *versionedObject
+		resp, err := c.Do(ctx, c.R().SetBody(obj).SetResult(&updated), PUT, "/object")
+		if err != nil {
+			return nil, err
+		}
+		return updated, c.Check(resp)
+	}
+}
+
+func TestConditionalUpdate(t *testing.T) {
+	server := &versionedServer{object: versionedObject{ObjectVersion: 3}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	// Unconditional updates overwrite.
+	_, err = updateObject(c)(context.Background(), &versionedObject{})
+	require.NoError(t, err)
+
+	_, err = updateObject(c)(WithExpectedVersion(context.Background(), 4), &versionedObject{})
+	require.NoError(t, err)
+
+	_, err = updateObject(c)(WithExpectedVersion(context.Background(), 4), &versionedObject{})
+	require.Error(t, err)
+	assert.True(t, IsVersionConflict(err))
+	assert.True(t, IsConflict(err))
+	var conflict *VersionConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, 4, conflict.ExpectedVersion)
+	assert.Equal(t, http.StatusPreconditionFailed, conflict.Err.StatusCode)
+	assert.Equal(t, []string{"", `"4"`, `"4"`}, server.ifMatch)
+
+	// GETs are never conditional.
+	_, err = getObject(c)(WithExpectedVersion(context.Background(), 1))
+	require.NoError(t, err)
+}
+
+func TestUpdateWithRetry(t *testing.T) {
+	server := &versionedServer{object: versionedObject{Labels: map[string]string{}, ObjectVersion: 1}}
+	concurrentWrites := 2
+	server.beforeUpdate = func(obj *versionedObject) {
+		if concurrentWrites > 0 {
+			concurrentWrites--
+			obj.Labels = map[string]string{"owner": fmt.Sprintf("other-%d", concurrentWrites)}
+			obj.ObjectVersion++
+		}
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	mutations := 0
+	updated, err := UpdateWithRetry(context.Background(), getObject(c), func(obj *versionedObject) error {
+		mutations++
+		obj.Labels["team"] = "platform"
+		return nil
+	}, updateObject(c))
+	require.NoError(t, err)
+	assert.Equal(t, 3, mutations)
+	assert.Equal(t, map[string]string{"owner": "other-0", "team": "platform"}, updated.Labels)
+	assert.Equal(t, 4, updated.ObjectVersion)
+
+	t.Run("mutate error aborts", func(t *testing.T) {
+		boom := errors.New("boom")
+		_, err := UpdateWithRetry(context.Background(), getObject(c), func(*versionedObject) error { return boom }, updateObject(c))
+		assert.ErrorIs(t, err, boom)
+	})
+
+	t.Run("gives up after repeated conflicts", func(t *testing.T) {
+		concurrentWrites = DefaultUpdateAttempts
+		_, err := UpdateWithRetry(context.Background(), getObject(c), func(*versionedObject) error { return nil }, updateObject(c))
+		assert.True(t, IsVersionConflict(err))
+	})
+}
+
+func TestObjectVersionOf(t *testing.T) {
+	type int64Version struct{ ObjectVersion int64 }
+	var nilObject *versionedObject
+
+	tests := []struct {
+		name   string
+		v      any
+		want   int
+		wantOK bool
+	}{
+		{name: "pointer", v: &versionedObject{ObjectVersion: 7}, want: 7, wantOK: true},
+		{name: "value", v: versionedObject{ObjectVersion: 2}, want: 2, wantOK: true},
+		{name: "int64 field", v: int64Version{ObjectVersion: 9}, want: 9, wantOK: true},
+		{name: "nil pointer", v: nilObject},
+		{name: "no field", v: struct{ Name string }{}},
+		{name: "not a struct", v: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ObjectVersionOf(tt.v)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package client
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewClientFromEnvironment. They override the values
+// of the selected profile.
+const (
+	EnvConfig              = "THALASSA_CONFIG"
+	EnvContext             = "THALASSA_CONTEXT"
+	EnvAPIURL              = "THALASSA_API_URL"
+	EnvOrganisation        = "THALASSA_ORGANISATION"
+	EnvProject             = "THALASSA_PROJECT"
+	EnvAuthMethod          = "THALASSA_AUTH_METHOD"
+	EnvToken               = "THALASSA_TOKEN"
+	EnvAccessToken         = "THALASSA_ACCESS_TOKEN"
+	EnvClientID            = "THALASSA_CLIENT_ID"
+	EnvClientSecret        = "THALASSA_CLIENT_SECRET"
+	EnvTokenURL            = "THALASSA_TOKEN_URL"
+	EnvSubjectToken        = "THALASSA_SUBJECT_TOKEN"
+	EnvSubjectTokenFile    = "THALASSA_SUBJECT_TOKEN_FILE"
+	EnvServiceAccountID    = "THALASSA_SERVICE_ACCOUNT_ID"
+	EnvAccessTokenLifetime = "THALASSA_ACCESS_TOKEN_LIFETIME"
+	EnvCABundle            = "THALASSA_CA_BUNDLE"
+	EnvInsecure            = "THALASSA_INSECURE"
+	EnvTimeout             = "THALASSA_TIMEOUT"
+	EnvProxy               = "THALASSA_PROXY"
+	EnvClientCertificate   = "THALASSA_CLIENT_CERTIFICATE"
+	EnvClientKey           = "THALASSA_CLIENT_KEY"
+)
+
+// AuthMethod selects how a profile authenticates.
+type AuthMethod string
+
+const (
+	AuthMethodNone AuthMethod = "none"
+	// AuthMethodPersonalAccessToken authenticates with a personal access token.
+	AuthMethodPersonalAccessToken AuthMethod = "pat"
+	// AuthMethodAccessToken authenticates with a pre-issued bearer token.
+	AuthMethodAccessToken AuthMethod = "token"
+	// AuthMethodOIDC uses the OIDC client credentials flow.
+	AuthMethodOIDC AuthMethod = "oidc"
+	// AuthMethodOIDCTokenExchange exchanges an external OIDC token for an API token.
+	AuthMethodOIDCTokenExchange AuthMethod = "token-exchange"
+)
+
+var (
+	ErrNoProfile         = errors.New("no configuration profile found")
+	ErrIncompleteProfile = errors.New("incomplete configuration profile")
+)
+
+// Config is the contents of the client configuration file, by default
+// ~/.config/thalassa/config.yaml. Like a kubeconfig it holds several named
+// contexts, one of which is the current context.
+type Config struct {
+	CurrentContext string    `yaml:"currentContext"`
+	Contexts       []Profile `yaml:"contexts"`
+}
+
+// Profile holds everything needed to construct a client for one API endpoint and organisation.
+type Profile struct {
+	Name         string      `yaml:"name"`
+	URL          string      `yaml:"url"`
+	Organisation string      `yaml:"organisation,omitempty"`
+	Project      string      `yaml:"project,omitempty"`
+	Auth         ProfileAuth `yaml:"auth"`
+	// CABundle is the path to a PEM file with additional trusted root certificates.
+	CABundle string `yaml:"caBundle,omitempty"`
+	Insecure bool   `yaml:"insecure,omitempty"`
+	// ClientCertificate and ClientKey are paths to PEM files with a client certificate
+	// for mutual TLS.
+	ClientCertificate string `yaml:"clientCertificate,omitempty"`
+	ClientKey         string `yaml:"clientKey,omitempty"`
+	// Proxy is the URL of an HTTP(S) proxy. When empty, HTTPS_PROXY and friends apply.
+	Proxy string `yaml:"proxy,omitempty"`
+	// Timeout is the request timeout, as a Go duration string (e.g. "30s").
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// ProfileAuth holds the credentials of a profile. Method may be left empty, in which
+// case it is derived from the credentials that are set.
+type ProfileAuth struct {
+	Method AuthMethod `yaml:"method,omitempty"`
+
+	// Personal access token or bearer token.
+	Token string `yaml:"token,omitempty"`
+
+	// OIDC client credentials.
+	ClientID     string   `yaml:"clientId,omitempty"`
+	ClientSecret string   `yaml:"clientSecret,omitempty"`
+	TokenURL     string   `yaml:"tokenUrl,omitempty"`
+	Scopes       []string `yaml:"scopes,omitempty"`
+
+	// OIDC token exchange. TokenURL defaults to {url}/oidc/token.
+	SubjectToken        string `yaml:"subjectToken,omitempty"`
+	SubjectTokenFile    string `yaml:"subjectTokenFile,omitempty"`
+	ServiceAccountID    string `yaml:"serviceAccountId,omitempty"`
+	AccessTokenLifetime string `yaml:"accessTokenLifetime,omitempty"`
+}
+
+// DefaultConfigPath returns the configuration file path: THALASSA_CONFIG if set,
+// otherwise thalassa/config.yaml in $XDG_CONFIG_HOME or ~/.config, on every platform.
+func DefaultConfigPath() (string, error) {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p, nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(dir) {
+		return filepath.Join(dir, "thalassa", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "thalassa", "config.yaml"), nil
+}
+
+// LoadConfig reads a configuration file.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Profile returns the named profile, or the current context when name is empty.
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		if len(c.Contexts) == 1 {
+			p := c.Contexts[0]
+			return &p, nil
+		}
+		return nil, fmt.Errorf("%w: no current context set", ErrNoProfile)
+	}
+	for _, p := range c.Contexts {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: context %q does not exist", ErrNoProfile, name)
+}
+
+// LoadProfile loads the named profile (or the current context when name is empty) from
+// the default configuration file and applies THALASSA_* environment overrides. A missing
+// configuration file is not an error as long as the environment describes a profile.
+func LoadProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvContext)
+	}
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	profile := &Profile{Name: name}
+	cfg, err := LoadConfig(path)
+	switch {
+	case err == nil:
+		if profile, err = cfg.Profile(name); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, os.ErrNotExist) && name == "":
+		// Environment only.
+	default:
+		return nil, err
+	}
+	profile.applyEnvironment()
+	return profile, nil
+}
+
+// NewClientFromProfile creates a client from the named profile. opts are applied after
+// the profile's options, so they take precedence.
+func NewClientFromProfile(name string, opts ...Option) (Client, error) {
+	profile, err := LoadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	profileOpts, err := profile.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(append(profileOpts, opts...)...)
+}
+
+// NewClientFromEnvironment creates a client from the current context of the
+// configuration file, overridden by THALASSA_* environment variables.
+func NewClientFromEnvironment(opts ...Option) (Client, error) {
+	return NewClientFromProfile("", opts...)
+}
+
+func (p *Profile) applyEnvironment() {
+	set := func(dst *string, env string) {
+		if v := os.Getenv(env); v != "" {
+			*dst = v
+		}
+	}
+	set(&p.URL, EnvAPIURL)
+	set(&p.Organisation, EnvOrganisation)
+	set(&p.Project, EnvProject)
+	set(&p.CABundle, EnvCABundle)
+	set(&p.Timeout, EnvTimeout)
+	set(&p.Proxy, EnvProxy)
+	set(&p.ClientCertificate, EnvClientCertificate)
+	set(&p.ClientKey, EnvClientKey)
+	if v, err := strconv.ParseBool(os.Getenv(EnvInsecure)); err == nil {
+		p.Insecure = v
+	}
+
+	method := string(p.Auth.Method)
+	set(&method, EnvAuthMethod)
+	p.Auth.Method = AuthMethod(method)
+	set(&p.Auth.ClientID, EnvClientID)
+	set(&p.Auth.ClientSecret, EnvClientSecret)
+	set(&p.Auth.TokenURL, EnvTokenURL)
+	set(&p.Auth.SubjectToken, EnvSubjectToken)
+	set(&p.Auth.SubjectTokenFile, EnvSubjectTokenFile)
+	set(&p.Auth.ServiceAccountID, EnvServiceAccountID)
+	set(&p.Auth.AccessTokenLifetime, EnvAccessTokenLifetime)
+	if v := os.Getenv(EnvAccessToken); v != "" {
+		p.Auth.Token = v
+		if os.Getenv(EnvAuthMethod) == "" {
+			p.Auth.Method = AuthMethodAccessToken
+		}
+	}
+	if v := os.Getenv(EnvToken); v != "" {
+		p.Auth.Token = v
+		if os.Getenv(EnvAuthMethod) == "" {
+			p.Auth.Method = AuthMethodPersonalAccessToken
+		}
+	}
+}
+
+// authMethod returns the configured method, or derives it from the credentials.
+func (p *Profile) authMethod() AuthMethod {
+	a := p.Auth
+	switch {
+	case a.Method != "":
+		return a.Method
+	case a.Token != "":
+		return AuthMethodPersonalAccessToken
+	case a.SubjectToken != "" || a.SubjectTokenFile != "":
+		return AuthMethodOIDCTokenExchange
+	case a.ClientID != "" || a.ClientSecret != "":
+		return AuthMethodOIDC
+	}
+	return AuthMethodNone
+}
+
+// Validate reports which required fields of the profile are missing.
+func (p *Profile) Validate() error {
+	var missing []string
+	if p.URL == "" {
+		missing = append(missing, "url")
+	}
+	a := p.Auth
+	switch method := p.authMethod(); method {
+	case AuthMethodNone:
+	case AuthMethodPersonalAccessToken, AuthMethodAccessToken:
+		if a.Token == "" {
+			missing = append(missing, "auth.token")
+		}
+	case AuthMethodOIDC:
+		if a.ClientID == "" {
+			missing = append(missing, "auth.clientId")
+		}
+		if a.ClientSecret == "" {
+			missing = append(missing, "auth.clientSecret")
+		}
+		if a.TokenURL == "" {
+			missing = append(missing, "auth.tokenUrl")
+		}
+	case AuthMethodOIDCTokenExchange:
+		if a.SubjectToken == "" && a.SubjectTokenFile == "" {
+			missing = append(missing, "auth.subjectToken or auth.subjectTokenFile")
+		}
+		if a.ServiceAccountID == "" {
+			missing = append(missing, "auth.serviceAccountId")
+		}
+		if p.Organisation == "" {
+			missing = append(missing, "organisation")
+		}
+	default:
+		return fmt.Errorf("%w: profile %q: unknown auth method %q", ErrIncompleteProfile, p.Name, method)
+	}
+	if p.Timeout != "" {
+		if _, err := time.ParseDuration(p.Timeout); err != nil {
+			return fmt.Errorf("%w: profile %q: invalid timeout %q: %v", ErrIncompleteProfile, p.Name, p.Timeout, err)
+		}
+	}
+	if p.ClientCertificate != "" && p.ClientKey == "" {
+		missing = append(missing, "clientKey")
+	}
+	if p.ClientKey != "" && p.ClientCertificate == "" {
+		missing = append(missing, "clientCertificate")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: profile %q: missing %s", ErrIncompleteProfile, p.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ClientOptions validates the profile and converts it to client options.
+func (p *Profile) ClientOptions() ([]Option, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	opts := []Option{WithBaseURL(p.URL)}
+	if p.Organisation != "" {
+		opts = append(opts, WithOrganisation(p.Organisation))
+	}
+	if p.Project != "" {
+		opts = append(opts, WithProject(p.Project))
+	}
+	if p.Timeout != "" {
+		d, _ := time.ParseDuration(p.Timeout)
+		opts = append(opts, WithTimeout(d))
+	}
+	if p.CABundle != "" {
+		pem, err := os.ReadFile(p.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no certificates", p.CABundle)
+		}
+		opts = append(opts, WithRootCAs(pool))
+	}
+	if p.Insecure {
+		opts = append(opts, WithInsecure())
+	}
+	if p.ClientCertificate != "" {
+		opts = append(opts, WithClientCertificateFiles(p.ClientCertificate, p.ClientKey))
+	}
+	if p.Proxy != "" {
+		opts = append(opts, WithProxy(p.Proxy))
+	}
+
+	a := p.Auth
+	switch p.authMethod() {
+	case AuthMethodPersonalAccessToken:
+		opts = append(opts, WithAuthPersonalToken(a.Token))
+	case AuthMethodAccessToken:
+		opts = append(opts, WithToken(a.Token))
+	case AuthMethodOIDC:
+		opts = append(opts, WithAuthOIDC(a.ClientID, a.ClientSecret, a.TokenURL, a.Scopes...))
+	case AuthMethodOIDCTokenExchange:
+		tokenURL := a.TokenURL
+		if tokenURL == "" {
+			tokenURL = strings.TrimRight(p.URL, "/") + "/oidc/token"
+		}
+		opts = append(opts, WithAuthOIDCTokenExchange(OIDCTokenExchangeConfig{
+			TokenURL:            tokenURL,
+			SubjectToken:        a.SubjectToken,
+			SubjectTokenFile:    a.SubjectTokenFile,
+			OrganisationID:      p.Organisation,
+			ServiceAccountID:    a.ServiceAccountID,
+			AccessTokenLifetime: a.AccessTokenLifetime,
+		}))
+	case AuthMethodNone:
+		opts = append(opts, WithAuthNone())
+	}
+	return opts, nil
+}
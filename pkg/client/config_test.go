@@ -0,0 +1,173 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+currentContext: prod
+contexts:
+  - name: prod
+    url: https://api.thalassa.cloud
+    organisation: org-prod
+    project: project-1
+    timeout: 45s
+    auth:
+      token: pat-prod
+  - name: ci
+    url: https://api.thalassa.cloud
+    organisation: org-ci
+    auth:
+      method: token-exchange
+      subjectTokenFile: /var/run/secrets/token
+      serviceAccountId: sa-1
+  - name: broken
+    auth:
+      method: oidc
+      clientId: id
+`
+
+// clearThalassaEnv makes sure the developer's own environment does not leak into tests.
+func clearThalassaEnv(t *testing.T) {
+	for _, env := range []string{EnvContext, EnvAPIURL, EnvOrganisation, EnvProject, EnvAuthMethod, EnvToken, EnvAccessToken,
+		EnvClientID, EnvClientSecret, EnvTokenURL, EnvSubjectToken, EnvSubjectTokenFile, EnvServiceAccountID,
+		EnvAccessTokenLifetime, EnvCABundle, EnvInsecure, EnvTimeout, EnvProxy, EnvClientCertificate, EnvClientKey} {
+		t.Setenv(env, "")
+	}
+}
+
+func writeTestConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o600))
+	t.Setenv(EnvConfig, path)
+	return path
+}
+
+func TestDefaultConfigPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv(EnvConfig, "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	path, err := DefaultConfigPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "thalassa", "config.yaml"), path)
+
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	path, err = DefaultConfigPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(xdg, "thalassa", "config.yaml"), path)
+
+	t.Setenv(EnvConfig, "/etc/thalassa.yaml")
+	path, err = DefaultConfigPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/thalassa.yaml", path)
+}
+
+func TestLoadConfigProfiles(t *testing.T) {
+	path := writeTestConfig(t)
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	current, err := cfg.Profile("")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", current.Name)
+	assert.Equal(t, AuthMethodPersonalAccessToken, current.authMethod())
+	require.NoError(t, current.Validate())
+
+	ci, err := cfg.Profile("ci")
+	require.NoError(t, err)
+	require.NoError(t, ci.Validate())
+
+	broken, err := cfg.Profile("broken")
+	require.NoError(t, err)
+	err = broken.Validate()
+	require.ErrorIs(t, err, ErrIncompleteProfile)
+	assert.Contains(t, err.Error(), "url")
+	assert.Contains(t, err.Error(), "auth.clientSecret")
+	assert.Contains(t, err.Error(), "auth.tokenUrl")
+
+	_, err = cfg.Profile("missing")
+	assert.ErrorIs(t, err, ErrNoProfile)
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	clearThalassaEnv(t)
+	writeTestConfig(t)
+
+	c, err := NewClientFromProfile("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.thalassa.cloud", c.GetBaseURL())
+	assert.Equal(t, "org-prod", c.GetOrganisationIdentity())
+	assert.Equal(t, "pat-prod", c.GetAuthToken())
+	impl := c.(*thalassaCloudClient)
+	assert.Equal(t, 45*time.Second, impl.resty.GetClient().Timeout)
+
+	c, err = NewClientFromProfile("ci")
+	require.NoError(t, err)
+	impl = c.(*thalassaCloudClient)
+	require.NotNil(t, impl.oidcTokenExchange)
+	assert.Equal(t, "https://api.thalassa.cloud/oidc/token", impl.oidcTokenExchange.TokenURL)
+	assert.Equal(t, "org-ci", impl.oidcTokenExchange.OrganisationID)
+
+	_, err = NewClientFromProfile("broken")
+	assert.ErrorIs(t, err, ErrIncompleteProfile)
+}
+
+func TestNewClientFromEnvironmentOverrides(t *testing.T) {
+	clearThalassaEnv(t)
+	writeTestConfig(t)
+	t.Setenv(EnvOrganisation, "org-from-env")
+	t.Setenv(EnvToken, "pat-from-env")
+
+	c, err := NewClientFromEnvironment()
+	require.NoError(t, err)
+	assert.Equal(t, "org-from-env", c.GetOrganisationIdentity())
+	assert.Equal(t, "pat-from-env", c.GetAuthToken())
+}
+
+func TestNewClientFromEnvironmentWithoutConfigFile(t *testing.T) {
+	clearThalassaEnv(t)
+	t.Setenv(EnvConfig, filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+
+	_, err := NewClientFromEnvironment()
+	require.ErrorIs(t, err, ErrIncompleteProfile)
+	assert.Contains(t, err.Error(), "url")
+
+	t.Setenv(EnvAPIURL, "https://api.example.com")
+	t.Setenv(EnvClientID, "client")
+	t.Setenv(EnvClientSecret, "secret")
+	t.Setenv(EnvTokenURL, "https://api.example.com/oidc/token")
+	c, err := NewClientFromEnvironment()
+	require.NoError(t, err)
+	assert.Equal(t, AuthOIDC, c.(*thalassaCloudClient).authType)
+}
+
+func TestProfileTransportOptions(t *testing.T) {
+	clearThalassaEnv(t)
+	t.Setenv(EnvConfig, filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	t.Setenv(EnvAPIURL, "https://api.example.com")
+	t.Setenv(EnvProxy, "http://proxy.example.com:3128")
+
+	c, err := NewClientFromEnvironment()
+	require.NoError(t, err)
+	transport := c.(*thalassaCloudClient).baseTransport.(*http.Transport)
+	proxyURL, err := transport.Proxy(httptest.NewRequest(http.MethodGet, "https://api.example.com/v1/vpcs", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "proxy.example.com:3128", proxyURL.Host)
+
+	t.Setenv(EnvClientCertificate, "client.crt")
+	_, err = NewClientFromEnvironment()
+	require.ErrorIs(t, err, ErrIncompleteProfile)
+	assert.Contains(t, err.Error(), "clientKey")
+}
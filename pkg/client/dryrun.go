@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DryRunHeader is set on the synthetic responses of requests captured in dry-run mode.
+const DryRunHeader = "X-Thalassa-Dry-Run"
+
+// PlannedRequest is a mutating request that was captured in dry-run mode instead of
+// being sent.
+type PlannedRequest struct {
+	// Method is the HTTP method.
+	Method string `json:"method"`
+	// Path is the request path relative to the base URL, including the query string.
+	Path string `json:"path"`
+	// URL is the absolute URL the request would have been sent to.
+	URL string `json:"url"`
+	// Header holds the request headers, with credentials redacted.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the JSON request body, if any.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// Plan collects the requests captured in dry-run mode. The zero value is ready to use
+// and a Plan is safe for concurrent use.
+type Plan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns the captured requests, in the order they were made.
+func (p *Plan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// Reset discards the captured requests.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = nil
+}
+
+func (p *Plan) add(r PlannedRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, r)
+}
+
+// WithDryRun puts the client in dry-run mode: POST, PUT, PATCH and DELETE requests are
+// recorded in plan instead of being sent, while GET requests still reach the API.
+// Captured requests succeed with 202 Accepted and an empty result, so the calling
+// code carries on as if the change had been accepted. Clones share the plan.
+func WithDryRun(plan *Plan) Option {
+	return func(c *thalassaCloudClient) error {
+		c.dryRun = plan
+		return nil
+	}
+}
+
+// WithRequestDryRun returns a context that captures the mutating requests made with it
+// in plan, as WithDryRun does for a whole client.
+//
+//	plan := &client.Plan{}
+//	_, err := tc.IaaS().CreateSubnet(client.WithRequestDryRun(ctx, plan), create)
+//	for _, r := range plan.Requests() {
+//		fmt.Println(r.Method, r.Path, string(r.Body))
+//	}
+func WithRequestDryRun(ctx context.Context, plan *Plan) context.Context {
+	return context.WithValue(ctx, dryRunKey, plan)
+}
+
+// planFor returns the plan capturing requests made with ctx, if dry-run mode is active.
+func (c *thalassaCloudClient) planFor(ctx context.Context) *Plan {
+	if plan, ok := ctx.Value(dryRunKey).(*Plan); ok && plan != nil {
+		return plan
+	}
+	return c.dryRun
+}
+
+// capture records req in plan and returns a synthetic 202 Accepted response for it.
+func (c *thalassaCloudClient) capture(plan *Plan, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
+	path := url
+	if query := req.QueryParam.Encode(); query != "" {
+		path += "?" + query
+	}
+	header := http.Header{}
+	for k, v := range c.resty.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	for k, v := range req.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	if header.Get("Authorization") == "" && c.authType != AuthNone && c.authType != AuthCustom {
+		header.Set("Authorization", redactedValue)
+	}
+	body, err := plannedBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil && header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/json")
+	}
+	plan.add(PlannedRequest{
+		Method: string(method),
+		Path:   path,
+		URL:    strings.TrimSuffix(c.resty.BaseURL, "/") + path,
+		Header: RedactHeaders(header),
+		Body:   body,
+	})
+
+	emptyResult(req.Result)
+	return &resty.Response{
+		Request: req,
+		RawResponse: &http.Response{
+			Status:     "202 Accepted",
+			StatusCode: http.StatusAccepted,
+			Header:     http.Header{DryRunHeader: []string{"true"}},
+			Body:       http.NoBody,
+			Request:    req.RawRequest,
+		},
+	}, nil
+}
+
+// plannedBody encodes a request body as JSON. Bodies that are already encoded are
+// kept as they are.
+func plannedBody(body any) (json.RawMessage, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		if json.Valid(b) {
+			return append(json.RawMessage(nil), b...), nil
+		}
+		return json.Marshal(string(b))
+	case string:
+		if json.Valid([]byte(b)) {
+			return json.RawMessage(b), nil
+		}
+		return json.Marshal(b)
+	}
+	return json.Marshal(body)
+}
+
+// emptyResult points a nil result pointer, as set by SetResult(&obj) for a pointer obj,
+// at a zero value, so callers of a captured create or update get an empty object
+// rather than nil.
+func emptyResult(result any) {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return
+	}
+	target := rv.Elem()
+	if target.Kind() == reflect.Pointer && target.IsNil() && target.CanSet() {
+		target.Set(reflect.New(target.Type().Elem()))
+	}
+}
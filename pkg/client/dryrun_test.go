@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type plannedObject struct {
+	Identity string `json:"identity,omitempty"`
+	Name     string `json:"name"`
+}
+
+func TestDryRun(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		methods []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"identity":"obj-1","name":"existing"}`))
+	}))
+	defer server.Close()
+
+	sent := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		m := methods
+		methods = nil
+		return m
+	}
+
+	t.Run("per client", func(t *testing.T) {
+		plan := &Plan{}
+		c, err := NewClient(
+			WithBaseURL(server.URL),
+			WithAuthPersonalToken("secret"),
+			WithOrganisation("org-1"),
+			WithDryRun(plan),
+		)
+		require.NoError(t, err)
+		ctx := context.Background()
+
+		var got *plannedObject
+		resp, err := c.Do(ctx, c.R().SetResult(&got), GET, "/v1/objects/obj-1")
+		require.NoError(t, err)
+		require.NoError(t, c.Check(resp))
+		assert.Equal(t, "existing", got.Name, "GETs reach the API")
+
+		var created *plannedObject
+		req := c.R().SetBody(plannedObject{Name: "new"}).SetResult(&created).SetQueryParam("force", "true")
+		resp, err = c.Do(ctx, req, POST, "/v1/objects")
+		require.NoError(t, err)
+		require.NoError(t, c.Check(resp))
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode())
+		assert.Equal(t, "true", resp.Header().Get(DryRunHeader))
+		require.NotNil(t, created, "captured creates return an empty object")
+		assert.Empty(t, created.Identity)
+
+		resp, err = c.Do(ctx, c.R(), DELETE, "/v1/objects/obj-1")
+		require.NoError(t, err)
+		require.NoError(t, c.Check(resp))
+		assert.Equal(t, []string{http.MethodGet}, sent())
+
+		requests := plan.Requests()
+		require.Len(t, requests, 2)
+		create := requests[0]
+		assert.Equal(t, "POST", create.Method)
+		assert.Equal(t, "/v1/objects?force=true", create.Path)
+		assert.Equal(t, server.URL+"/v1/objects?force=true", create.URL)
+		assert.JSONEq(t, `{"name":"new"}`, string(create.Body))
+		assert.Equal(t, redactedValue, create.Header.Get("Authorization"))
+		assert.Equal(t, "org-1", create.Header.Get("X-Organisation-Identity"))
+		assert.Equal(t, "application/json", create.Header.Get("Content-Type"))
+		assert.Equal(t, PlannedRequest{Method: "DELETE", Path: "/v1/objects/obj-1", URL: server.URL + "/v1/objects/obj-1", Header: requests[1].Header}, requests[1])
+
+		plan.Reset()
+		assert.Empty(t, plan.Requests())
+	})
+
+	t.Run("per context", func(t *testing.T) {
+		c, err := NewClient(WithBaseURL(server.URL), WithAuthNone())
+		require.NoError(t, err)
+		plan := &Plan{}
+		ctx := WithRequestDryRun(context.Background(), plan)
+
+		_, err = c.RawRequest(ctx, "PATCH", "/v1/objects/obj-1", []byte(`{"name":"patched"}`))
+		require.NoError(t, err)
+		_, err = c.RawRequest(context.Background(), "PATCH", "/v1/objects/obj-1", []byte(`{"name":"sent"}`))
+		require.NoError(t, err)
+		assert.Equal(t, []string{http.MethodPatch}, sent(), "only the dry-run context is captured")
+
+		requests := plan.Requests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, json.RawMessage(`{"name":"patched"}`), requests[0].Body)
+		assert.Empty(t, requests[0].Header.Get("Authorization"))
+	})
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// RequestIDHeader is the response header carrying the server-side request ID.
+const RequestIDHeader = "X-Request-Id"
+
+// FieldError describes a validation failure for a single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// APIError is returned by Check for every non-2xx response.
+// Use errors.As to access it, or one of the Is* predicates for common cases.
+type APIError struct {
+	// StatusCode is the HTTP status code returned by the server.
+	StatusCode int
+	// Message is the human readable message returned by the server, if any.
+	Message string
+	// RequestID is the server-side request ID, useful when contacting support.
+	RequestID string
+	// FieldErrors holds field-level validation errors, if the server returned any.
+	FieldErrors []FieldError
+	// Body is the raw response body.
+	Body []byte
+	// RetryAfter is the parsed Retry-After header; zero when absent.
+	RetryAfter time.Duration
+
+	// Method and URL of the request that failed.
+	Method string
+	URL    string
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "server returned status %d", e.StatusCode)
+	switch {
+	case e.Message != "":
+		sb.WriteString(": " + e.Message)
+	case len(e.Body) > 0:
+		sb.WriteString(": " + strings.TrimSpace(string(e.Body)))
+	}
+	for _, fe := range e.FieldErrors {
+		fmt.Fprintf(&sb, "; %s: %s", fe.Field, fe.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, " (request id: %s)", e.RequestID)
+	}
+	return sb.String()
+}
+
+// Is maps the status code onto the package's sentinel errors, so that
+// errors.Is(err, ErrNotFound) keeps working for typed API errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Temporary reports whether the request may succeed when retried later.
+func (e *APIError) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// AsAPIError returns the *APIError in err's chain, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
+
+// serverErrorBody is the JSON error envelope returned by the API.
+type serverErrorBody struct {
+	Message string       `json:"message"`
+	Error   string       `json:"error"`
+	Errors  []FieldError `json:"errors"`
+}
+
+// newAPIError builds an APIError from a resty response.
+func newAPIError(resp *resty.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode(),
+		Body:       resp.Body(),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL
+	}
+	if h := resp.Header(); h != nil {
+		apiErr.RequestID = h.Get(RequestIDHeader)
+		apiErr.RetryAfter = parseRetryAfter(h.Get("Retry-After"), time.Now())
+	}
+	var body serverErrorBody
+	if err := json.Unmarshal(apiErr.Body, &body); err == nil {
+		apiErr.Message = body.Message
+		if apiErr.Message == "" {
+			apiErr.Message = body.Error
+		}
+		apiErr.FieldErrors = body.Errors
+	}
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header in either delay-seconds or HTTP-date form.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
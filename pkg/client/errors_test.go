@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-123")
+		switch r.URL.Path {
+		case "/not-found":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"machine not found"}`))
+		case "/bad-request":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"invalid request","errors":[{"field":"name","message":"is required"}]}`))
+		case "/unauthorized":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		case "/conflict":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"object version mismatch"}`))
+		case "/rate-limited":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("upstream unavailable"))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(WithBaseURL(server.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	tests := []struct {
+		path       string
+		status     int
+		predicate  func(error) bool
+		sentinel   error
+		message    string
+		retryAfter time.Duration
+	}{
+		{path: "/not-found", status: http.StatusNotFound, predicate: IsNotFound, sentinel: ErrNotFound, message: "machine not found"},
+		{path: "/bad-request", status: http.StatusBadRequest, predicate: IsBadRequest, sentinel: ErrBadRequest, message: "invalid request"},
+		{path: "/unauthorized", status: http.StatusUnauthorized, predicate: IsUnauthorized, sentinel: ErrUnauthorized},
+		{path: "/forbidden", status: http.StatusForbidden, predicate: IsForbidden, sentinel: ErrForbidden},
+		{path: "/conflict", status: http.StatusConflict, predicate: IsConflict, sentinel: ErrConflict, message: "object version mismatch"},
+		{path: "/rate-limited", status: http.StatusTooManyRequests, predicate: IsRateLimited, sentinel: ErrRateLimited, retryAfter: 7 * time.Second},
+		{path: "/unavailable", status: http.StatusServiceUnavailable, predicate: IsServerError, sentinel: ErrServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, err := c.Do(context.Background(), c.R(), GET, tt.path)
+			require.NoError(t, err)
+
+			err = c.Check(resp)
+			require.Error(t, err)
+			assert.True(t, tt.predicate(err))
+			assert.ErrorIs(t, err, tt.sentinel)
+
+			// Wrapping must not hide the typed error.
+			wrapped := fmt.Errorf("wrapped: %w", err)
+			apiErr, ok := AsAPIError(wrapped)
+			require.True(t, ok)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.message, apiErr.Message)
+			assert.Equal(t, "req-123", apiErr.RequestID)
+			assert.Equal(t, tt.retryAfter, apiErr.RetryAfter)
+			assert.Equal(t, "GET", apiErr.Method)
+		})
+	}
+}
+
+func TestAPIErrorFieldErrors(t *testing.T) {
+	apiErr := &APIError{
+		StatusCode:  http.StatusBadRequest,
+		Message:     "invalid request",
+		FieldErrors: []FieldError{{Field: "name", Message: "is required"}},
+		RequestID:   "abc",
+	}
+	assert.Equal(t, "server returned status 400: invalid request; name: is required (request id: abc)", apiErr.Error())
+	assert.False(t, errors.Is(apiErr, ErrNotFound))
+	assert.True(t, errors.Is(apiErr, ErrBadRequest))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("garbage", now))
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package client
+
+import "os"
+
+// File locking is not supported on this platform; concurrent processes may
+// occasionally fetch a token each, which is harmless.
+func lockFile(*os.File) error { return nil }
+
+func unlockFile(*os.File) error { return nil }
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// IdempotencyKeyHeader carries the idempotency key of a request. Requests with an
+// idempotency key are safe to replay, even for unsafe methods such as POST.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey returns a context that sends key as the Idempotency-Key header on
+// POST requests made with it. Reuse the same key when retrying a logical operation,
+// such as a create, so the server can deduplicate it.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set with WithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyKey).(string)
+	return key, ok && key != ""
+}
+
+// WithAutoIdempotencyKeys generates an idempotency key for every POST request that
+// does not already carry one. The key is generated once per call and reused for
+// all of its retries.
+func WithAutoIdempotencyKeys() Option {
+	return func(c *thalassaCloudClient) error {
+		c.autoIdempotencyKeys = true
+		return nil
+	}
+}
+
+// NewIdempotencyKey returns a random (version 4) UUID suitable as an idempotency key.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// setIdempotencyKey attaches an idempotency key to POST requests, taken from the
+// context or generated when automatic keys are enabled.
+func (c *thalassaCloudClient) setIdempotencyKey(ctx context.Context, req *resty.Request, method httpMethod) {
+	if method != POST || req.Header.Get(IdempotencyKeyHeader) != "" {
+		return
+	}
+	if key, ok := IdempotencyKeyFromContext(ctx); ok {
+		req.SetHeader(IdempotencyKeyHeader, key)
+		return
+	}
+	if c.autoIdempotencyKeys {
+		req.SetHeader(IdempotencyKeyHeader, NewIdempotencyKey())
+	}
+}
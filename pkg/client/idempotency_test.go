@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		n := len(keys)
+		mu.Unlock()
+		if r.URL.Path == "/flaky" && n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	reset := func() {
+		mu.Lock()
+		keys = nil
+		mu.Unlock()
+	}
+
+	t.Run("no key by default", func(t *testing.T) {
+		reset()
+		c, err := NewClient(WithBaseURL(server.URL))
+		require.NoError(t, err)
+		_, err = c.Do(context.Background(), c.R(), POST, "/create")
+		require.NoError(t, err)
+		assert.Equal(t, []string{""}, keys)
+	})
+
+	t.Run("key from context", func(t *testing.T) {
+		reset()
+		c, err := NewClient(WithBaseURL(server.URL))
+		require.NoError(t, err)
+		ctx := WithIdempotencyKey(context.Background(), "create-vpc-1")
+		_, err = c.Do(ctx, c.R(), POST, "/create")
+		require.NoError(t, err)
+		// GETs never carry a key.
+		_, err = c.Do(ctx, c.R(), GET, "/get")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"create-vpc-1", ""}, keys)
+	})
+
+	t.Run("generated key reused across retries", func(t *testing.T) {
+		reset()
+		c, err := NewClient(
+			WithBaseURL(server.URL),
+			WithAutoIdempotencyKeys(),
+			WithRetryPolicy(fastRetryPolicy(3)),
+		)
+		require.NoError(t, err)
+		resp, err := c.Do(context.Background(), c.R(), POST, "/flaky")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, keys, 3)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+		assert.Equal(t, keys[0], keys[2])
+
+		// A new logical call gets a new key.
+		_, err = c.Do(context.Background(), c.R(), POST, "/create")
+		require.NoError(t, err)
+		assert.NotEqual(t, keys[0], keys[3])
+	})
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	key := NewIdempotencyKey()
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, key)
+	assert.NotEqual(t, key, NewIdempotencyKey())
+}
@@ -0,0 +1,145 @@
+package login
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+
+	"golang.org/x/oauth2"
+)
+
+// CallbackPath is the path of the loopback redirect URL.
+const CallbackPath = "/callback"
+
+var ErrStateMismatch = errors.New("login: state parameter mismatch")
+
+// BrowserOptions configures BrowserLogin.
+type BrowserOptions struct {
+	// Open opens the authorization URL for the user. Defaults to OpenBrowser.
+	Open func(url string) error
+	// ListenAddr is the loopback address of the redirect listener. Defaults to
+	// "127.0.0.1:0", a random free port; set a fixed port when the authorization
+	// server requires exact redirect URLs.
+	ListenAddr string
+	// SuccessHTML is shown in the browser after the redirect.
+	SuccessHTML string
+}
+
+const defaultSuccessHTML = `<!DOCTYPE html><html><body><p>Login complete. You can close this window.</p></body></html>`
+
+// BrowserLogin runs the authorization code grant with PKCE on a loopback redirect
+// (RFC 8252). It starts a local listener, opens the authorization URL in the browser
+// and exchanges the returned code for a token. The returned token source keeps
+// refreshing after ctx is done.
+func (c *Config) BrowserLogin(ctx context.Context, opts BrowserOptions) (oauth2.TokenSource, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if c.Endpoint.AuthURL == "" {
+		return nil, fmt.Errorf("%w: authorization URL", ErrMissingEndpoint)
+	}
+	if opts.Open == nil {
+		opts.Open = OpenBrowser
+	}
+	if opts.ListenAddr == "" {
+		opts.ListenAddr = "127.0.0.1:0"
+	}
+	if opts.SuccessHTML == "" {
+		opts.SuccessHTML = defaultSuccessHTML
+	}
+
+	listener, err := net.Listen("tcp", opts.ListenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("login: listen for redirect: %w", err)
+	}
+	defer listener.Close()
+
+	conf := c.oauth2Config(fmt.Sprintf("http://%s%s", listener.Addr().String(), CallbackPath))
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(CallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+		switch {
+		case q.Get("state") != state:
+			res.err = ErrStateMismatch
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("login: authorization failed: %s %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			res.err = errors.New("login: redirect is missing the authorization code")
+		default:
+			res.code = q.Get("code")
+		}
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(opts.SuccessHTML))
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	authURL := conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+	if err := opts.Open(authURL); err != nil {
+		return nil, fmt.Errorf("login: open browser: %w", err)
+	}
+
+	var res result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-results:
+	}
+	if res.err != nil {
+		return nil, res.err
+	}
+	tok, err := conf.Exchange(c.context(ctx), res.code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("login: exchange authorization code: %w", err)
+	}
+	return c.TokenSourceFromToken(ctx, tok)
+}
+
+// OpenBrowser opens url in the user's default browser.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("login: generate state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
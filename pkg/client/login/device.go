@@ -0,0 +1,53 @@
+package login
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"golang.org/x/oauth2"
+)
+
+// DevicePrompt shows the user where to enter the user code of a device authorization.
+type DevicePrompt func(ctx context.Context, auth *oauth2.DeviceAuthResponse) error
+
+// PrintDeviceCode returns a DevicePrompt that writes the verification URL and user code to w.
+func PrintDeviceCode(w io.Writer) DevicePrompt {
+	return func(_ context.Context, auth *oauth2.DeviceAuthResponse) error {
+		if auth.VerificationURIComplete != "" {
+			_, err := fmt.Fprintf(w, "To log in, open %s\nand confirm the code %s\n", auth.VerificationURIComplete, auth.UserCode)
+			return err
+		}
+		_, err := fmt.Fprintf(w, "To log in, open %s\nand enter the code %s\n", auth.VerificationURI, auth.UserCode)
+		return err
+	}
+}
+
+// DeviceLogin runs the OAuth 2.0 device authorization grant (RFC 8628). It requests a
+// device code, calls prompt so the user can approve it on another device, and polls the
+// token endpoint until the login is approved, denied or expires, or ctx is done. The
+// returned token source keeps refreshing after ctx is done.
+func (c *Config) DeviceLogin(ctx context.Context, prompt DevicePrompt) (oauth2.TokenSource, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if c.Endpoint.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("%w: device authorization URL", ErrMissingEndpoint)
+	}
+	conf := c.oauth2Config("")
+	octx := c.context(ctx)
+	auth, err := conf.DeviceAuth(octx)
+	if err != nil {
+		return nil, fmt.Errorf("login: device authorization: %w", err)
+	}
+	if prompt != nil {
+		if err := prompt(ctx, auth); err != nil {
+			return nil, err
+		}
+	}
+	tok, err := conf.DeviceAccessToken(octx, auth)
+	if err != nil {
+		return nil, fmt.Errorf("login: device authorization: %w", err)
+	}
+	return c.TokenSourceFromToken(ctx, tok)
+}
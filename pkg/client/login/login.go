@@ -0,0 +1,239 @@
+// Package login implements interactive user login for the Thalassa Cloud API.
+//
+// Two flows are supported: the OAuth 2.0 device authorization grant (RFC 8628), for
+// terminals and machines without a browser, and the authorization code grant with
+// PKCE (RFC 7636) on a loopback redirect, for desktops. Both produce a refreshable
+// token source that plugs into client.WithTokenSource:
+//
+//	cfg := &login.Config{ClientID: "thalassa-cli", Endpoint: endpoint, Store: store}
+//	ts, err := cfg.TokenSource(ctx)
+//	if errors.Is(err, login.ErrNotLoggedIn) {
+//		ts, err = cfg.DeviceLogin(ctx, login.PrintDeviceCode(os.Stderr))
+//	}
+//	c, err := client.NewClient(client.WithBaseURL(apiURL), client.WithTokenSource(ts))
+//
+// When a token store is configured, tokens including the refresh token are persisted
+// after every login and refresh, so later processes can reuse the session.
+package login
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+var (
+	ErrMissingClientID = errors.New("login requires a client ID")
+	ErrMissingEndpoint = errors.New("login endpoint is missing")
+	// ErrNotLoggedIn is returned by TokenSource when no session is stored and an
+	// interactive login is required.
+	ErrNotLoggedIn = errors.New("not logged in")
+)
+
+// Config configures the login flows.
+type Config struct {
+	// ClientID is the OAuth client ID of the (public) application.
+	ClientID string
+	// ClientSecret is optional; public clients such as CLIs do not have one.
+	ClientSecret string
+	// Scopes requested during login. Include "offline_access" when the authorization
+	// server requires it to issue refresh tokens.
+	Scopes []string
+	// Endpoint holds the authorization, device authorization and token URLs.
+	// See Discover.
+	Endpoint oauth2.Endpoint
+	// HTTPClient is used for requests to the authorization server. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Store persists the session, including the refresh token. Optional.
+	Store client.TokenStore
+}
+
+func (c *Config) validate() error {
+	if strings.TrimSpace(c.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	if c.Endpoint.TokenURL == "" {
+		return ErrMissingEndpoint
+	}
+	return nil
+}
+
+func (c *Config) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     c.Endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       c.Scopes,
+	}
+}
+
+func (c *Config) context(ctx context.Context) context.Context {
+	if c.HTTPClient != nil {
+		return context.WithValue(ctx, oauth2.HTTPClient, c.HTTPClient)
+	}
+	return ctx
+}
+
+// CacheKey returns the key under which the session is persisted in Store.
+func (c *Config) CacheKey() string {
+	return client.TokenCacheKey("login", c.Endpoint.TokenURL, c.ClientID, strings.Join(c.Scopes, " "))
+}
+
+// TokenSource returns a token source for a stored session. It returns ErrNotLoggedIn
+// when no store is configured or the store holds no refresh token. Refreshes use the
+// values of ctx, such as an HTTP client, but are not cancelled with it.
+func (c *Config) TokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if c.Store == nil {
+		return nil, ErrNotLoggedIn
+	}
+	tok, err := c.Store.Load(c.CacheKey())
+	if err != nil {
+		return nil, fmt.Errorf("login: load session: %w", err)
+	}
+	if tok == nil || tok.RefreshToken == "" {
+		return nil, ErrNotLoggedIn
+	}
+	return c.newTokenSource(ctx, tok), nil
+}
+
+// TokenSourceFromToken returns a token source starting from tok, typically the
+// result of a login, and persists it in Store.
+func (c *Config) TokenSourceFromToken(ctx context.Context, tok *oauth2.Token) (oauth2.TokenSource, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, errors.New("login: token cannot be nil")
+	}
+	if err := c.store(tok); err != nil {
+		return nil, err
+	}
+	return c.newTokenSource(ctx, tok), nil
+}
+
+// Logout removes the stored session by overwriting it with an empty token.
+func (c *Config) Logout() error {
+	if c.Store == nil {
+		return nil
+	}
+	return c.Store.Store(c.CacheKey(), &oauth2.Token{})
+}
+
+func (c *Config) store(tok *oauth2.Token) error {
+	if c.Store == nil {
+		return nil
+	}
+	if err := c.Store.Store(c.CacheKey(), tok); err != nil {
+		return fmt.Errorf("login: store session: %w", err)
+	}
+	return nil
+}
+
+// newTokenSource keeps the values of ctx for refreshes but not its cancellation: ctx
+// typically bounds an interactive login, while the session outlives it.
+func (c *Config) newTokenSource(ctx context.Context, tok *oauth2.Token) *tokenSource {
+	return &tokenSource{ctx: context.WithoutCancel(ctx), cfg: c, token: tok, pending: tok.AccessToken != ""}
+}
+
+// tokenSource hands out the login token once, then refreshes on every call. The client
+// caches tokens itself and only asks for a new one when the current one is about to
+// expire or was rejected, so every call after the first needs a refresh.
+type tokenSource struct {
+	ctx context.Context
+	cfg *Config
+
+	mu      sync.Mutex
+	token   *oauth2.Token
+	pending bool
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending {
+		s.pending = false
+		if s.token.Valid() {
+			return s.token, nil
+		}
+	}
+
+	if locker, ok := s.cfg.Store.(client.TokenStoreLocker); ok {
+		// Refresh tokens may be rotated on use, so refreshes are serialised between
+		// processes and each one starts from the most recently stored session.
+		unlock, err := locker.Lock(s.cfg.CacheKey())
+		if err != nil {
+			return nil, fmt.Errorf("login: lock session: %w", err)
+		}
+		defer unlock()
+		if stored, err := s.cfg.Store.Load(s.cfg.CacheKey()); err == nil && stored != nil && stored.RefreshToken != "" &&
+			stored.RefreshToken != s.token.RefreshToken {
+			s.token = stored
+			if stored.Valid() {
+				return stored, nil
+			}
+		}
+	}
+
+	if s.token.RefreshToken == "" {
+		return nil, ErrNotLoggedIn
+	}
+	ctx := s.cfg.context(s.ctx)
+	tok, err := s.cfg.oauth2Config("").TokenSource(ctx, &oauth2.Token{RefreshToken: s.token.RefreshToken}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("login: refresh token: %w", err)
+	}
+	s.token = tok
+	if err := s.cfg.store(tok); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+// Discover reads the authorization, device authorization and token endpoints from the
+// OpenID Connect discovery document of issuer.
+func Discover(ctx context.Context, issuer string, httpClient *http.Client) (oauth2.Endpoint, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return oauth2.Endpoint{}, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("login: discovery: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return oauth2.Endpoint{}, fmt.Errorf("login: discovery: server returned status %d", resp.StatusCode)
+	}
+	var doc struct {
+		AuthorizationEndpoint       string `json:"authorization_endpoint"`
+		TokenEndpoint               string `json:"token_endpoint"`
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("login: discovery: %w", err)
+	}
+	if doc.TokenEndpoint == "" {
+		return oauth2.Endpoint{}, fmt.Errorf("login: discovery: %w", ErrMissingEndpoint)
+	}
+	return oauth2.Endpoint{
+		AuthURL:       doc.AuthorizationEndpoint,
+		TokenURL:      doc.TokenEndpoint,
+		DeviceAuthURL: doc.DeviceAuthorizationEndpoint,
+	}, nil
+}
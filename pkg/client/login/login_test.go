@@ -0,0 +1,242 @@
+package login
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// authServer is a minimal authorization server supporting the device, authorization
+// code and refresh token grants. Refresh tokens are rotated on use.
+type authServer struct {
+	*httptest.Server
+	polls     atomic.Int32
+	refreshes atomic.Int32
+
+	mu        sync.Mutex
+	challenge string
+	refresh   string
+	issued    int
+}
+
+func newAuthServer(t *testing.T) *authServer {
+	s := &authServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":      "device-123",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": s.URL + "/activate",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		s.mu.Lock()
+		s.challenge = q.Get("code_challenge")
+		s.mu.Unlock()
+		http.Redirect(w, r, q.Get("redirect_uri")+"?code=auth-code&state="+q.Get("state"), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.Form.Get("grant_type") {
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			if s.polls.Add(1) == 1 {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+				return
+			}
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+			if r.Form.Get("code") != "auth-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != s.challenge {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+				return
+			}
+		case "refresh_token":
+			s.refreshes.Add(1)
+			if r.Form.Get("refresh_token") != s.refresh {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+				return
+			}
+		default:
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+			return
+		}
+		s.issued++
+		s.refresh = fmt.Sprintf("refresh-%d", s.issued)
+		writeJSON(w, http.StatusOK, map[string]any{
+			"access_token":  fmt.Sprintf("access-%d", s.issued),
+			"refresh_token": s.refresh,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"authorization_endpoint":        s.URL + "/authorize",
+			"token_endpoint":                s.URL + "/token",
+			"device_authorization_endpoint": s.URL + "/device",
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func testConfig(t *testing.T, s *authServer) *Config {
+	endpoint, err := Discover(context.Background(), s.URL, nil)
+	require.NoError(t, err)
+	store, err := client.NewFileTokenStore(t.TempDir())
+	require.NoError(t, err)
+	return &Config{ClientID: "cli", Scopes: []string{"openid", "offline_access"}, Endpoint: endpoint, Store: store}
+}
+
+func TestDeviceLogin(t *testing.T) {
+	s := newAuthServer(t)
+	cfg := testConfig(t, s)
+
+	_, err := cfg.TokenSource(context.Background())
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
+	var prompt strings.Builder
+	ts, err := cfg.DeviceLogin(context.Background(), PrintDeviceCode(&prompt))
+	require.NoError(t, err)
+	assert.Contains(t, prompt.String(), "ABCD-EFGH")
+	assert.Contains(t, prompt.String(), s.URL+"/activate")
+	assert.Equal(t, int32(2), s.polls.Load(), "pending authorization is polled again")
+
+	tok, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", tok.AccessToken)
+
+	// The session is persisted, including the refresh token.
+	stored, err := cfg.Store.Load(cfg.CacheKey())
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-1", stored.RefreshToken)
+}
+
+func TestRefreshAfterLoginContextIsDone(t *testing.T) {
+	s := newAuthServer(t)
+	cfg := testConfig(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ts, err := cfg.DeviceLogin(ctx, nil)
+	require.NoError(t, err)
+	cancel()
+
+	tok, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", tok.AccessToken)
+	tok, err = ts.Token()
+	require.NoError(t, err, "refreshes outlive the login context")
+	assert.Equal(t, "access-2", tok.AccessToken)
+	assert.Equal(t, int32(1), s.refreshes.Load())
+}
+
+func TestBrowserLogin(t *testing.T) {
+	s := newAuthServer(t)
+	cfg := testConfig(t, s)
+
+	// The browser follows the redirect back to the loopback listener.
+	open := func(url string) error {
+		go func() {
+			resp, err := http.Get(url)
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ts, err := cfg.BrowserLogin(ctx, BrowserOptions{Open: open})
+	require.NoError(t, err)
+	tok, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", tok.AccessToken)
+}
+
+func TestBrowserLoginRejectsStateMismatch(t *testing.T) {
+	s := newAuthServer(t)
+	cfg := testConfig(t, s)
+
+	open := func(authURL string) error {
+		go func() {
+			resp, err := http.Get(authURL)
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+	// Rewrite the state the authorization server sends back.
+	cfg.Endpoint.AuthURL = s.URL + "/authorize?state=forged&"
+	_, err := cfg.BrowserLogin(context.Background(), BrowserOptions{Open: open})
+	assert.Error(t, err)
+}
+
+func TestStoredSessionWithClient(t *testing.T) {
+	s := newAuthServer(t)
+	cfg := testConfig(t, s)
+	_, err := cfg.DeviceLogin(context.Background(), nil)
+	require.NoError(t, err)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// access-2 was issued by the refresh below; the original token is revoked.
+		if r.Header.Get("Authorization") != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer api.Close()
+
+	// A later process resumes the session from the store.
+	ts, err := cfg.TokenSource(context.Background())
+	require.NoError(t, err)
+	c, err := client.NewClient(client.WithBaseURL(api.URL), client.WithTokenSource(ts))
+	require.NoError(t, err)
+	resp, err := c.Do(context.Background(), c.R(), client.GET, "/me")
+	require.NoError(t, err)
+	require.NoError(t, c.Check(resp))
+	assert.Equal(t, int32(1), s.refreshes.Load())
+
+	// The rotated refresh token is persisted.
+	stored, err := cfg.Store.Load(cfg.CacheKey())
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-2", stored.RefreshToken)
+
+	require.NoError(t, cfg.Logout())
+	_, err = cfg.TokenSource(context.Background())
+	assert.ErrorIs(t, err, ErrNotLoggedIn)
+}
+
+func TestConfigValidation(t *testing.T) {
+	_, err := (&Config{}).DeviceLogin(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrMissingClientID)
+	_, err = (&Config{ClientID: "cli", Endpoint: oauth2.Endpoint{TokenURL: "https://example.com/token"}}).DeviceLogin(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrMissingEndpoint)
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// RequestInfo describes a single API call as seen by a RequestObserver.
+type RequestInfo struct {
+	// Method is the HTTP method.
+	Method string
+	// Path is the request path as passed to Do, relative to the base URL.
+	Path string
+	// PathTemplate is Path with identities replaced by placeholders (e.g. /v1/machines/{id}),
+	// suitable as a low-cardinality metric label.
+	PathTemplate string
+	// Header holds the request headers. Observers may add headers in RequestStarted
+	// (for example for trace propagation); use RedactHeaders before logging them.
+	Header http.Header
+
+	// StatusCode is the HTTP status code, or zero if no response was received.
+	StatusCode int
+	// Duration is the total time spent in Do, including rate limiting and retries.
+	Duration time.Duration
+	// RetryCount is the number of retries performed after the first attempt.
+	RetryCount int
+	// BreakerKey is the key of the circuit breaker the call went through, if any.
+	BreakerKey string
+	// BreakerState is the circuit breaker state after the call, if a breaker is configured.
+	BreakerState string
+	// Err is the transport error, or an *APIError for non-2xx responses.
+	Err error
+}
+
+// RequestObserver receives a callback before and after every API call made through Do.
+type RequestObserver interface {
+	// RequestStarted is called before the request is sent. The returned context is used
+	// for the request and passed to RequestFinished.
+	RequestStarted(ctx context.Context, info *RequestInfo) context.Context
+	// RequestFinished is called once the request completed or failed.
+	RequestFinished(ctx context.Context, info *RequestInfo)
+}
+
+// ObserverFuncs adapts plain functions to a RequestObserver. Nil functions are skipped,
+// which makes it a convenient hook for metrics.
+type ObserverFuncs struct {
+	OnStart  func(ctx context.Context, info *RequestInfo) context.Context
+	OnFinish func(ctx context.Context, info *RequestInfo)
+}
+
+func (o ObserverFuncs) RequestStarted(ctx context.Context, info *RequestInfo) context.Context {
+	if o.OnStart == nil {
+		return ctx
+	}
+	return o.OnStart(ctx, info)
+}
+
+func (o ObserverFuncs) RequestFinished(ctx context.Context, info *RequestInfo) {
+	if o.OnFinish != nil {
+		o.OnFinish(ctx, info)
+	}
+}
+
+// WithRequestObserver registers observers that are notified of every API call.
+// Observers are started in order and finished in reverse order.
+func WithRequestObserver(observers ...RequestObserver) Option {
+	return func(c *thalassaCloudClient) error {
+		c.observers = append(c.observers, observers...)
+		return nil
+	}
+}
+
+// WithPathTemplate returns a context that overrides the path template reported to observers.
+func WithPathTemplate(ctx context.Context, template string) context.Context {
+	return context.WithValue(ctx, pathTemplateKey, template)
+}
+
+var versionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// PathTemplate replaces path segments that look like identities or region slugs
+// (anything containing a digit, apart from the API version) with "{id}".
+func PathTemplate(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s == "" || versionSegment.MatchString(s) {
+			continue
+		}
+		if strings.ContainsAny(s, "0123456789") || len(s) > 32 {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func pathTemplateFor(ctx context.Context, path string) string {
+	if t, ok := ctx.Value(pathTemplateKey).(string); ok && t != "" {
+		return t
+	}
+	return PathTemplate(path)
+}
+
+// redactedValue replaces sensitive values in logs.
+const redactedValue = "REDACTED"
+
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Auth-Token", "Token"}
+
+// RedactHeaders returns a copy of h with credentials replaced.
+func RedactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	if out == nil {
+		return http.Header{}
+	}
+	for _, k := range sensitiveHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(k)]; ok {
+			out.Set(k, redactedValue)
+		}
+	}
+	return out
+}
+
+// RedactURL returns rawURL with credentials in the query string (such as the
+// ?token= parameter used for websocket connections) and userinfo replaced.
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	if u.User != nil {
+		u.User = url.User(redactedValue)
+	}
+	q := u.Query()
+	changed := false
+	for k := range q {
+		switch strings.ToLower(k) {
+		case "token", "access_token", "subject_token", "client_secret":
+			q.Set(k, redactedValue)
+			changed = true
+		}
+	}
+	if changed {
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogObserver is a RequestObserver that logs every API call to a slog.Logger.
+// Successful calls are logged at Level, failed calls at slog.LevelWarn or above.
+// Credentials in headers are always redacted.
+type SlogObserver struct {
+	Logger *slog.Logger
+	// Level is the level used for successful requests. Defaults to slog.LevelDebug.
+	Level slog.Level
+	// LogHeaders includes the (redacted) request headers in the log record.
+	LogHeaders bool
+}
+
+// NewSlogObserver returns a SlogObserver that logs successful requests at debug level.
+// A nil logger uses slog.Default().
+func NewSlogObserver(logger *slog.Logger) *SlogObserver {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogObserver{Logger: logger, Level: slog.LevelDebug}
+}
+
+func (o *SlogObserver) RequestStarted(ctx context.Context, _ *RequestInfo) context.Context {
+	return ctx
+}
+
+func (o *SlogObserver) RequestFinished(ctx context.Context, info *RequestInfo) {
+	level := o.Level
+	switch {
+	case info.Err != nil && info.StatusCode == 0, info.StatusCode >= 500:
+		level = slog.LevelError
+	case info.Err != nil:
+		level = slog.LevelWarn
+	}
+	logger := o.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", info.Method),
+		slog.String("path", RedactURL(info.Path)),
+		slog.String("path_template", info.PathTemplate),
+		slog.Int("status", info.StatusCode),
+		slog.Duration("duration", info.Duration),
+	}
+	if info.RetryCount > 0 {
+		attrs = append(attrs, slog.Int("retries", info.RetryCount))
+	}
+	if info.BreakerState != "" {
+		attrs = append(attrs, slog.String("breaker", info.BreakerKey), slog.String("breaker_state", info.BreakerState))
+	}
+	if info.Err != nil {
+		attrs = append(attrs, slog.String("error", info.Err.Error()))
+	}
+	if o.LogHeaders {
+		headers := RedactHeaders(info.Header)
+		headerAttrs := make([]any, 0, len(headers))
+		for k, v := range headers {
+			headerAttrs = append(headerAttrs, slog.Any(k, v))
+		}
+		attrs = append(attrs, slog.Group("headers", headerAttrs...))
+	}
+	logger.LogAttrs(ctx, level, "thalassa api request", attrs...)
+}
+
+// BreakerStateChanged logs circuit breaker state changes at slog.LevelWarn.
+func (o *SlogObserver) BreakerStateChanged(key, from, to string) {
+	logger := o.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Warn("thalassa circuit breaker state changed", slog.String("breaker", key), slog.String("from", from), slog.String("to", to))
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestObserver(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	var started, finished []*RequestInfo
+	c, err := NewClient(
+		WithBaseURL(server.URL),
+		WithAuthPersonalToken("secret-token"),
+		WithRequestObserver(ObserverFuncs{
+			OnStart: func(ctx context.Context, info *RequestInfo) context.Context {
+				started = append(started, info)
+				return ctx
+			},
+			OnFinish: func(ctx context.Context, info *RequestInfo) {
+				finished = append(finished, info)
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Do(context.Background(), c.R(), GET, "/success")
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), c.R(), GET, "/not-found")
+	require.NoError(t, err)
+
+	require.Len(t, started, 2)
+	require.Len(t, finished, 2)
+	assert.Equal(t, "GET", finished[0].Method)
+	assert.Equal(t, "/success", finished[0].Path)
+	assert.Equal(t, http.StatusOK, finished[0].StatusCode)
+	assert.NoError(t, finished[0].Err)
+	assert.Positive(t, finished[0].Duration)
+
+	assert.Equal(t, http.StatusNotFound, finished[1].StatusCode)
+	assert.True(t, IsNotFound(finished[1].Err))
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/v1/machines":                           "/v1/machines",
+		"/v1/machines/vm-01hx9k2m/start":         "/v1/machines/{id}/start",
+		"/v1/kms/nl-01/keys/key-123/rotate":      "/v1/kms/{id}/keys/{id}/rotate",
+		"/v1/dbaas/dbclusters/abc123?force=true": "/v1/dbaas/dbclusters/{id}",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, PathTemplate(in), in)
+	}
+	ctx := WithPathTemplate(context.Background(), "/v1/secrets/{region}/{path}")
+	assert.Equal(t, "/v1/secrets/{region}/{path}", pathTemplateFor(ctx, "/v1/secrets/nl-01/app/db"))
+}
+
+func TestRedaction(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Token secret")
+	h.Set("X-Organisation-Identity", "org-1")
+	redacted := RedactHeaders(h)
+	assert.Equal(t, "REDACTED", redacted.Get("Authorization"))
+	assert.Equal(t, "org-1", redacted.Get("X-Organisation-Identity"))
+	assert.Equal(t, "Token secret", h.Get("Authorization"), "original must not be modified")
+
+	assert.Equal(t, "wss://api.example.com/v1/machines/vm-1/console?token=REDACTED",
+		RedactURL("wss://api.example.com/v1/machines/vm-1/console?token=secret"))
+	assert.Equal(t, "/v1/machines", RedactURL("/v1/machines"))
+}
+
+func TestSlogObserverRedactsCredentials(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	var buf bytes.Buffer
+	obs := NewSlogObserver(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	obs.LogHeaders = true
+
+	c, err := NewClient(
+		WithBaseURL(server.URL),
+		WithAuthNone(),
+		WithRequestObserver(obs),
+	)
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), c.R().SetHeader("Authorization", "Token super-secret-token"), GET, "/success")
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "path_template=/success")
+	assert.Contains(t, out, "status=200")
+	assert.Contains(t, out, "REDACTED")
+	assert.NotContains(t, out, "super-secret-token")
+}
+
+func TestTraceObserverPropagatesTraceparent(t *testing.T) {
+	var gotTraceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceparent = r.Header.Get(TraceparentHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var spans []Span
+	c, err := NewClient(
+		WithBaseURL(server.URL),
+		WithRequestObserver(NewTraceObserver(func(ctx context.Context, span Span) {
+			spans = append(spans, span)
+		})),
+	)
+	require.NoError(t, err)
+
+	parent, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	ctx := ContextWithTraceContext(context.Background(), parent)
+
+	_, err = c.Do(ctx, c.R(), GET, "/v1/machines/vm-1")
+	require.NoError(t, err)
+
+	sent, err := ParseTraceparent(gotTraceparent)
+	require.NoError(t, err)
+	assert.Equal(t, parent.TraceID, sent.TraceID)
+	assert.NotEqual(t, parent.SpanID, sent.SpanID)
+	assert.True(t, sent.Sampled())
+
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /v1/machines/{id}", spans[0].Name)
+	assert.Equal(t, parent.SpanID, spans[0].ParentSpanID)
+	assert.Equal(t, sent.SpanID, spans[0].SpanID)
+}
+
+func TestParseTraceparentInvalid(t *testing.T) {
+	for _, s := range []string{"", "garbage", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"} {
+		_, err := ParseTraceparent(s)
+		assert.ErrorIs(t, err, ErrInvalidTraceparent, s)
+	}
+}
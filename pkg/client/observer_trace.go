@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+)
+
+var ErrInvalidTraceparent = errors.New("invalid traceparent")
+
+// TraceContext is a W3C trace context (https://www.w3.org/TR/trace-context/).
+type TraceContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+	// State is the opaque tracestate header value, propagated unchanged.
+	State string
+}
+
+// IsValid reports whether both trace and span IDs are non-zero.
+func (tc TraceContext) IsValid() bool {
+	return tc.TraceID != [16]byte{} && tc.SpanID != [8]byte{}
+}
+
+// Sampled reports whether the sampled flag is set.
+func (tc TraceContext) Sampled() bool {
+	return tc.Flags&0x01 == 0x01
+}
+
+// Traceparent formats the trace context as a traceparent header value.
+func (tc TraceContext) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-%02x", hex.EncodeToString(tc.TraceID[:]), hex.EncodeToString(tc.SpanID[:]), tc.Flags)
+}
+
+// ParseTraceparent parses a version 00 traceparent header value.
+func ParseTraceparent(s string) (TraceContext, error) {
+	var tc TraceContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return tc, ErrInvalidTraceparent
+	}
+	if _, err := hex.Decode(tc.TraceID[:], []byte(parts[1])); err != nil {
+		return tc, ErrInvalidTraceparent
+	}
+	if _, err := hex.Decode(tc.SpanID[:], []byte(parts[2])); err != nil {
+		return tc, ErrInvalidTraceparent
+	}
+	var flags [1]byte
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return tc, ErrInvalidTraceparent
+	}
+	tc.Flags = flags[0]
+	if !tc.IsValid() {
+		return tc, ErrInvalidTraceparent
+	}
+	return tc, nil
+}
+
+// ContextWithTraceContext returns a context carrying tc as the parent for outgoing requests.
+func ContextWithTraceContext(ctx context.Context, tc TraceContext) context.Context {
+	return context.WithValue(ctx, traceContextKey, tc)
+}
+
+// TraceContextFromContext returns the trace context stored in ctx, if any.
+func TraceContextFromContext(ctx context.Context) (TraceContext, bool) {
+	tc, ok := ctx.Value(traceContextKey).(TraceContext)
+	return tc, ok && tc.IsValid()
+}
+
+// Span describes a single client-side API call traced by TraceObserver.
+type Span struct {
+	TraceContext
+	// ParentSpanID is the span ID of the caller's trace context, zero for root spans.
+	ParentSpanID [8]byte
+	// Name is "<METHOD> <path template>".
+	Name  string
+	Start time.Time
+	End   time.Time
+	// Info is the finished request.
+	Info *RequestInfo
+}
+
+// TraceObserver propagates W3C trace context on every request. It creates a child
+// span of the trace context found in the request context (see ContextWithTraceContext),
+// or starts a new sampled trace, and sends it in the traceparent header.
+type TraceObserver struct {
+	// OnSpanEnd, if set, is called with every finished span so it can be exported.
+	OnSpanEnd func(ctx context.Context, span Span)
+}
+
+// NewTraceObserver returns a TraceObserver that reports finished spans to onSpanEnd.
+func NewTraceObserver(onSpanEnd func(ctx context.Context, span Span)) *TraceObserver {
+	return &TraceObserver{OnSpanEnd: onSpanEnd}
+}
+
+func (o *TraceObserver) RequestStarted(ctx context.Context, info *RequestInfo) context.Context {
+	span := &Span{
+		Name:  info.Method + " " + info.PathTemplate,
+		Start: time.Now(),
+	}
+	if parent, ok := TraceContextFromContext(ctx); ok {
+		span.TraceContext = parent
+		span.ParentSpanID = parent.SpanID
+	} else {
+		_, _ = rand.Read(span.TraceID[:])
+		span.Flags = 0x01
+	}
+	_, _ = rand.Read(span.SpanID[:])
+
+	if info.Header != nil {
+		info.Header.Set(TraceparentHeader, span.Traceparent())
+		if span.State != "" {
+			info.Header.Set(TracestateHeader, span.State)
+		}
+	}
+	ctx = ContextWithTraceContext(ctx, span.TraceContext)
+	return context.WithValue(ctx, traceSpanKey, span)
+}
+
+func (o *TraceObserver) RequestFinished(ctx context.Context, info *RequestInfo) {
+	span, ok := ctx.Value(traceSpanKey).(*Span)
+	if !ok || o.OnSpanEnd == nil {
+		return
+	}
+	span.End = time.Now()
+	span.Info = info
+	o.OnSpanEnd(ctx, *span)
+}
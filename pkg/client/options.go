@@ -1,9 +1,9 @@
 package client
 
 import (
-	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -40,13 +40,16 @@ func WithProject(projectIdentity string) Option {
 	}
 }
 
+// WithRetries retries failed requests up to count times, using an exponential backoff
+// starting at waitTime and capped at maxWaitTime. It is shorthand for WithRetryPolicy
+// with DefaultRetryPolicy; see RetryPolicy for which requests are retried.
 func WithRetries(count int, waitTime, maxWaitTime time.Duration) Option {
 	return func(c *thalassaCloudClient) error {
 		if count > 0 {
-			c.resty.
-				SetRetryCount(count).
-				SetRetryWaitTime(waitTime).
-				SetRetryMaxWaitTime(maxWaitTime)
+			p := DefaultRetryPolicy(count)
+			p.InitialBackoff = waitTime
+			p.MaxBackoff = maxWaitTime
+			c.retryPolicy = &p
 		}
 		return nil
 	}
@@ -62,15 +65,25 @@ func WithAuthCustom() Option {
 func WithRateLimit(rps float64, burst int) Option {
 	return func(c *thalassaCloudClient) error {
 		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		c.rateLimits = nil
 		return nil
 	}
 }
 
-// WithCircuitBreaker configures a circuit breaker using sony/gobreaker.
+// WithCircuitBreaker configures a single circuit breaker for all requests using
+// sony/gobreaker. Unless st.IsSuccessful is set, transport errors, timeouts and the
+// DefaultBreakerFailureStatusCodes count as failures. Use WithCircuitBreakerPolicy for
+// breakers per service and region.
 func WithCircuitBreaker(name string, st gobreaker.Settings) Option {
 	return func(c *thalassaCloudClient) error {
 		st.Name = name
+		if st.IsSuccessful == nil {
+			st.IsSuccessful = legacyBreakerPolicy.isSuccessful
+		}
+		c.breakerSubscribers = newBreakerSubscribers(c)
+		st.OnStateChange = chainStateChange(st.OnStateChange, c.breakerSubscribers.notify)
 		c.breaker = gobreaker.NewCircuitBreaker(st)
+		c.breakers = nil
 		return nil
 	}
 }
@@ -82,6 +95,20 @@ func WithMiddleware(mw func(*resty.Client, *resty.Request) error) Option {
 	}
 }
 
+// WithTransportWrapper wraps the HTTP transport used for API and OIDC token requests,
+// e.g. to record or stub responses. Wrappers are applied after all other options, so TLS settings
+// still apply to the wrapped transport; the first wrapper is the innermost. Websocket
+// connections do not use the transport.
+func WithTransportWrapper(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(c *thalassaCloudClient) error {
+		if wrap == nil {
+			return errors.New("transport wrapper cannot be nil")
+		}
+		c.transportWrappers = append(c.transportWrappers, wrap)
+		return nil
+	}
+}
+
 // AddMiddleware is a convenience to add further request hooks after creation.
 func (c *thalassaCloudClient) AddMiddleware(mw func(*resty.Client, *resty.Request) error) {
 	c.resty.OnBeforeRequest(mw)
@@ -99,7 +126,6 @@ func WithUserAgent(ua string) Option {
 func WithInsecure() Option {
 	return func(c *thalassaCloudClient) error {
 		c.insecure = true
-		c.resty.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
 		return nil
 	}
 }
@@ -111,10 +137,6 @@ func WithRootCAs(pool *x509.CertPool) Option {
 			return errors.New("root CA pool cannot be nil")
 		}
 		c.rootCAs = pool
-		c.resty.SetTLSClientConfig(&tls.Config{
-			RootCAs:    pool,
-			MinVersion: tls.VersionTLS12,
-		})
 		return nil
 	}
 }
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+const (
+	// DefaultPageSize is the page size used by Paginate when none is configured.
+	DefaultPageSize = 100
+)
+
+// PageRequest describes the page that a PageFunc should fetch. Page is 1-based.
+type PageRequest struct {
+	Page     int
+	PageSize int
+}
+
+// Page is a single page of results returned by a PageFunc.
+type Page[T any] struct {
+	Items []T
+	// TotalPages is the total number of pages reported by the server.
+	// When zero, paging stops at the first page shorter than the requested page size.
+	TotalPages int
+}
+
+// PageFunc fetches a single page of results.
+type PageFunc[T any] func(ctx context.Context, req PageRequest) (*Page[T], error)
+
+// PagerOption configures Paginate.
+type PagerOption func(*pagerConfig)
+
+type pagerConfig struct {
+	pageSize  int
+	startPage int
+	prefetch  bool
+}
+
+// WithPageSize sets the number of items requested per page.
+func WithPageSize(size int) PagerOption {
+	return func(c *pagerConfig) {
+		if size > 0 {
+			c.pageSize = size
+		}
+	}
+}
+
+// WithStartPage sets the first page to fetch (1-based).
+func WithStartPage(page int) PagerOption {
+	return func(c *pagerConfig) {
+		if page > 0 {
+			c.startPage = page
+		}
+	}
+}
+
+// WithPrefetch fetches the next page in the background while the current page is consumed.
+func WithPrefetch() PagerOption {
+	return func(c *pagerConfig) {
+		c.prefetch = true
+	}
+}
+
+// Paginate returns an iterator over all items returned by fetch, requesting pages
+// until the server reports no more results. Iteration stops at the first error,
+// which is yielded together with the zero value of T. Breaking out of the loop
+// stops fetching and cancels any in-flight prefetch.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T], opts ...PagerOption) iter.Seq2[T, error] {
+	cfg := pagerConfig{pageSize: DefaultPageSize, startPage: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			page *Page[T]
+			err  error
+		}
+		fetchAsync := func(n int) <-chan result {
+			ch := make(chan result, 1)
+			go func() {
+				p, err := fetch(ctx, PageRequest{Page: n, PageSize: cfg.pageSize})
+				ch <- result{p, err}
+			}()
+			return ch
+		}
+
+		var next <-chan result
+		for n := cfg.startPage; ; n++ {
+			var (
+				page *Page[T]
+				err  error
+			)
+			if next != nil {
+				r := <-next
+				page, err, next = r.page, r.err, nil
+			} else {
+				page, err = fetch(ctx, PageRequest{Page: n, PageSize: cfg.pageSize})
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if page == nil || len(page.Items) == 0 {
+				return
+			}
+
+			more := hasMorePages(page, n, cfg.pageSize)
+			if more && cfg.prefetch {
+				next = fetchAsync(n + 1)
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					if next != nil {
+						// Cancel and drain the prefetch so the goroutine does not leak.
+						cancel()
+						<-next
+					}
+					return
+				}
+			}
+			if !more {
+				return
+			}
+		}
+	}
+}
+
+func hasMorePages[T any](page *Page[T], n, pageSize int) bool {
+	if page.TotalPages > 0 {
+		return n < page.TotalPages
+	}
+	return len(page.Items) >= pageSize
+}
+
+// ListAll adapts an unpaged list call to the iterator interface used by Paginate,
+// so that callers can range over every List* endpoint the same way.
+func ListAll[T any](ctx context.Context, list func(ctx context.Context) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		items, err := list(ctx)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains an iterator into a slice, returning the first error encountered.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
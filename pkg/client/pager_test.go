@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedInts returns a PageFunc serving the integers [0, total) in pages.
+func pagedInts(total int, reportTotalPages bool, calls *atomic.Int32) PageFunc[int] {
+	return func(ctx context.Context, req PageRequest) (*Page[int], error) {
+		calls.Add(1)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page := &Page[int]{}
+		for i := (req.Page - 1) * req.PageSize; i < req.Page*req.PageSize && i < total; i++ {
+			page.Items = append(page.Items, i)
+		}
+		if reportTotalPages {
+			page.TotalPages = (total + req.PageSize - 1) / req.PageSize
+		}
+		return page, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name             string
+		total            int
+		pageSize         int
+		reportTotalPages bool
+		prefetch         bool
+		wantCalls        int32
+	}{
+		{name: "short last page", total: 25, pageSize: 10, wantCalls: 3},
+		{name: "exact multiple needs empty page", total: 20, pageSize: 10, wantCalls: 3},
+		{name: "total pages reported", total: 20, pageSize: 10, reportTotalPages: true, wantCalls: 2},
+		{name: "empty", total: 0, pageSize: 10, wantCalls: 1},
+		{name: "prefetch", total: 25, pageSize: 10, prefetch: true, wantCalls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			opts := []PagerOption{WithPageSize(tt.pageSize)}
+			if tt.prefetch {
+				opts = append(opts, WithPrefetch())
+			}
+			items, err := Collect(Paginate(context.Background(), pagedInts(tt.total, tt.reportTotalPages, &calls), opts...))
+			require.NoError(t, err)
+			require.Len(t, items, tt.total)
+			for i, v := range items {
+				assert.Equal(t, i, v)
+			}
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestPaginateEarlyTermination(t *testing.T) {
+	var calls atomic.Int32
+	seen := 0
+	for v, err := range Paginate(context.Background(), pagedInts(100, false, &calls), WithPageSize(10), WithPrefetch()) {
+		require.NoError(t, err)
+		seen++
+		if v == 4 {
+			break
+		}
+	}
+	assert.Equal(t, 5, seen)
+	// The first page plus at most one prefetched page.
+	assert.LessOrEqual(t, calls.Load(), int32(2))
+}
+
+func TestPaginateError(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, req PageRequest) (*Page[string], error) {
+		if req.Page == 2 {
+			return nil, boom
+		}
+		return &Page[string]{Items: []string{"a", "b"}}, nil
+	}
+	items, err := Collect(Paginate(context.Background(), fetch, WithPageSize(2)))
+	require.ErrorIs(t, err, boom)
+	assert.Equal(t, []string{"a", "b"}, items)
+}
+
+func TestListAll(t *testing.T) {
+	items, err := Collect(ListAll(context.Background(), func(ctx context.Context) ([]string, error) {
+		return []string{"vpc-1", "vpc-2"}, nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vpc-1", "vpc-2"}, items)
+
+	_, err = Collect(ListAll(context.Background(), func(ctx context.Context) ([]string, error) {
+		return nil, ErrNotFound
+	}))
+	assert.True(t, IsNotFound(err))
+}
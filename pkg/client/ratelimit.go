@@ -0,0 +1,291 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
+)
+
+// Rate limit response headers read by the adaptive rate limiter. The equivalent
+// headers without the X- prefix are accepted too.
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// DefaultAdaptiveMinRate is the lowest rate, in requests per second, the adaptive rate
+// limiter backs off to when AdaptiveRateLimit.MinRate is not set.
+const DefaultAdaptiveMinRate = 0.1
+
+// Priority orders requests waiting for the adaptive rate limiter: while requests of a
+// higher priority are waiting, requests of a lower priority are held back.
+type Priority int
+
+const (
+	// PriorityLow is for background work, such as sync jobs.
+	PriorityLow Priority = iota
+	// PriorityNormal is the priority of requests without an explicit priority.
+	PriorityNormal
+	// PriorityHigh is for interactive requests that a user is waiting for.
+	PriorityHigh
+)
+
+// WithRequestPriority returns a context whose requests wait for the adaptive rate
+// limiter with priority p.
+func WithRequestPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey, p)
+}
+
+// RequestPriority returns the priority set with WithRequestPriority, or PriorityNormal.
+func RequestPriority(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey).(Priority); ok {
+		return min(max(p, PriorityLow), PriorityHigh)
+	}
+	return PriorityNormal
+}
+
+// RateBudget is a request rate, in requests per second, and burst.
+type RateBudget struct {
+	Rate  float64
+	Burst int
+}
+
+// AdaptiveRateLimit configures a rate limiter that adjusts to the limits reported by
+// the API. After every response, the rate is set to spread the remaining requests
+// evenly until the limit resets. A 429 response halves the rate and pauses requests
+// for its Retry-After; responses without rate limit headers restore the initial rate
+// step by step.
+type AdaptiveRateLimit struct {
+	// RateBudget is the initial rate and burst of every budget.
+	RateBudget
+	// MinRate is the lowest rate the limiter backs off to. Defaults to DefaultAdaptiveMinRate.
+	MinRate float64
+	// MaxRate caps the rate learned from response headers. Zero means no cap.
+	MaxRate float64
+	// Key maps a request path to its budget. Nil shares one budget between all
+	// requests; DefaultBreakerKey gives every service and region its own budget.
+	Key func(path string) string
+	// Budgets overrides the initial rate and burst for the budgets with these keys.
+	Budgets map[string]RateBudget
+}
+
+// WithAdaptiveRateLimit configures an adaptive rate limiter, replacing a limiter set
+// with WithRateLimit. Clones share the limiter.
+func WithAdaptiveRateLimit(cfg AdaptiveRateLimit) Option {
+	return func(c *thalassaCloudClient) error {
+		if cfg.Rate <= 0 {
+			return errors.New("adaptive rate limit: Rate must be positive")
+		}
+		if cfg.MinRate <= 0 {
+			cfg.MinRate = DefaultAdaptiveMinRate
+		}
+		c.limiter = nil
+		c.rateLimits = &rateLimits{cfg: cfg}
+		return nil
+	}
+}
+
+// rateLimits holds the budgets of an AdaptiveRateLimit, created on first use.
+type rateLimits struct {
+	cfg AdaptiveRateLimit
+
+	mu      sync.Mutex
+	budgets map[string]*rateBudget
+}
+
+func (l *rateLimits) get(path string) *rateBudget {
+	key := ""
+	if l.cfg.Key != nil {
+		key = l.cfg.Key(path)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, ok := l.budgets[key]; ok {
+		return b
+	}
+	budget := l.cfg.RateBudget
+	if override, ok := l.cfg.Budgets[key]; ok {
+		budget = override
+	}
+	b := newRateBudget(budget, l.cfg.MinRate, l.cfg.MaxRate)
+	if l.budgets == nil {
+		l.budgets = map[string]*rateBudget{}
+	}
+	l.budgets[key] = b
+	return b
+}
+
+// rateBudget is a token bucket whose rate follows the responses of the API, and whose
+// tokens go to the waiters of the highest priority first.
+type rateBudget struct {
+	base, minRate, maxRate float64
+	limiter                *rate.Limiter
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+	waiting      [PriorityHigh + 1]int
+	// changed is closed and replaced whenever a waiter leaves or the limits change.
+	changed chan struct{}
+}
+
+func newRateBudget(budget RateBudget, minRate, maxRate float64) *rateBudget {
+	return &rateBudget{
+		base:    budget.Rate,
+		minRate: minRate,
+		maxRate: maxRate,
+		limiter: rate.NewLimiter(rate.Limit(budget.Rate), max(budget.Burst, 1)),
+		changed: make(chan struct{}),
+	}
+}
+
+// wait blocks until a request of priority p may be sent.
+func (b *rateBudget) wait(ctx context.Context, p Priority) error {
+	b.mu.Lock()
+	b.waiting[p]++
+	defer func() {
+		b.waiting[p]--
+		b.notify()
+		b.mu.Unlock()
+	}()
+	for {
+		now := time.Now()
+		delay := time.Duration(-1)
+		switch {
+		case now.Before(b.blockedUntil):
+			delay = b.blockedUntil.Sub(now)
+		case b.preempted(p):
+			// Wait for the higher priority requests to go first.
+		default:
+			r := b.limiter.ReserveN(now, 1)
+			delay = r.DelayFrom(now)
+			if delay == 0 {
+				return nil
+			}
+			r.CancelAt(now)
+		}
+		changed := b.changed
+		b.mu.Unlock()
+		err := waitForChange(ctx, delay, changed)
+		b.mu.Lock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (b *rateBudget) preempted(p Priority) bool {
+	for higher := p + 1; higher <= PriorityHigh; higher++ {
+		if b.waiting[higher] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *rateBudget) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// waitForChange waits for delay, or for changed if delay is negative, or until ctx is done.
+func waitForChange(ctx context.Context, delay time.Duration, changed <-chan struct{}) error {
+	var timeout <-chan time.Time
+	if delay >= 0 {
+		t := time.NewTimer(delay)
+		defer t.Stop()
+		timeout = t.C
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeout:
+	case <-changed:
+	}
+	return nil
+}
+
+// update adjusts the budget to a response.
+func (b *rateBudget) update(resp *resty.Response, now time.Time) {
+	if resp == nil || resp.RawResponse == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	header := resp.Header()
+	current := float64(b.limiter.Limit())
+	if resp.StatusCode() == http.StatusTooManyRequests {
+		pause := parseRetryAfter(header.Get("Retry-After"), now)
+		if pause <= 0 {
+			pause, _ = rateLimitReset(header, now)
+		}
+		if pause > 0 {
+			b.blockedUntil = now.Add(pause)
+		}
+		b.setRate(now, current/2)
+		return
+	}
+
+	reset, ok := rateLimitReset(header, now)
+	if !ok || reset <= 0 {
+		if current < b.base {
+			b.setRate(now, min(current+b.base/10, b.base))
+		}
+		return
+	}
+	remaining, ok := rateLimitHeader(header, RateLimitRemainingHeader)
+	if !ok {
+		// Without the remaining count, assume the full limit is available.
+		if remaining, ok = rateLimitHeader(header, RateLimitLimitHeader); !ok {
+			return
+		}
+	}
+	if remaining <= 0 {
+		b.blockedUntil = now.Add(reset)
+		b.notify()
+		return
+	}
+	b.setRate(now, float64(remaining)/reset.Seconds())
+}
+
+// setRate sets the rate, clamped to the configured bounds.
+func (b *rateBudget) setRate(now time.Time, r float64) {
+	r = max(r, b.minRate)
+	if b.maxRate > 0 {
+		r = min(r, b.maxRate)
+	}
+	b.limiter.SetLimitAt(now, rate.Limit(r))
+	b.notify()
+}
+
+// rateLimitHeader returns the integer value of a rate limit header, with or without
+// the X- prefix.
+func rateLimitHeader(header http.Header, name string) (int, bool) {
+	value := header.Get(name)
+	if value == "" {
+		value = header.Get(strings.TrimPrefix(name, "X-"))
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	return n, err == nil
+}
+
+// rateLimitReset returns the time until the rate limit resets. The reset header holds
+// either a number of seconds or a Unix timestamp.
+func rateLimitReset(header http.Header, now time.Time) (time.Duration, bool) {
+	n, ok := rateLimitHeader(header, RateLimitResetHeader)
+	if !ok {
+		return 0, false
+	}
+	// Values this large are timestamps rather than durations.
+	if n > 1_000_000_000 {
+		return time.Unix(int64(n), 0).Sub(now), true
+	}
+	return time.Duration(n) * time.Second, true
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func rateLimitResponse(status int, headers map[string]string) *resty.Response {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return &resty.Response{RawResponse: &http.Response{StatusCode: status, Header: h}}
+}
+
+func TestRateBudgetUpdate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		start       float64
+		resp        *resty.Response
+		wantRate    float64
+		wantBlocked time.Duration
+	}{
+		{
+			name:     "remaining spread until reset",
+			start:    10,
+			resp:     rateLimitResponse(http.StatusOK, map[string]string{RateLimitRemainingHeader: "100", RateLimitResetHeader: "20"}),
+			wantRate: 5,
+		},
+		{
+			name:     "reset as unix timestamp",
+			start:    10,
+			resp:     rateLimitResponse(http.StatusOK, map[string]string{"RateLimit-Remaining": "30", "RateLimit-Reset": strconv.FormatInt(now.Add(10*time.Second).Unix(), 10)}),
+			wantRate: 3,
+		},
+		{
+			name:     "limit without remaining",
+			start:    10,
+			resp:     rateLimitResponse(http.StatusOK, map[string]string{RateLimitLimitHeader: "600", RateLimitResetHeader: "60"}),
+			wantRate: 10,
+		},
+		{
+			name:     "capped by max rate",
+			start:    10,
+			resp:     rateLimitResponse(http.StatusOK, map[string]string{RateLimitRemainingHeader: "1000", RateLimitResetHeader: "1"}),
+			wantRate: 50,
+		},
+		{
+			name:        "exhausted",
+			start:       10,
+			resp:        rateLimitResponse(http.StatusOK, map[string]string{RateLimitRemainingHeader: "0", RateLimitResetHeader: "30"}),
+			wantRate:    10,
+			wantBlocked: 30 * time.Second,
+		},
+		{
+			name:        "too many requests",
+			start:       10,
+			resp:        rateLimitResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "5"}),
+			wantRate:    5,
+			wantBlocked: 5 * time.Second,
+		},
+		{
+			name:     "backs off to the minimum rate",
+			start:    1.5,
+			resp:     rateLimitResponse(http.StatusTooManyRequests, nil),
+			wantRate: 1,
+		},
+		{
+			name:     "recovers without headers",
+			start:    4,
+			resp:     rateLimitResponse(http.StatusOK, nil),
+			wantRate: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newRateBudget(RateBudget{Rate: 10, Burst: 1}, 1, 50)
+			b.limiter.SetLimit(rate.Limit(tt.start))
+			b.update(tt.resp, now)
+			assert.InDelta(t, tt.wantRate, float64(b.limiter.Limit()), 0.001)
+			if tt.wantBlocked > 0 {
+				assert.Equal(t, now.Add(tt.wantBlocked), b.blockedUntil)
+			} else {
+				assert.True(t, b.blockedUntil.IsZero())
+			}
+		})
+	}
+}
+
+func TestRateBudgetPriority(t *testing.T) {
+	b := newRateBudget(RateBudget{Rate: 20, Burst: 1}, 1, 0)
+	ctx := context.Background()
+	require.NoError(t, b.wait(ctx, PriorityNormal))
+
+	var (
+		mu    sync.Mutex
+		order []Priority
+		wg    sync.WaitGroup
+	)
+	start := func(p Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, b.wait(ctx, p))
+			mu.Lock()
+			order = append(order, p)
+			mu.Unlock()
+		}()
+	}
+	for range 3 {
+		start(PriorityLow)
+	}
+	time.Sleep(10 * time.Millisecond)
+	start(PriorityHigh)
+	wg.Wait()
+	assert.Equal(t, []Priority{PriorityHigh, PriorityLow, PriorityLow, PriorityLow}, order)
+}
+
+func TestRateBudgetBlockedRespectsContext(t *testing.T) {
+	b := newRateBudget(RateBudget{Rate: 10, Burst: 1}, 1, 0)
+	b.blockedUntil = time.Now().Add(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.wait(ctx, PriorityHigh), context.DeadlineExceeded)
+}
+
+func TestAdaptiveRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RateLimitRemainingHeader, "40")
+		w.Header().Set(RateLimitResetHeader, "10")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(WithBaseURL(server.URL), WithAdaptiveRateLimit(AdaptiveRateLimit{}))
+	assert.Error(t, err, "a rate is required")
+
+	c, err := NewClient(WithBaseURL(server.URL), WithRateLimit(1, 1), WithAdaptiveRateLimit(AdaptiveRateLimit{
+		RateBudget: RateBudget{Rate: 100, Burst: 10},
+		Key:        DefaultBreakerKey,
+		Budgets:    map[string]RateBudget{"/v1/kms/nl-01": {Rate: 1, Burst: 1}},
+	}))
+	require.NoError(t, err)
+	client := c.(*thalassaCloudClient)
+	assert.Nil(t, client.limiter, "the adaptive limiter replaces the static one")
+
+	ctx := WithRequestPriority(context.Background(), PriorityHigh)
+	assert.Equal(t, PriorityHigh, RequestPriority(ctx))
+	assert.Equal(t, PriorityNormal, RequestPriority(context.Background()))
+	_, err = c.Do(ctx, c.R(), GET, "/v1/vpcs")
+	require.NoError(t, err)
+
+	vpcs := client.rateLimits.get("/v1/vpcs/vpc-1")
+	assert.InDelta(t, 4, float64(vpcs.limiter.Limit()), 0.001, "the rate follows the response headers")
+	kms := client.rateLimits.get("/v1/kms/nl-01/keys")
+	assert.NotSame(t, vpcs, kms)
+	assert.InDelta(t, 1, float64(kms.limiter.Limit()), 0.001)
+
+	clone, err := c.Clone()
+	require.NoError(t, err)
+	assert.Same(t, client.rateLimits, clone.(*thalassaCloudClient).rateLimits)
+}
@@ -0,0 +1,376 @@
+// Package recorder records HTTP interactions with the Thalassa API to cassette files
+// and replays them, so integration tests can run offline and deterministically.
+//
+// Record a session once against the real API, then replay it in CI:
+//
+//	rec, err := recorder.New("testdata/vpcs.yaml", recorder.ModeReplayOrRecord)
+//	if err != nil { ... }
+//	defer rec.Stop()
+//
+//	c, err := thalassa.NewClient(
+//		client.WithBaseURL("https://api.thalassa.cloud"),
+//		client.WithAuthPersonalToken(os.Getenv("THALASSA_TOKEN")),
+//		rec.Option(),
+//	)
+//
+// Authorization and cookie headers, token query parameters, secret values, KMS
+// plaintexts and credentials are scrubbed before interactions are stored. OIDC token
+// requests go through the recorder as well, so sessions that authenticate with OIDC
+// client credentials or token exchange also replay offline. Requests are matched on
+// method, path, query and body; in replay mode a request without a matching
+// interaction fails with ErrNoMatch, and Stop reports every unmatched request.
+//
+// Cassettes are written as YAML when the file name ends in .yaml or .yml and as JSON
+// otherwise.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// Mode selects whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay serves responses from an existing cassette and never contacts the API.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the API and writes the interactions to the cassette on Stop,
+	// replacing any existing cassette.
+	ModeRecord
+	// ModeReplayOrRecord replays the cassette if it exists and records a new one otherwise.
+	ModeReplayOrRecord
+)
+
+// Redacted replaces scrubbed values. Scrubbed values that were base64 encoded are
+// replaced with the base64 encoding of Redacted, so they still decode on replay.
+const Redacted = "REDACTED"
+
+var (
+	// ErrNoMatch is returned in replay mode for requests without a recorded interaction.
+	ErrNoMatch = errors.New("recorder: no recorded interaction matches request")
+
+	// DefaultScrubbedHeaders are the request and response headers scrubbed by default.
+	DefaultScrubbedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+	// DefaultScrubbedQueryParameters are the query parameters scrubbed by default.
+	DefaultScrubbedQueryParameters = []string{"token", "access_token"}
+	// DefaultScrubbedFields are the JSON and form body fields scrubbed by default: secret
+	// values, KMS plaintexts, the inputs of KMS signatures and HMACs, imported and
+	// exported key material, service account access secrets, Kubernetes session
+	// credentials, and the secrets and tokens of OIDC token requests.
+	DefaultScrubbedFields = []string{"secretString", "secretKeyValues", "plaintext", "input", "importKeyMaterial", "keyMaterial",
+		"password", "accessSecret", "token", "kubeconfig",
+		"client_secret", "client_assertion", "subject_token", "access_token", "refresh_token", "id_token"}
+)
+
+// Cassette is the file format of recorded interactions.
+type Cassette struct {
+	Version      int           `json:"version" yaml:"version"`
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method" yaml:"method"`
+	Path   string      `json:"path" yaml:"path"`
+	Query  string      `json:"query,omitempty" yaml:"query,omitempty"`
+	Header http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"statusCode" yaml:"statusCode"`
+	Header     http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+const cassetteVersion = 1
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithScrubbedHeaders scrubs the given headers in addition to DefaultScrubbedHeaders.
+func WithScrubbedHeaders(names ...string) Option {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.headers[http.CanonicalHeaderKey(name)] = true
+		}
+	}
+}
+
+// WithScrubbedQueryParameters scrubs the given query parameters in addition to
+// DefaultScrubbedQueryParameters.
+func WithScrubbedQueryParameters(names ...string) Option {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.params[name] = true
+		}
+	}
+}
+
+// WithScrubbedFields scrubs the given JSON body fields, at any depth, and form body
+// fields in addition to DefaultScrubbedFields.
+func WithScrubbedFields(names ...string) Option {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.fields[name] = true
+		}
+	}
+}
+
+// WithScrubber registers a function that further scrubs each interaction before it is
+// stored. In replay mode it is applied to incoming requests before matching.
+func WithScrubber(scrub func(*Interaction)) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrub)
+	}
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions. It is safe
+// for concurrent use.
+type Recorder struct {
+	path string
+	mode Mode
+
+	headers   map[string]bool
+	params    map[string]bool
+	fields    map[string]bool
+	scrubbers []func(*Interaction)
+
+	mu        sync.Mutex
+	cassette  Cassette
+	used      []bool
+	unmatched []string
+}
+
+// New returns a Recorder for the cassette at path. In ModeReplay the cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:    path,
+		mode:    mode,
+		headers: map[string]bool{},
+		params:  map[string]bool{},
+		fields:  map[string]bool{},
+	}
+	WithScrubbedHeaders(DefaultScrubbedHeaders...)(r)
+	WithScrubbedQueryParameters(DefaultScrubbedQueryParameters...)(r)
+	WithScrubbedFields(DefaultScrubbedFields...)(r)
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+	if r.mode == ModeReplay {
+		cassette, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = *cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode the recorder operates in. ModeReplayOrRecord is resolved to
+// ModeReplay or ModeRecord by New.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Option returns a client option that routes API requests through the recorder.
+func (r *Recorder) Option() client.Option {
+	return client.WithTransportWrapper(r.Wrap)
+}
+
+// Wrap returns a round-tripper that records or replays the requests sent through next.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return r.roundTrip(req, next)
+	})
+}
+
+// RoundTrip records or replays req, sending it with http.DefaultTransport when recording.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.roundTrip(req, http.DefaultTransport)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func (r *Recorder) roundTrip(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.scrubRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header),
+			Body:       string(r.scrubBody(respBody)),
+		},
+	}
+	for _, scrub := range r.scrubbers {
+		scrub(&interaction)
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+		resp := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        resp.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(resp.Body)),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}, nil
+	}
+	desc := recorded.Method + " " + recorded.Path
+	if recorded.Query != "" {
+		desc += "?" + recorded.Query
+	}
+	if recorded.Body != "" {
+		desc += " " + recorded.Body
+	}
+	r.unmatched = append(r.unmatched, desc)
+	return nil, fmt.Errorf("%w: %s (cassette %s)", ErrNoMatch, desc, r.path)
+}
+
+// Unmatched returns the requests that had no matching interaction in replay mode.
+func (r *Recorder) Unmatched() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.unmatched...)
+}
+
+// Stop finishes the session. In record mode it writes the cassette; in replay mode it
+// returns an error listing the requests that had no matching interaction.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode == ModeReplay {
+		if len(r.unmatched) > 0 {
+			return fmt.Errorf("%w: %d unmatched request(s) replaying %s:\n  %s", ErrNoMatch, len(r.unmatched), r.path, strings.Join(r.unmatched, "\n  "))
+		}
+		return nil
+	}
+	r.cassette.Version = cassetteVersion
+	return Save(r.path, &r.cassette)
+}
+
+// Load reads a cassette from path.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to read cassette: %w", err)
+	}
+	var cassette Cassette
+	if isYAML(path) {
+		err = yaml.Unmarshal(data, &cassette)
+	} else {
+		err = json.Unmarshal(data, &cassette)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to parse cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes a cassette to path, creating its directory if needed.
+func Save(path string, cassette *Cassette) error {
+	var data []byte
+	var err error
+	if isYAML(path) {
+		data, err = yaml.Marshal(cassette)
+	} else {
+		data, err = json.MarshalIndent(cassette, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("recorder: failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("recorder: failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("recorder: failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// readRequestBody reads the body of req and restores it so it can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
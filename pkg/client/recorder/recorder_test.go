@@ -0,0 +1,253 @@
+package recorder
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/iam"
+	"github.com/thalassa-cloud/client-go/kms"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/secrets"
+	"github.com/thalassa-cloud/client-go/thalassa"
+	"github.com/thalassa-cloud/client-go/thalassatest"
+)
+
+const (
+	testToken  = "super-secret-token"
+	testRegion = "nl-01"
+)
+
+// session performs the same calls against c in record and replay mode.
+func session(t *testing.T, c thalassa.Client) (vpc *iaas.Vpc, plaintext []byte) {
+	ctx := context.Background()
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "recorded"})
+	require.NoError(t, err)
+	_, err = c.IaaS().GetVpc(ctx, vpc.Identity)
+	require.NoError(t, err)
+
+	key, err := c.KMS().CreateKey(ctx, testRegion, kms.CreateKmsKeyRequest{Name: "key"})
+	require.NoError(t, err)
+	enc, err := c.KMS().EncryptBytes(ctx, testRegion, key.Identity, []byte("kms-plaintext"))
+	require.NoError(t, err)
+	plaintext, err = c.KMS().DecryptBytes(ctx, testRegion, key.Identity, enc.Ciphertext)
+	require.NoError(t, err)
+
+	_, err = c.Secrets().CreateSecret(ctx, testRegion, secrets.CreateSecretRequest{Path: "/db", SecretString: secrets.EncodeBytes([]byte("hunter2"))})
+	require.NoError(t, err)
+	return vpc, plaintext
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	for _, name := range []string{"session.yaml", "session.json"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cassettes", name)
+
+			server := thalassatest.NewServer(thalassatest.WithToken(testToken))
+			rec, err := New(path, ModeReplayOrRecord)
+			require.NoError(t, err)
+			require.Equal(t, ModeRecord, rec.Mode())
+			c, err := thalassa.NewClient(append(server.ClientOptions(), rec.Option())...)
+			require.NoError(t, err)
+			recordedVpc, plaintext := session(t, c)
+			assert.Equal(t, "kms-plaintext", string(plaintext))
+			require.NoError(t, rec.Stop())
+			server.Close()
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			for _, secret := range []string{testToken, "hunter2", "kms-plaintext", "aHVudGVyMg==", "a21zLXBsYWludGV4dA=="} {
+				assert.NotContains(t, string(data), secret)
+			}
+			assert.Contains(t, string(data), Redacted)
+
+			// Replay without a server: the base URL points nowhere.
+			rec, err = New(path, ModeReplayOrRecord)
+			require.NoError(t, err)
+			require.Equal(t, ModeReplay, rec.Mode())
+			c, err = thalassa.NewClient(client.WithBaseURL("http://127.0.0.1:1"), client.WithAuthPersonalToken("other-token"), rec.Option())
+			require.NoError(t, err)
+			replayedVpc, plaintext := session(t, c)
+			assert.Equal(t, recordedVpc.Identity, replayedVpc.Identity)
+			assert.Equal(t, Redacted, string(plaintext))
+			require.NoError(t, rec.Stop())
+		})
+	}
+}
+
+func TestRecordScrubsCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		call     func(ctx context.Context, c thalassa.Client) error
+		// field is the scrubbed field, which stays in the cassette.
+		field   string
+		secrets []string
+	}{
+		{
+			name:     "exported key material",
+			response: `{"keyMaterial":"cmF3LWtleS1tYXRlcmlhbA==","keyVersion":"1"}`,
+			call: func(ctx context.Context, c thalassa.Client) error {
+				_, err := c.KMS().ExportKey(ctx, testRegion, "key-1", kms.ExportKeyRequest{})
+				return err
+			},
+			field:   "keyMaterial",
+			secrets: []string{"cmF3LWtleS1tYXRlcmlhbA==", "raw-key-material"},
+		},
+		{
+			name:     "signed input",
+			response: `{"signature":"c2lnbmF0dXJl","keyVersion":"1"}`,
+			call: func(ctx context.Context, c thalassa.Client) error {
+				_, err := c.KMS().Sign(ctx, testRegion, "key-1", kms.SignRequest{Input: "c2lnbmVkLWlucHV0"})
+				return err
+			},
+			field:   "input",
+			secrets: []string{"c2lnbmVkLWlucHV0", "signed-input"},
+		},
+		{
+			name:     "service account access secret",
+			response: `{"identity":"cred-1","accessKey":"AKEXAMPLE","accessSecret":"service-account-secret"}`,
+			call: func(ctx context.Context, c thalassa.Client) error {
+				_, err := c.IAM().CreateServiceAccountAccessCredentials(ctx, "sa-1", iam.CreateServiceAccountAccessCredentialRequest{Name: "ci"})
+				return err
+			},
+			field:   "accessSecret",
+			secrets: []string{"service-account-secret"},
+		},
+		{
+			name:     "kubernetes session",
+			response: `{"identity":"session-1","apiServerUrl":"https://k8s.example","token":"cluster-session-token","kubeconfig":"users:\n- user:\n    token: cluster-session-token"}`,
+			call: func(ctx context.Context, c thalassa.Client) error {
+				_, err := c.Kubernetes().GetKubernetesClusterKubeconfig(ctx, "k8s-1")
+				return err
+			},
+			field:   "kubeconfig",
+			secrets: []string{"cluster-session-token", "users:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cassette.json")
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			rec, err := New(path, ModeRecord)
+			require.NoError(t, err)
+			c, err := thalassa.NewClient(client.WithBaseURL(server.URL), client.WithAuthNone(), rec.Option())
+			require.NoError(t, err)
+			require.NoError(t, tt.call(context.Background(), c))
+			require.NoError(t, rec.Stop())
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			for _, secret := range tt.secrets {
+				assert.NotContains(t, string(data), secret)
+			}
+			assert.Contains(t, string(data), tt.field)
+		})
+	}
+}
+
+func TestRecordAndReplayOIDC(t *testing.T) {
+	const (
+		clientSecret = "oidc-client-secret"
+		accessToken  = "oidc-access-token"
+	)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oidc/token":
+			tokenRequests++
+			_, _ = w.Write([]byte(`{"access_token":"` + accessToken + `","token_type":"Bearer","expires_in":3600}`))
+		case iaas.VpcEndpoint:
+			if r.Header.Get("Authorization") != "Bearer "+accessToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"identity":"vpc-1"}]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	rec, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	c, err := thalassa.NewClient(client.WithBaseURL(server.URL), client.WithAuthOIDC("ci", clientSecret, server.URL+"/oidc/token"), rec.Option())
+	require.NoError(t, err)
+	vpcs, err := c.IaaS().ListVpcs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, vpcs, 1)
+	require.NoError(t, rec.Stop())
+	assert.Equal(t, 1, tokenRequests)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "/oidc/token")
+	assert.NotContains(t, string(data), clientSecret)
+	assert.NotContains(t, string(data), accessToken)
+
+	// Replay without a server, including the token request.
+	rec, err = New(path, ModeReplay)
+	require.NoError(t, err)
+	c, err = thalassa.NewClient(client.WithBaseURL("http://127.0.0.1:1"), client.WithAuthOIDC("ci", "other-secret", "http://127.0.0.1:1/oidc/token"), rec.Option())
+	require.NoError(t, err)
+	vpcs, err = c.IaaS().ListVpcs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, vpcs, 1)
+	assert.Equal(t, "vpc-1", vpcs[0].Identity)
+	require.NoError(t, rec.Stop())
+	assert.Equal(t, 1, tokenRequests)
+}
+
+func TestReplayUnmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, Save(path, &Cassette{Version: cassetteVersion, Interactions: []Interaction{{
+		Request:  Request{Method: http.MethodGet, Path: iaas.VpcEndpoint + "/vpc-1"},
+		Response: Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: `{"identity":"vpc-1"}`},
+	}}}))
+
+	rec, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	c, err := thalassa.NewClient(client.WithBaseURL("http://127.0.0.1:1"), client.WithAuthNone(), rec.Option())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	vpc, err := c.IaaS().GetVpc(ctx, "vpc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-1", vpc.Identity)
+
+	// Each interaction is replayed once.
+	_, err = c.IaaS().GetVpc(ctx, "vpc-1")
+	assert.ErrorIs(t, err, ErrNoMatch)
+	_, err = c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "new"})
+	assert.ErrorIs(t, err, ErrNoMatch)
+
+	assert.Len(t, rec.Unmatched(), 2)
+	err = rec.Stop()
+	assert.ErrorIs(t, err, ErrNoMatch)
+	assert.Contains(t, err.Error(), "POST "+iaas.VpcEndpoint)
+
+	_, err = New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.Error(t, err)
+}
+
+func TestMatchesJSONBodiesSemantically(t *testing.T) {
+	recorded := Request{Method: "POST", Path: "/v1/vpcs", Body: `{"name":"a","labels":{"x":"1"}}`}
+	assert.True(t, matches(recorded, Request{Method: "POST", Path: "/v1/vpcs", Body: `{"labels": {"x": "1"}, "name": "a"}`}))
+	assert.False(t, matches(recorded, Request{Method: "POST", Path: "/v1/vpcs", Body: `{"name":"b"}`}))
+	assert.False(t, matches(recorded, Request{Method: "PUT", Path: "/v1/vpcs", Body: recorded.Body}))
+	assert.False(t, matches(recorded, Request{Method: "POST", Path: "/v1/vpcs", Query: "a=1", Body: recorded.Body}))
+}
@@ -0,0 +1,159 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// scrubRequest returns the scrubbed form of req used for storing and matching.
+func (r *Recorder) scrubRequest(req *http.Request, body []byte) Request {
+	query := req.URL.Query()
+	for name := range query {
+		if r.params[name] {
+			for i := range query[name] {
+				query[name][i] = Redacted
+			}
+		}
+	}
+	recorded := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  query.Encode(),
+		Header: r.scrubHeader(req.Header),
+		Body:   string(r.scrubBody(body)),
+	}
+	if r.mode == ModeReplay {
+		interaction := Interaction{Request: recorded}
+		for _, scrub := range r.scrubbers {
+			scrub(&interaction)
+		}
+		recorded = interaction.Request
+	}
+	return recorded
+}
+
+func (r *Recorder) scrubHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	scrubbed := header.Clone()
+	for name, values := range scrubbed {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			for i := range values {
+				values[i] = Redacted
+			}
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody redacts the scrubbed fields of a JSON or form-encoded body. Other bodies
+// are returned as is.
+func (r *Recorder) scrubBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	var v any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return r.scrubForm(body)
+	}
+	if !r.scrubValue(v) {
+		return body
+	}
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// scrubForm redacts the scrubbed fields of a form-encoded body, such as the client
+// secret of an OIDC token request.
+func (r *Recorder) scrubForm(body []byte) []byte {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+	found := false
+	for name, values := range form {
+		if r.fields[name] {
+			for i := range values {
+				values[i] = Redacted
+			}
+			found = true
+		}
+	}
+	if !found {
+		return body
+	}
+	return []byte(form.Encode())
+}
+
+// scrubValue redacts the scrubbed fields in v in place and reports whether any were found.
+func (r *Recorder) scrubValue(v any) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if r.fields[key] {
+				v[key] = redact(value)
+				found = true
+			} else if r.scrubValue(value) {
+				found = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if r.scrubValue(value) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+// redact replaces every string in v with Redacted, keeping the shape of v so that
+// replayed bodies still decode into the same types.
+func redact(v any) any {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+		if _, err := base64.StdEncoding.DecodeString(v); err == nil {
+			return base64.StdEncoding.EncodeToString([]byte(Redacted))
+		}
+		return Redacted
+	case map[string]any:
+		for key, value := range v {
+			v[key] = redact(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redact(value)
+		}
+	}
+	return v
+}
+
+// matches reports whether a recorded request matches an incoming one on method, path,
+// query and body. JSON bodies are compared semantically.
+func matches(recorded, incoming Request) bool {
+	if recorded.Method != incoming.Method || recorded.Path != incoming.Path || recorded.Query != incoming.Query {
+		return false
+	}
+	if recorded.Body == incoming.Body {
+		return true
+	}
+	var a, b any
+	if json.Unmarshal([]byte(recorded.Body), &a) != nil || json.Unmarshal([]byte(incoming.Body), &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
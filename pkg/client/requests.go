@@ -2,11 +2,11 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -16,67 +16,211 @@ import (
 // ─────────────────────────────────────────────────────────────────────────────
 
 func (c *thalassaCloudClient) Do(ctx context.Context, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
-	// If we have a circuit breaker, wrap the request call in breaker.Execute.
-	if c.breaker != nil {
-		result, err := c.breaker.Execute(func() (any, error) {
-			return c.executeRequest(ctx, req, method, url)
-		})
-		if err != nil {
-			return nil, fmt.Errorf("circuit breaker error for %s %s: %w", method, url, err)
+	if err := c.validateBody(req.Body); err != nil {
+		return nil, err
+	}
+	if len(c.observers) == 0 {
+		return c.do(ctx, req, method, url)
+	}
+
+	info := &RequestInfo{
+		Method:       string(method),
+		Path:         url,
+		PathTemplate: pathTemplateFor(ctx, url),
+		Header:       req.Header,
+	}
+	ctx = c.observeStart(ctx, info)
+	start := time.Now()
+	resp, err := c.do(ctx, req, method, url)
+	info.Duration = time.Since(start)
+	info.Err = err
+	if resp != nil {
+		info.StatusCode = resp.StatusCode()
+		if resp.Request != nil && resp.Request.Attempt > 1 {
+			info.RetryCount = resp.Request.Attempt - 1
+		}
+		if err == nil && resp.IsError() {
+			info.Err = newAPIError(resp)
 		}
-		return result.(*resty.Response), nil
+	}
+	c.observeFinish(ctx, info)
+	return resp, err
+}
+
+func (c *thalassaCloudClient) observeStart(ctx context.Context, info *RequestInfo) context.Context {
+	for _, o := range c.observers {
+		ctx = o.RequestStarted(ctx, info)
+	}
+	return ctx
+}
+
+func (c *thalassaCloudClient) observeFinish(ctx context.Context, info *RequestInfo) {
+	if cb, _ := c.breakerFor(info.Path); cb != nil {
+		info.BreakerKey = cb.Name()
+		info.BreakerState = cb.State().String()
+	}
+	for i := len(c.observers) - 1; i >= 0; i-- {
+		c.observers[i].RequestFinished(ctx, info)
+	}
+}
+
+// do runs the request through the circuit breaker, if configured.
+func (c *thalassaCloudClient) do(ctx context.Context, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
+	if cb, policy := c.breakerFor(url); cb != nil {
+		return c.executeWithBreaker(ctx, cb, policy, req, method, url)
 	}
 
 	// If no circuit breaker, just do the request directly.
 	return c.executeRequest(ctx, req, method, url)
 }
 
-// executeRequest does the actual rate-limit & resty request call.
 type contextKey int
 
-const withoutProjectKey contextKey = iota
+const (
+	withoutProjectKey contextKey = iota
+	pathTemplateKey
+	traceContextKey
+	traceSpanKey
+	retryPolicyKey
+	retryBudgetKey
+	idempotencyKeyKey
+	organisationKey
+	projectKey
+	expectedVersionKey
+	dryRunKey
+	priorityKey
+)
 
 // WithoutProject returns a context that suppresses X-Project-Identity on the request.
 func WithoutProject(ctx context.Context) context.Context {
 	return context.WithValue(ctx, withoutProjectKey, true)
 }
 
+// WithRequestOrganisation returns a context that sends requests on behalf of organisation,
+// overriding the client's organisation. Use it to share one client between goroutines
+// working for different organisations.
+func WithRequestOrganisation(ctx context.Context, organisation string) context.Context {
+	return context.WithValue(ctx, organisationKey, organisation)
+}
+
+// WithRequestProject returns a context that sends requests for project, overriding the
+// client's project. WithoutProject takes precedence.
+func WithRequestProject(ctx context.Context, project string) context.Context {
+	return context.WithValue(ctx, projectKey, project)
+}
+
+// organisationFor returns the organisation for a request made with ctx.
+func (c *thalassaCloudClient) organisationFor(ctx context.Context) string {
+	if organisation, ok := ctx.Value(organisationKey).(string); ok && organisation != "" {
+		return organisation
+	}
+	return c.GetOrganisationIdentity()
+}
+
+// projectFor returns the project for a request made with ctx, if any.
+func (c *thalassaCloudClient) projectFor(ctx context.Context) string {
+	if ctx.Value(withoutProjectKey) != nil {
+		return ""
+	}
+	if project, ok := ctx.Value(projectKey).(string); ok && project != "" {
+		return project
+	}
+	return c.getProjectIdentity()
+}
+
+// executeRequest sets the common headers and performs the request, retrying
+// according to the retry policy.
 func (c *thalassaCloudClient) executeRequest(ctx context.Context, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
+	req.SetContext(ctx)
+	if organisation := c.organisationFor(ctx); organisation != "" {
+		req.SetHeader("X-Organisation-Identity", organisation)
+	}
+	if project := c.projectFor(ctx); project != "" {
+		req.SetHeader("X-Project-Identity", project)
+	}
+	// All API calls are JSON.
+	req.SetHeader("Accept", "application/json")
+	// Set before the retry loop, so that every attempt carries the same key.
+	c.setIdempotencyKey(ctx, req, method)
+	setExpectedVersion(ctx, req, method)
+
+	policy := c.retryPolicyFor(ctx)
+	budget, _ := ctx.Value(retryBudgetKey).(*RetryBudget)
+	retries, refreshedToken := 0, false
+	for {
+		resp, err := c.send(ctx, req, method, url)
+		if errors.Is(err, ErrUnsupportedHTTPMethod) {
+			return nil, err
+		}
+		req.Attempt = retries + 1
+
+		// A 401 for a token that looked valid means it was revoked or expired early.
+		// Force one refresh and resend; the server did not process the request.
+		if err == nil && resp.StatusCode() == http.StatusUnauthorized && !refreshedToken &&
+			req.Token != "" && c.tokens.invalidate(req.Token) {
+			refreshedToken = true
+			continue
+		}
+
+		if policy == nil || retries >= policy.MaxRetries || !isIdempotent(method, req) {
+			return requestResult(resp, err, method, url)
+		}
+		delay, retry := policy.retryDelay(retries, resp, err)
+		if !retry || (budget != nil && !budget.take()) {
+			return requestResult(resp, err, method, url)
+		}
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return requestResult(resp, err, method, url)
+		}
+		retries++
+	}
+}
+
+// send performs a single attempt, waiting for the rate limiter first.
+func (c *thalassaCloudClient) send(ctx context.Context, req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
+	// In dry-run mode, mutating requests are captured instead of sent.
+	if method != GET {
+		if plan := c.planFor(ctx); plan != nil {
+			return c.capture(plan, req, method, url)
+		}
+	}
 	// Enforce rate limiting if configured.
 	if c.limiter != nil {
 		if err := c.limiter.Wait(ctx); err != nil {
 			return nil, fmt.Errorf("rate limiter wait error: %w", err)
 		}
 	}
-	req.SetContext(ctx)
-	if c.organisationIdentity != nil {
-		req.SetHeader("X-Organisation-Identity", *c.organisationIdentity)
-	}
-	if c.projectIdentity != nil && ctx.Value(withoutProjectKey) == nil {
-		req.SetHeader("X-Project-Identity", *c.projectIdentity)
+	if c.rateLimits != nil {
+		budget := c.rateLimits.get(url)
+		if err := budget.wait(ctx, RequestPriority(ctx)); err != nil {
+			return nil, fmt.Errorf("rate limiter wait error: %w", err)
+		}
+		resp, err := dispatch(req, method, url)
+		budget.update(resp, time.Now())
+		return resp, err
 	}
-	// All API calls are JSON.
-	req.SetHeader("Accept", "application/json")
+	return dispatch(req, method, url)
+}
 
-	var (
-		resp *resty.Response
-		err  error
-	)
+// dispatch sends req with the given method.
+func dispatch(req *resty.Request, method httpMethod, url string) (*resty.Response, error) {
 	switch method {
 	case GET:
-		resp, err = req.Get(url)
+		return req.Get(url)
 	case POST:
-		resp, err = req.Post(url)
+		return req.Post(url)
 	case PUT:
-		resp, err = req.Put(url)
+		return req.Put(url)
 	case PATCH:
-		resp, err = req.Patch(url)
+		return req.Patch(url)
 	case DELETE:
-		resp, err = req.Delete(url)
+		return req.Delete(url)
 	default:
 		return nil, ErrUnsupportedHTTPMethod
 	}
+}
 
+func requestResult(resp *resty.Response, err error, method httpMethod, url string) (*resty.Response, error) {
 	if err != nil {
 		return nil, fmt.Errorf("request to %s %s failed: %w", method, url, err)
 	}
@@ -125,24 +269,14 @@ func (c *thalassaCloudClient) RawRequest(ctx context.Context, method, path strin
 	return c.Do(ctx, req, m, path)
 }
 
+// Check returns an *APIError when the response has a non-2xx status code.
 func (c *thalassaCloudClient) Check(resp *resty.Response) error {
 	if resp.IsError() {
-		switch resp.StatusCode() {
-		case http.StatusNotFound:
-			var errorMessage ServerErrorMessage
-			if err := json.Unmarshal(resp.Body(), &errorMessage); err != nil {
-				return ErrNotFound
-			}
-			return errors.Join(ErrNotFound, errors.New(errorMessage.Message))
-		case http.StatusBadRequest:
-			var errorMessage ServerErrorMessage
-			if err := json.Unmarshal(resp.Body(), &errorMessage); err != nil {
-				return ErrBadRequest
-			}
-			return errors.Join(ErrBadRequest, errors.New(errorMessage.Message))
-		default:
-			return fmt.Errorf("server returned status %d: %s", resp.StatusCode(), resp.String())
+		apiErr := newAPIError(resp)
+		if err := versionConflict(resp, apiErr); err != nil {
+			return err
 		}
+		return apiErr
 	}
 	return nil
 }
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
+	DefaultRetryMaxRetryAfter  = 2 * time.Minute
+)
+
+// DefaultRetryableStatusCodes are the status codes retried by DefaultRetryPolicy.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls how failed requests are retried.
+//
+// Requests are retried on transport errors and on RetryableStatusCodes, waiting an
+// exponentially growing, fully jittered backoff between attempts. A Retry-After header
+// on the response takes precedence over the computed backoff. Unsafe methods (POST, PATCH)
+// are only retried when the request carries an Idempotency-Key header.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// InitialBackoff is the backoff cap for the first retry; it doubles for every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed backoff.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After the client is willing to wait. Responses
+	// asking for a longer wait are returned to the caller instead of being retried.
+	MaxRetryAfter time.Duration
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns a policy retrying up to maxRetries times on 429, 502, 503 and 504.
+func DefaultRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:           maxRetries,
+		InitialBackoff:       DefaultRetryInitialBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		MaxRetryAfter:        DefaultRetryMaxRetryAfter,
+		RetryableStatusCodes: slices.Clone(DefaultRetryableStatusCodes),
+	}
+}
+
+// WithRetryPolicy configures the retry policy used for every request.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *thalassaCloudClient) error {
+		if p.MaxRetries < 0 {
+			return errors.New("retry policy: MaxRetries cannot be negative")
+		}
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// WithRequestRetryPolicy returns a context that overrides the client's retry policy
+// for requests made with it.
+func WithRequestRetryPolicy(ctx context.Context, p RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey, &p)
+}
+
+// WithoutRetries returns a context that disables retries for requests made with it.
+func WithoutRetries(ctx context.Context) context.Context {
+	return WithRequestRetryPolicy(ctx, RetryPolicy{})
+}
+
+// RetryBudget limits the total number of retries shared by all requests using a context.
+// It is safe for concurrent use.
+type RetryBudget struct {
+	remaining atomic.Int64
+}
+
+// NewRetryBudget returns a budget allowing at most n retries in total.
+func NewRetryBudget(n int) *RetryBudget {
+	b := &RetryBudget{}
+	b.remaining.Store(int64(n))
+	return b
+}
+
+// Remaining returns the number of retries left in the budget.
+func (b *RetryBudget) Remaining() int {
+	return int(max(b.remaining.Load(), 0))
+}
+
+func (b *RetryBudget) take() bool {
+	return b.remaining.Add(-1) >= 0
+}
+
+// WithRetryBudget returns a context whose requests draw their retries from budget.
+// Once the budget is exhausted, failed requests are returned without further retries.
+func WithRetryBudget(ctx context.Context, budget *RetryBudget) context.Context {
+	return context.WithValue(ctx, retryBudgetKey, budget)
+}
+
+func (c *thalassaCloudClient) retryPolicyFor(ctx context.Context) *RetryPolicy {
+	if p, ok := ctx.Value(retryPolicyKey).(*RetryPolicy); ok {
+		return p
+	}
+	return c.retryPolicy
+}
+
+// isIdempotent reports whether a request may be replayed safely.
+func isIdempotent(method httpMethod, req *resty.Request) bool {
+	switch method {
+	case GET, PUT, DELETE:
+		return true
+	}
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// retryDelay decides whether the outcome of an attempt should be retried, and after
+// how long. retry is the zero-based retry number.
+func (p *RetryPolicy) retryDelay(retry int, resp *resty.Response, err error) (time.Duration, bool) {
+	if err != nil {
+		// Cancellation and deadlines come from the caller; never retry them.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		return p.backoff(retry), true
+	}
+	if resp == nil || !slices.Contains(p.RetryableStatusCodes, resp.StatusCode()) {
+		return 0, false
+	}
+	if ra := parseRetryAfter(resp.Header().Get("Retry-After"), time.Now()); ra > 0 {
+		maxRetryAfter := p.MaxRetryAfter
+		if maxRetryAfter <= 0 {
+			maxRetryAfter = DefaultRetryMaxRetryAfter
+		}
+		if ra > maxRetryAfter {
+			return 0, false
+		}
+		return ra, true
+	}
+	return p.backoff(retry), true
+}
+
+// backoff returns a fully jittered exponential backoff for the given retry number.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	initial, maxBackoff := p.InitialBackoff, p.MaxBackoff
+	if initial <= 0 {
+		initial = DefaultRetryInitialBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	ceiling := initial << min(retry, 30)
+	if ceiling <= 0 || ceiling > maxBackoff {
+		ceiling = maxBackoff
+	}
+	return rand.N(ceiling) + 1
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
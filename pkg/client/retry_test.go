@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first failures requests with status, then succeeds.
+func flakyServer(t *testing.T, failures int32, status int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func fastRetryPolicy(maxRetries int) RetryPolicy {
+	p := DefaultRetryPolicy(maxRetries)
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		method         httpMethod
+		idempotencyKey string
+		wantCalls      int32
+		wantStatus     int
+	}{
+		{name: "GET retried on 503", status: http.StatusServiceUnavailable, method: GET, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "DELETE retried on 429", status: http.StatusTooManyRequests, method: DELETE, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "GET not retried on 500", status: http.StatusInternalServerError, method: GET, wantCalls: 1, wantStatus: http.StatusInternalServerError},
+		{name: "POST not retried", status: http.StatusServiceUnavailable, method: POST, wantCalls: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "POST with idempotency key retried", status: http.StatusServiceUnavailable, method: POST, idempotencyKey: "key-1", wantCalls: 3, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := flakyServer(t, 2, tt.status, "")
+			c, err := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(3)))
+			require.NoError(t, err)
+
+			req := c.R()
+			if tt.idempotencyKey != "" {
+				req.SetHeader(IdempotencyKeyHeader, tt.idempotencyKey)
+			}
+			resp, err := c.Do(context.Background(), req, tt.method, "/resource")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode())
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	server, calls := flakyServer(t, 1, http.StatusTooManyRequests, "1")
+	c, err := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(2)))
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err := c.Do(context.Background(), c.R(), GET, "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, int32(2), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryAfterTooLongIsNotRetried(t *testing.T) {
+	server, calls := flakyServer(t, 1, http.StatusServiceUnavailable, "3600")
+	c, err := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(2)))
+	require.NoError(t, err)
+
+	resp, err := c.Do(context.Background(), c.R(), GET, "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryContextOverrides(t *testing.T) {
+	server, calls := flakyServer(t, 5, http.StatusServiceUnavailable, "")
+	c, err := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(3)))
+	require.NoError(t, err)
+
+	resp, err := c.Do(WithoutRetries(context.Background()), c.R(), GET, "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+	assert.Equal(t, int32(1), calls.Load())
+
+	calls.Store(0)
+	budget := NewRetryBudget(1)
+	ctx := WithRetryBudget(context.Background(), budget)
+	_, err = c.Do(ctx, c.R(), GET, "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load(), "one attempt plus one retry from the budget")
+	assert.Equal(t, 0, budget.Remaining())
+}
+
+func TestRetryReportsRetryCountToObservers(t *testing.T) {
+	server, _ := flakyServer(t, 2, http.StatusBadGateway, "")
+	var retries int
+	c, err := NewClient(
+		WithBaseURL(server.URL),
+		WithRetries(3, time.Millisecond, 5*time.Millisecond),
+		WithRequestObserver(ObserverFuncs{OnFinish: func(ctx context.Context, info *RequestInfo) {
+			retries = info.RetryCount
+		}}),
+	)
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), c.R(), GET, "/resource")
+	require.NoError(t, err)
+	assert.Equal(t, 2, retries)
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for retry := 0; retry < 10; retry++ {
+		d := p.backoff(retry)
+		assert.Positive(t, d)
+		assert.LessOrEqual(t, d, time.Second)
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoScopeServer responds with the organisation and project headers it received.
+func echoScopeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"organisation": r.Header.Get("X-Organisation-Identity"),
+			"project":      r.Header.Get("X-Project-Identity"),
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func scopeOf(t *testing.T, c Client, ctx context.Context) map[string]string {
+	var scope map[string]string
+	resp, err := c.Do(ctx, c.R().SetResult(&scope), GET, "/scope")
+	require.NoError(t, err)
+	require.NoError(t, c.Check(resp))
+	return scope
+}
+
+func TestRequestScopedOrganisationAndProject(t *testing.T) {
+	server := echoScopeServer(t)
+	c, err := NewClient(WithBaseURL(server.URL), WithOrganisation("org-default"), WithProject("project-default"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	assert.Equal(t, map[string]string{"organisation": "org-default", "project": "project-default"}, scopeOf(t, c, ctx))
+
+	ctx = WithRequestOrganisation(ctx, "org-a")
+	ctx = WithRequestProject(ctx, "project-a")
+	assert.Equal(t, map[string]string{"organisation": "org-a", "project": "project-a"}, scopeOf(t, c, ctx))
+	assert.Equal(t, map[string]string{"organisation": "org-a", "project": ""}, scopeOf(t, c, WithoutProject(ctx)))
+
+	// The client itself is unchanged.
+	assert.Equal(t, "org-default", c.GetOrganisationIdentity())
+}
+
+func TestClone(t *testing.T) {
+	server := echoScopeServer(t)
+	parent, err := NewClient(WithBaseURL(server.URL), WithOrganisation("org-parent"), WithRateLimit(100, 10))
+	require.NoError(t, err)
+
+	clone, err := parent.Clone(WithOrganisation("org-clone"))
+	require.NoError(t, err)
+	assert.Equal(t, "org-clone", clone.GetOrganisationIdentity())
+	assert.Equal(t, "org-parent", parent.GetOrganisationIdentity())
+	assert.Equal(t, server.URL, clone.GetBaseURL())
+	assert.Equal(t, "org-clone", scopeOf(t, clone, context.Background())["organisation"])
+
+	// Limiter is shared, so clones draw from the same request budget.
+	assert.Same(t, parent.(*thalassaCloudClient).limiter, clone.(*thalassaCloudClient).limiter)
+
+	// SetOrganisation on the parent is carried into later clones, not earlier ones.
+	parent.SetOrganisation("org-changed")
+	later, err := parent.Clone()
+	require.NoError(t, err)
+	assert.Equal(t, "org-changed", later.GetOrganisationIdentity())
+	assert.Equal(t, "org-clone", clone.GetOrganisationIdentity())
+}
+
+func TestConcurrentOrganisationsAndTokenRefresh(t *testing.T) {
+	var tokenCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			tokenCalls.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "tok", "token_type": "Bearer", "expires_in": 3600})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"organisation": r.Header.Get("X-Organisation-Identity")})
+	}))
+	defer server.Close()
+
+	c, err := NewClient(
+		WithBaseURL(server.URL),
+		WithAuthOIDCTokenExchange(OIDCTokenExchangeConfig{
+			TokenURL:         server.URL + "/oidc/token",
+			SubjectToken:     "jwt",
+			OrganisationID:   "org",
+			ServiceAccountID: "sa",
+		}),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			org := fmt.Sprintf("org-%d", i)
+			target := c
+			if i%2 == 0 {
+				clone, err := c.Clone(WithOrganisation(org))
+				if !assert.NoError(t, err) {
+					return
+				}
+				target = clone
+			}
+			ctx := context.Background()
+			if i%2 == 1 {
+				ctx = WithRequestOrganisation(ctx, org)
+			}
+			var scope map[string]string
+			resp, err := target.Do(ctx, target.R().SetResult(&scope), GET, "/scope")
+			if assert.NoError(t, err) && assert.NoError(t, target.Check(resp)) {
+				assert.Equal(t, org, scope["organisation"])
+			}
+			_ = c.GetAuthToken()
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), tokenCalls.Load(), "concurrent requests should share one token fetch")
+}
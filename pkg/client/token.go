@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultTokenRefreshSkew is how long before expiry a token is proactively refreshed.
+	DefaultTokenRefreshSkew = time.Minute
+)
+
+var ErrMissingTokenSource = errors.New("token source cannot be nil")
+
+// tokenFetchFunc fetches a new token. Unlike oauth2.TokenSource it receives the
+// context of the request that triggered the refresh.
+type tokenFetchFunc func(ctx context.Context) (*oauth2.Token, error)
+
+// WithTokenSource authenticates requests with bearer tokens from ts. Tokens are cached
+// and refreshed a configurable skew before they expire (see WithTokenRefreshSkew),
+// and once more when the API rejects a token with 401 Unauthorized.
+//
+// The client does its own caching, so ts should return a fresh token on every call;
+// wrapping it in oauth2.ReuseTokenSource prevents proactive and forced refreshes.
+func WithTokenSource(ts oauth2.TokenSource) Option {
+	return func(c *thalassaCloudClient) error {
+		if ts == nil {
+			return ErrMissingTokenSource
+		}
+		c.authType = AuthTokenSource
+		c.tokens = &tokenCache{}
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// WithTokenRefreshSkew sets how long before expiry tokens from WithTokenSource and the
+// OIDC flows are refreshed. The skew is capped at half the token's lifetime.
+func WithTokenRefreshSkew(d time.Duration) Option {
+	return func(c *thalassaCloudClient) error {
+		if d < 0 {
+			return errors.New("token refresh skew cannot be negative")
+		}
+		c.tokenRefreshSkew = &d
+		return nil
+	}
+}
+
+// tokenCache holds the current access token. It is shared between clones of a client
+// and serialises refreshes, so that concurrent requests share a single in-flight fetch.
+type tokenCache struct {
+	mu        sync.Mutex
+	token     *oauth2.Token
+	fetchedAt time.Time
+	fetch     tokenFetchFunc
+	skew      time.Duration
+	// stale forces a refresh on the next call, after the API rejected the token.
+	stale bool
+
+	// Optional persistent store, shared between processes.
+	store    TokenStore
+	storeKey string
+}
+
+// init sets the fetch function and optional store once; clones sharing the cache
+// keep the first configuration.
+func (t *tokenCache) init(fetch tokenFetchFunc, skew time.Duration, store TokenStore, storeKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.fetch == nil {
+		t.fetch = fetch
+		t.skew = skew
+		if store != nil && storeKey != "" {
+			t.store = store
+			t.storeKey = storeKey
+		}
+	}
+}
+
+// current returns the cached token, which may be nil or expired.
+func (t *tokenCache) current() *oauth2.Token {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+// refreshable reports whether the cache can fetch new tokens.
+func (t *tokenCache) refreshable() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.fetch != nil
+}
+
+// valid returns the cached token, fetching a new one first when it is missing, stale
+// or about to expire.
+func (t *tokenCache) valid(ctx context.Context) (*oauth2.Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.fresh(time.Now()) {
+		return t.token, nil
+	}
+	if t.fetch == nil {
+		if t.token == nil || !t.token.Valid() {
+			return nil, errors.New("token is not valid")
+		}
+		return t.token, nil
+	}
+	if tok := t.loadStored(); tok != nil {
+		return tok, nil
+	}
+	if locker, ok := t.store.(TokenStoreLocker); ok {
+		// Another process may be fetching the same token; wait for it and reuse its result.
+		unlock, err := locker.Lock(t.storeKey)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		if tok := t.loadStored(); tok != nil {
+			return tok, nil
+		}
+	}
+
+	tok, err := t.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil || tok.AccessToken == "" {
+		return nil, errors.New("token source returned an empty token")
+	}
+	t.token = tok
+	t.fetchedAt = time.Now()
+	t.stale = false
+	if t.store != nil {
+		// Failing to persist the token only costs a fetch in the next process.
+		_ = t.store.Store(t.storeKey, tok)
+	}
+	return tok, nil
+}
+
+// loadStored adopts a fresh token from the persistent store, if there is one. A token
+// the API has rejected is never adopted again.
+func (t *tokenCache) loadStored() *oauth2.Token {
+	if t.store == nil {
+		return nil
+	}
+	tok, err := t.store.Load(t.storeKey)
+	if err != nil || tok == nil || tok.AccessToken == "" {
+		return nil
+	}
+	if t.stale && t.token != nil && t.token.AccessToken == tok.AccessToken {
+		return nil
+	}
+	candidate := &tokenCache{token: tok, skew: t.skew}
+	if !candidate.fresh(time.Now()) {
+		return nil
+	}
+	t.token = tok
+	t.fetchedAt = time.Time{}
+	t.stale = false
+	return tok
+}
+
+// fresh reports whether the cached token can be used without refreshing.
+func (t *tokenCache) fresh(now time.Time) bool {
+	if t.token == nil || t.stale || !t.token.Valid() {
+		return false
+	}
+	if t.token.Expiry.IsZero() {
+		return true
+	}
+	skew := t.skew
+	if lifetime := t.token.Expiry.Sub(t.fetchedAt); !t.fetchedAt.IsZero() && skew > lifetime/2 {
+		skew = lifetime / 2
+	}
+	return now.Add(skew).Before(t.token.Expiry)
+}
+
+// invalidate marks accessToken as rejected, forcing the next call to fetch a new token.
+// It is a no-op when the cached token has already been replaced.
+func (t *tokenCache) invalidate(accessToken string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.fetch == nil || t.token == nil || t.token.AccessToken != accessToken {
+		return t.fetch != nil
+	}
+	t.stale = true
+	return true
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// countingTokenSource hands out tokens "t1", "t2", ... that are valid for lifetime.
+type countingTokenSource struct {
+	calls    atomic.Int32
+	lifetime time.Duration
+	delay    time.Duration
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	n := s.calls.Add(1)
+	time.Sleep(s.delay)
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("t%d", n),
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(s.lifetime),
+	}, nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	var sawAuth []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sawAuth = append(sawAuth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(WithBaseURL(server.URL), WithTokenSource(nil))
+	require.ErrorIs(t, err, ErrMissingTokenSource)
+
+	ts := &countingTokenSource{lifetime: time.Hour, delay: 20 * time.Millisecond}
+	c, err := NewClient(WithBaseURL(server.URL), WithTokenSource(ts))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Do(context.Background(), c.R(), GET, "/x")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), ts.calls.Load(), "concurrent callers should share one refresh")
+	for _, auth := range sawAuth {
+		assert.Equal(t, "Bearer t1", auth)
+	}
+	assert.Equal(t, "t1", c.GetAuthToken())
+}
+
+func TestTokenCacheRefreshesBeforeExpiry(t *testing.T) {
+	now := time.Now()
+	cache := &tokenCache{skew: time.Minute, fetchedAt: now.Add(-time.Hour)}
+
+	cache.token = &oauth2.Token{AccessToken: "a", Expiry: now.Add(2 * time.Minute)}
+	assert.True(t, cache.fresh(now))
+
+	cache.token = &oauth2.Token{AccessToken: "a", Expiry: now.Add(30 * time.Second)}
+	assert.False(t, cache.fresh(now), "token expiring within the skew must be refreshed")
+
+	// The skew is capped at half the token lifetime, so short-lived tokens are reused.
+	cache.fetchedAt = now
+	cache.token = &oauth2.Token{AccessToken: "a", Expiry: now.Add(40 * time.Second)}
+	assert.True(t, cache.fresh(now))
+
+	cache.token = &oauth2.Token{AccessToken: "a"}
+	assert.True(t, cache.fresh(now), "tokens without expiry never need a refresh")
+}
+
+func TestTokenSourceProactiveRefresh(t *testing.T) {
+	server := setupTestServer()
+	defer server.Close()
+
+	ts := &countingTokenSource{lifetime: time.Hour}
+	c, err := NewClient(WithBaseURL(server.URL), WithTokenSource(ts), WithTokenRefreshSkew(5*time.Minute))
+	require.NoError(t, err)
+
+	_, err = c.Do(context.Background(), c.R(), GET, "/success")
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), c.R(), GET, "/success")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), ts.calls.Load())
+
+	// Within the skew the token is refreshed, even though it is still valid.
+	cache := c.(*thalassaCloudClient).tokens
+	cache.mu.Lock()
+	cache.fetchedAt = time.Now().Add(-56 * time.Minute)
+	cache.token.Expiry = time.Now().Add(4 * time.Minute)
+	cache.mu.Unlock()
+	_, err = c.Do(context.Background(), c.R(), GET, "/success")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), ts.calls.Load())
+	assert.Equal(t, "t2", c.GetAuthToken())
+}
+
+func TestUnauthorizedForcesTokenRefresh(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch {
+		case r.URL.Path == "/always-401":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Header.Get("Authorization") == "Bearer t1":
+			// t1 has been revoked server-side.
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	ts := &countingTokenSource{lifetime: time.Hour}
+	c, err := NewClient(WithBaseURL(server.URL), WithTokenSource(ts))
+	require.NoError(t, err)
+
+	resp, err := c.Do(context.Background(), c.R(), POST, "/create")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.Equal(t, int32(2), ts.calls.Load())
+	assert.Equal(t, int32(2), requests.Load())
+
+	// Only one forced refresh per call.
+	requests.Store(0)
+	resp, err = c.Do(context.Background(), c.R(), GET, "/always-401")
+	require.NoError(t, err)
+	assert.True(t, IsUnauthorized(c.Check(resp)))
+	assert.Equal(t, int32(3), ts.calls.Load())
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestUnauthorizedWithStaticTokenIsNotRetried(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(WithBaseURL(server.URL), WithToken("static"))
+	require.NoError(t, err)
+	resp, err := c.Do(context.Background(), c.R(), GET, "/x")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenStore persists access tokens across processes, so that short-lived processes
+// such as CLI invocations and CI jobs can reuse a token instead of fetching a new one.
+type TokenStore interface {
+	// Load returns the stored token for key, or nil if there is none.
+	Load(key string) (*oauth2.Token, error)
+	// Store saves tok under key.
+	Store(key string, tok *oauth2.Token) error
+}
+
+// TokenStoreLocker is implemented by token stores that can serialise token fetches
+// between processes. The client holds the lock while fetching and storing a token.
+type TokenStoreLocker interface {
+	Lock(key string) (unlock func(), err error)
+}
+
+// WithTokenCache persists tokens of the OIDC client credentials and token exchange
+// flows in store. Cached tokens are keyed by token URL, organisation and service
+// account (or client ID), and ignored when they are close to expiry. Interactive
+// logins persist their sessions through login.Config.Store instead.
+func WithTokenCache(store TokenStore) Option {
+	return func(c *thalassaCloudClient) error {
+		c.tokenStore = store
+		return nil
+	}
+}
+
+// TokenCacheKey derives a token store key from the parts identifying a credential.
+func TokenCacheKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// FileTokenStore stores each token as a JSON file with 0600 permissions in a directory.
+// Writes are atomic and, on Unix systems, fetches are serialised between processes
+// with an advisory file lock.
+type FileTokenStore struct {
+	Dir string
+}
+
+// DefaultTokenCacheDir returns the default directory for cached tokens,
+// typically ~/.cache/thalassa/tokens.
+func DefaultTokenCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "thalassa", "tokens"), nil
+}
+
+// NewFileTokenStore returns a token store in dir, or in DefaultTokenCacheDir when dir is empty.
+func NewFileTokenStore(dir string) (*FileTokenStore, error) {
+	if dir == "" {
+		var err error
+		if dir, err = DefaultTokenCacheDir(); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("token cache: create directory: %w", err)
+	}
+	return &FileTokenStore{Dir: dir}, nil
+}
+
+func (s *FileTokenStore) path(key, ext string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || key == "." || key == ".." {
+		return "", fmt.Errorf("token cache: invalid key %q", key)
+	}
+	return filepath.Join(s.Dir, key+ext), nil
+}
+
+func (s *FileTokenStore) Load(key string) (*oauth2.Token, error) {
+	p, err := s.path(key, ".json")
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("token cache: read: %w", err)
+	}
+	var tok oauth2.Token
+	if err := json.Unmarshal(b, &tok); err != nil {
+		// A corrupt entry is treated as a cache miss and overwritten on the next store.
+		return nil, nil
+	}
+	return &tok, nil
+}
+
+func (s *FileTokenStore) Store(key string, tok *oauth2.Token) error {
+	p, err := s.path(key, ".json")
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(tok)
+	if err != nil {
+		return fmt.Errorf("token cache: encode: %w", err)
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return fmt.Errorf("token cache: create directory: %w", err)
+	}
+	f, err := os.CreateTemp(s.Dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		return fmt.Errorf("token cache: write: %w", err)
+	}
+	return nil
+}
+
+// Lock takes an exclusive advisory lock for key, blocking until it is available.
+func (s *FileTokenStore) Lock(key string) (func(), error) {
+	p, err := s.path(key, ".lock")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("token cache: create directory: %w", err)
+	}
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("token cache: lock: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("token cache: lock: %w", err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		f.Close()
+	}, nil
+}
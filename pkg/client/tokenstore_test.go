@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store, err := NewFileTokenStore(dir)
+	require.NoError(t, err)
+
+	key := TokenCacheKey("https://api.example.com/oidc/token", "org", "sa")
+	tok, err := store.Load(key)
+	require.NoError(t, err)
+	assert.Nil(t, tok)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, store.Store(key, &oauth2.Token{AccessToken: "abc", TokenType: "Bearer", Expiry: expiry}))
+	tok, err = store.Load(key)
+	require.NoError(t, err)
+	require.NotNil(t, tok)
+	assert.Equal(t, "abc", tok.AccessToken)
+	assert.True(t, expiry.Equal(tok.Expiry))
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(filepath.Join(dir, key+".json"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, e := range entries {
+		assert.NotContains(t, e.Name(), ".tmp")
+	}
+
+	// Corrupt entries are a cache miss.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, key+".json"), []byte("{not json"), 0o600))
+	tok, err = store.Load(key)
+	require.NoError(t, err)
+	assert.Nil(t, tok)
+
+	_, err = store.Load("../escape")
+	assert.Error(t, err)
+
+	unlock, err := store.Lock(key)
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestTokenCacheSharedBetweenClients(t *testing.T) {
+	var exchanges atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			exchanges.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "exchanged", "token_type": "Bearer", "expires_in": 39600})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := NewFileTokenStore(t.TempDir())
+	require.NoError(t, err)
+	cfg := OIDCTokenExchangeConfig{
+		TokenURL:         server.URL + "/oidc/token",
+		SubjectToken:     "jwt",
+		OrganisationID:   "org",
+		ServiceAccountID: "sa",
+	}
+	newClient := func(cfg OIDCTokenExchangeConfig) Client {
+		c, err := NewClient(WithBaseURL(server.URL), WithAuthOIDCTokenExchange(cfg), WithTokenCache(store))
+		require.NoError(t, err)
+		return c
+	}
+
+	// Each client stands in for a separate process.
+	for i := 0; i < 3; i++ {
+		c := newClient(cfg)
+		_, err := c.Do(context.Background(), c.R(), GET, "/x")
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged", c.GetAuthToken())
+	}
+	assert.Equal(t, int32(1), exchanges.Load())
+
+	// A different service account does not share the cached token.
+	other := cfg
+	other.ServiceAccountID = "sa-2"
+	c := newClient(other)
+	_, err = c.Do(context.Background(), c.R(), GET, "/x")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), exchanges.Load())
+
+	// A cached token that is about to expire is discarded.
+	key := TokenCacheKey("token-exchange", cfg.TokenURL, cfg.OrganisationID, cfg.ServiceAccountID)
+	require.NoError(t, store.Store(key, &oauth2.Token{AccessToken: "nearly-expired", Expiry: time.Now().Add(30 * time.Second)}))
+	c = newClient(cfg)
+	_, err = c.Do(context.Background(), c.R(), GET, "/x")
+	require.NoError(t, err)
+	assert.Equal(t, "exchanged", c.GetAuthToken())
+	assert.Equal(t, int32(3), exchanges.Load())
+}
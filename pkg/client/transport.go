@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrUnsupportedTransport is returned when TLS, proxy or dialer options are combined
+// with a custom transport they cannot be applied to.
+var ErrUnsupportedTransport = errors.New("TLS, proxy and dialer options require an *http.Transport")
+
+// WithHTTPClient uses hc for API and token requests. Its transport is the base for the
+// TLS, proxy and dialer options, and its Timeout, Jar and CheckRedirect are applied to
+// API requests; later options such as WithTimeout take precedence.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *thalassaCloudClient) error {
+		if hc == nil {
+			return errors.New("HTTP client cannot be nil")
+		}
+		c.httpClient = hc
+		rc := c.resty.GetClient()
+		rc.Jar = hc.Jar
+		rc.CheckRedirect = hc.CheckRedirect
+		if hc.Timeout > 0 {
+			c.resty.SetTimeout(hc.Timeout)
+		}
+		return nil
+	}
+}
+
+// WithTransport uses rt as the base transport for API and token requests. The TLS,
+// proxy and dialer options modify a copy of rt, which therefore must be an
+// *http.Transport when they are used. To intercept requests, use WithTransportWrapper.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *thalassaCloudClient) error {
+		if rt == nil {
+			return errors.New("transport cannot be nil")
+		}
+		c.transport = rt
+		return nil
+	}
+}
+
+// WithProxy sends all requests, including websocket dials, through the proxy at
+// proxyURL. Without it, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+// are used.
+func WithProxy(proxyURL string) Option {
+	return func(c *thalassaCloudClient) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: scheme and host are required", proxyURL)
+		}
+		c.proxy = http.ProxyURL(u)
+		return nil
+	}
+}
+
+// WithProxyFunc selects the proxy for each request with proxy, as http.Transport.Proxy
+// does. A nil URL means no proxy.
+func WithProxyFunc(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(c *thalassaCloudClient) error {
+		if proxy == nil {
+			return errors.New("proxy function cannot be nil")
+		}
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithClientCertificate presents cert to servers that request a client certificate
+// (mutual TLS).
+func WithClientCertificate(cert tls.Certificate) Option {
+	return func(c *thalassaCloudClient) error {
+		c.clientCertificates = append(c.clientCertificates, cert)
+		return nil
+	}
+}
+
+// WithClientCertificateFiles loads a client certificate for mutual TLS from a pair of
+// PEM files.
+func WithClientCertificateFiles(certFile, keyFile string) Option {
+	return func(c *thalassaCloudClient) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("load client certificate: %w", err)
+		}
+		c.clientCertificates = append(c.clientCertificates, cert)
+		return nil
+	}
+}
+
+// WithDialContext opens all connections, including websocket connections, with dial.
+func WithDialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(c *thalassaCloudClient) error {
+		if dial == nil {
+			return errors.New("dial function cannot be nil")
+		}
+		c.dialContext = dial
+		return nil
+	}
+}
+
+// configureTransport builds the base transport from the transport settings and installs
+// it on the resty client, wrapped by the transport wrappers configured through
+// WithTransportWrapper, the first wrapper being the innermost.
+func (c *thalassaCloudClient) configureTransport() error {
+	base, err := c.newBaseTransport()
+	if err != nil {
+		return err
+	}
+	c.baseTransport = base
+	rt := base
+	for _, wrap := range c.transportWrappers {
+		rt = wrap(rt)
+	}
+	c.resty.SetTransport(rt)
+	return nil
+}
+
+func (c *thalassaCloudClient) newBaseTransport() (http.RoundTripper, error) {
+	rt := c.restyTransport
+	switch {
+	case c.transport != nil:
+		rt = c.transport
+	case c.httpClient != nil && c.httpClient.Transport != nil:
+		rt = c.httpClient.Transport
+	}
+	if !c.hasTLSSettings() && c.proxy == nil && c.dialContext == nil {
+		return rt, nil
+	}
+	t, ok := rt.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("%w, got %T", ErrUnsupportedTransport, rt)
+	}
+	t = t.Clone()
+	if c.hasTLSSettings() {
+		t.TLSClientConfig = c.tlsConfigFrom(t.TLSClientConfig)
+	}
+	if c.proxy != nil {
+		t.Proxy = c.proxy
+	}
+	if c.dialContext != nil {
+		t.DialContext = c.dialContext
+	}
+	return t, nil
+}
+
+func (c *thalassaCloudClient) hasTLSSettings() bool {
+	return c.insecure || c.rootCAs != nil || len(c.clientCertificates) > 0
+}
+
+// tlsConfig returns the TLS configuration set with WithInsecure, WithRootCAs and the
+// client certificate options.
+func (c *thalassaCloudClient) tlsConfig() *tls.Config {
+	return c.tlsConfigFrom(nil)
+}
+
+// tlsConfigFrom applies the TLS options to a copy of base.
+func (c *thalassaCloudClient) tlsConfigFrom(base *tls.Config) *tls.Config {
+	tlsConfig := &tls.Config{}
+	if base != nil {
+		tlsConfig = base.Clone()
+	}
+	if tlsConfig.MinVersion == 0 {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+	if c.insecure {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // dev-only, matches WithInsecure
+	}
+	if c.rootCAs != nil {
+		tlsConfig.RootCAs = c.rootCAs
+	}
+	if len(c.clientCertificates) > 0 {
+		tlsConfig.Certificates = append(tlsConfig.Certificates, c.clientCertificates...)
+	}
+	return tlsConfig
+}
+
+// tokenHTTPClient returns the HTTP client for OIDC token requests. It uses the base
+// transport wrapped by the transport wrappers, so token requests are recorded and
+// stubbed like API requests, and skips certificate verification when insecure is set.
+func (c *thalassaCloudClient) tokenHTTPClient(insecure bool) *http.Client {
+	rt := c.baseTransport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if insecure && !c.insecure {
+		if t, ok := rt.(*http.Transport); ok {
+			t = t.Clone()
+			tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+			if t.TLSClientConfig != nil {
+				tlsConfig = t.TLSClientConfig.Clone()
+			}
+			tlsConfig.InsecureSkipVerify = true //nolint:gosec // dev-only, matches WithAuthOIDCInsecure
+			t.TLSClientConfig = tlsConfig
+			rt = t
+		}
+	}
+	for _, wrap := range c.transportWrappers {
+		rt = wrap(rt)
+	}
+	hc := &http.Client{Transport: rt}
+	if c.httpClient != nil {
+		hc.Timeout = c.httpClient.Timeout
+	}
+	return hc
+}
+
+// websocketDialer returns a dialer with the proxy, dialer and TLS configuration of the
+// base transport.
+func (c *thalassaCloudClient) websocketDialer(opts WebsocketOptions) *websocket.Dialer {
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  c.tlsConfig(),
+		HandshakeTimeout: opts.handshakeTimeout(),
+	}
+	if t, ok := c.baseTransport.(*http.Transport); ok {
+		dialer.Proxy = t.Proxy
+		dialer.NetDialContext = t.DialContext
+		if t.TLSClientConfig != nil {
+			dialer.TLSClientConfig = t.TLSClientConfig.Clone()
+		}
+	}
+	return dialer
+}
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apiHandler serves a token endpoint, a JSON endpoint and a websocket endpoint.
+func apiHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oidc/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600})
+	})
+	mux.HandleFunc("GET /v1/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"authorization":"` + r.Header.Get("Authorization") + `"}`))
+	})
+	mux.Handle("GET /v1/console", &echoServer{})
+	return mux
+}
+
+func tokenExchangeAuth(tokenURL string) Option {
+	return WithAuthOIDCTokenExchange(OIDCTokenExchangeConfig{
+		TokenURL:         tokenURL,
+		SubjectToken:     "subject-token",
+		OrganisationID:   "org-1",
+		ServiceAccountID: "sa-1",
+	})
+}
+
+// ping makes an authenticated API request and opens a websocket.
+func ping(t *testing.T, c Client, baseURL string) error {
+	t.Helper()
+	resp, err := c.Do(context.Background(), c.R(), GET, "/v1/ping")
+	if err != nil {
+		return err
+	}
+	if err := c.Check(resp); err != nil {
+		return err
+	}
+	assert.Contains(t, resp.String(), "Bearer access-token")
+	conn, err := c.DialWebsocket(context.Background(), baseURL+"/v1/console")
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func newClientCertificate(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestMutualTLS(t *testing.T) {
+	cert := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert.Leaf)
+
+	ts := httptest.NewUnstartedServer(apiHandler())
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	ts.StartTLS()
+	defer ts.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ts.Certificate())
+
+	c, err := NewClient(WithBaseURL(ts.URL), tokenExchangeAuth(ts.URL+"/oidc/token"),
+		WithRootCAs(rootCAs), WithClientCertificate(cert))
+	require.NoError(t, err)
+	assert.NoError(t, ping(t, c, ts.URL), "API, token and websocket requests present the certificate")
+
+	c, err = NewClient(WithBaseURL(ts.URL), tokenExchangeAuth(ts.URL+"/oidc/token"), WithRootCAs(rootCAs))
+	require.NoError(t, err)
+	assert.Error(t, ping(t, c, ts.URL))
+
+	_, err = NewClient(WithBaseURL(ts.URL), WithClientCertificateFiles("missing.crt", "missing.key"))
+	assert.ErrorContains(t, err, "load client certificate")
+}
+
+// forwardProxy is an HTTP proxy that forwards all requests, including CONNECT tunnels,
+// to target.
+type forwardProxy struct {
+	target string
+
+	mu    sync.Mutex
+	hosts []string
+}
+
+func (p *forwardProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	p.hosts = append(p.hosts, r.Method+" "+r.Host)
+	p.mu.Unlock()
+
+	if r.Method == http.MethodConnect {
+		upstream, err := net.Dial("tcp", p.target)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer upstream.Close()
+		w.WriteHeader(http.StatusOK)
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		go func() { _, _ = io.Copy(upstream, conn) }()
+		_, _ = io.Copy(conn, upstream)
+		return
+	}
+
+	r.URL.Host = p.target
+	r.RequestURI = ""
+	resp, err := http.DefaultTransport.RoundTrip(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+func (p *forwardProxy) requests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.hosts
+}
+
+func TestWithProxy(t *testing.T) {
+	api := httptest.NewServer(apiHandler())
+	defer api.Close()
+	proxy := &forwardProxy{target: api.Listener.Addr().String()}
+	ps := httptest.NewServer(proxy)
+	defer ps.Close()
+
+	// The API is only reachable through the proxy.
+	const baseURL = "http://api.thalassa.invalid"
+	c, err := NewClient(WithBaseURL(baseURL), tokenExchangeAuth("http://auth.thalassa.invalid/oidc/token"), WithProxy(ps.URL))
+	require.NoError(t, err)
+	require.NoError(t, ping(t, c, baseURL))
+	assert.Equal(t, []string{
+		"POST auth.thalassa.invalid",
+		"GET api.thalassa.invalid",
+		"CONNECT api.thalassa.invalid:80",
+	}, proxy.requests())
+
+	_, err = NewClient(WithBaseURL(baseURL), WithProxy("proxy.example.com"))
+	assert.ErrorContains(t, err, "invalid proxy URL")
+}
+
+func TestWithDialContext(t *testing.T) {
+	api := httptest.NewServer(apiHandler())
+	defer api.Close()
+
+	var mu sync.Mutex
+	var dialed []string
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		mu.Lock()
+		dialed = append(dialed, addr)
+		mu.Unlock()
+		var d net.Dialer
+		return d.DialContext(ctx, network, api.Listener.Addr().String())
+	}
+
+	const baseURL = "http://api.thalassa.invalid"
+	c, err := NewClient(WithBaseURL(baseURL), tokenExchangeAuth("http://auth.thalassa.invalid/oidc/token"), WithDialContext(dial))
+	require.NoError(t, err)
+	require.NoError(t, ping(t, c, baseURL))
+	assert.Contains(t, dialed, "auth.thalassa.invalid:80")
+	assert.Contains(t, dialed, "api.thalassa.invalid:80")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithTransport(t *testing.T) {
+	stub := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Request:    r,
+		}, nil
+	})
+	c, err := NewClient(WithBaseURL("https://api.thalassa.invalid"), WithAuthNone(), WithTransport(stub))
+	require.NoError(t, err)
+	resp, err := c.Do(context.Background(), c.R(), GET, "/v1/ping")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+
+	_, err = NewClient(WithBaseURL("https://api.thalassa.invalid"), WithTransport(stub), WithInsecure())
+	assert.True(t, errors.Is(err, ErrUnsupportedTransport))
+	_, err = c.Clone(WithInsecure())
+	assert.True(t, errors.Is(err, ErrUnsupportedTransport))
+	assert.NotPanics(t, func() { c.WithOptions(WithInsecure()) })
+	assert.True(t, errors.Is(ApplyOptions(c, WithInsecure()), ErrUnsupportedTransport))
+
+	// Transport options modify a copy of an *http.Transport.
+	base := &http.Transport{MaxIdleConns: 7}
+	c, err = NewClient(WithBaseURL("https://api.thalassa.invalid"), WithTransport(base), WithInsecure())
+	require.NoError(t, err)
+	transport := c.(*thalassaCloudClient).resty.GetClient().Transport.(*http.Transport)
+	assert.Equal(t, 7, transport.MaxIdleConns)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.False(t, base.TLSClientConfig != nil && base.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestDefaultTransport(t *testing.T) {
+	c, err := NewClient(WithBaseURL("https://api.thalassa.invalid"), WithAuthNone())
+	require.NoError(t, err)
+	transport := c.(*thalassaCloudClient).resty.GetClient().Transport
+	assert.NotSame(t, http.DefaultTransport, transport)
+	assert.Same(t, c.(*thalassaCloudClient).restyTransport, transport)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer slow.Close()
+
+	var used bool
+	hc := &http.Client{
+		Timeout: 20 * time.Millisecond,
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			used = true
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+	c, err := NewClient(WithBaseURL(slow.URL), WithAuthNone(), WithHTTPClient(hc))
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), c.R(), GET, "/")
+	assert.Error(t, err, "the timeout of the HTTP client applies")
+	assert.True(t, used)
+
+	// The transport of the HTTP client is also used for websocket dials.
+	c, err = NewClient(WithBaseURL(slow.URL), WithHTTPClient(&http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return nil, errors.New("dial blocked")
+		},
+	}}))
+	require.NoError(t, err)
+	_, err = c.DialWebsocket(context.Background(), strings.Replace(slow.URL, "http", "ws", 1))
+	assert.ErrorContains(t, err, "dial blocked")
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gorilla/websocket"
+)
+
+// unavailableResty builds the requests of unavailableClient; they are never sent.
+var unavailableResty = resty.New()
+
+// Unavailable returns a Client whose requests all fail with err. It stands in for a
+// client that could not be created, so that callers get err instead of a panic.
+func Unavailable(err error) Client {
+	return &unavailableClient{err: err}
+}
+
+type unavailableClient struct {
+	err error
+}
+
+func (c *unavailableClient) Do(context.Context, *resty.Request, httpMethod, string) (*resty.Response, error) {
+	return nil, c.err
+}
+
+func (c *unavailableClient) Check(*resty.Response) error {
+	return c.err
+}
+
+func (c *unavailableClient) R() *resty.Request {
+	return unavailableResty.R()
+}
+
+func (c *unavailableClient) WithOptions(...Option) Client {
+	return c
+}
+
+func (c *unavailableClient) Clone(...Option) (Client, error) {
+	return nil, c.err
+}
+
+func (c *unavailableClient) GetOrganisationIdentity() string {
+	return ""
+}
+
+func (c *unavailableClient) SetOrganisation(string) {}
+
+func (c *unavailableClient) GetAuthToken() string {
+	return ""
+}
+
+func (c *unavailableClient) GetBaseURL() string {
+	return ""
+}
+
+func (c *unavailableClient) DialWebsocket(context.Context, string) (*websocket.Conn, error) {
+	return nil, c.err
+}
+
+func (c *unavailableClient) DialWebsocketSession(context.Context, string, WebsocketOptions) (*WebsocketSession, error) {
+	return nil, c.err
+}
+
+func (c *unavailableClient) RawRequest(context.Context, string, string, []byte) (*resty.Response, error) {
+	return nil, c.err
+}
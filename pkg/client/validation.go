@@ -0,0 +1,64 @@
+package client
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidRequest is matched by errors for requests that failed validation before
+// they were sent.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ValidationError lists the invalid fields of a request, as found by its Validate
+// method. It matches ErrInvalidRequest and, like a 400 response from the server,
+// ErrBadRequest.
+type ValidationError struct {
+	FieldErrors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.FieldErrors))
+	for i, fe := range e.FieldErrors {
+		msgs[i] = fe.Field + ": " + fe.Message
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Fields returns the names of the invalid fields, in order.
+func (e *ValidationError) Fields() []string {
+	fields := make([]string, len(e.FieldErrors))
+	for i, fe := range e.FieldErrors {
+		fields[i] = fe.Field
+	}
+	return fields
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidRequest || target == ErrBadRequest
+}
+
+// Validator is implemented by request types that can check their fields.
+type Validator interface {
+	Validate() error
+}
+
+// WithRequestValidation validates request bodies that implement Validator before
+// they are sent. Requests that fail validation return the *ValidationError without
+// reaching the API.
+func WithRequestValidation() Option {
+	return func(c *thalassaCloudClient) error {
+		c.validateRequests = true
+		return nil
+	}
+}
+
+// validateBody runs the Validate method of the request body, if validation is enabled.
+func (c *thalassaCloudClient) validateBody(body any) error {
+	if !c.validateRequests {
+		return nil
+	}
+	if v, ok := body.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
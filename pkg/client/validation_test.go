@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatedBody struct {
+	Name string `json:"name"`
+}
+
+func (b validatedBody) Validate() error {
+	if b.Name == "" {
+		return &ValidationError{FieldErrors: []FieldError{{Field: "name", Message: "is required"}}}
+	}
+	return nil
+}
+
+func TestRequestValidation(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	c, err := NewClient(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	_, err = c.Do(ctx, c.R().SetBody(validatedBody{}), POST, "/v1/vpcs")
+	require.NoError(t, err, "requests are not validated by default")
+	assert.Equal(t, int32(1), requests.Load())
+
+	c, err = NewClient(WithBaseURL(server.URL), WithRequestValidation())
+	require.NoError(t, err)
+	_, err = c.Do(ctx, c.R().SetBody(validatedBody{}), POST, "/v1/vpcs")
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []FieldError{{Field: "name", Message: "is required"}}, verr.FieldErrors)
+	assert.Equal(t, []string{"name"}, verr.Fields())
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.EqualError(t, err, "invalid request: name: is required")
+	assert.Equal(t, int32(1), requests.Load(), "invalid requests are not sent")
+
+	_, err = c.Do(ctx, c.R().SetBody(validatedBody{Name: "vpc"}), POST, "/v1/vpcs")
+	require.NoError(t, err)
+	_, err = c.Do(ctx, c.R().SetBody(map[string]string{}), POST, "/v1/vpcs")
+	require.NoError(t, err, "bodies without a Validate method are sent as is")
+	assert.Equal(t, int32(3), requests.Load())
+}
@@ -0,0 +1,539 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	DefaultWebsocketHandshakeTimeout    = 45 * time.Second
+	DefaultWebsocketPingInterval        = 30 * time.Second
+	DefaultWebsocketPongTimeout         = 60 * time.Second
+	DefaultWebsocketWriteTimeout        = 10 * time.Second
+	DefaultWebsocketReconnectAttempts   = 5
+	DefaultWebsocketReconnectBackoff    = 500 * time.Millisecond
+	DefaultWebsocketReconnectMaxBackoff = 30 * time.Second
+)
+
+// ErrWebsocketSessionClosed is returned by a WebsocketSession after Close was called.
+var ErrWebsocketSessionClosed = errors.New("websocket session closed")
+
+// WebsocketOptions configures a WebsocketSession. The zero value uses the defaults.
+type WebsocketOptions struct {
+	// HandshakeTimeout bounds the opening handshake.
+	HandshakeTimeout time.Duration
+	// PingInterval is the interval between keepalive pings. A negative value disables
+	// keepalive and the read deadline that comes with it.
+	PingInterval time.Duration
+	// PongTimeout is how long the session waits for a message or pong from the server
+	// before it considers the connection dead. It should exceed PingInterval.
+	PongTimeout time.Duration
+	// WriteTimeout bounds every write.
+	WriteTimeout time.Duration
+
+	// OmitQueryToken sends the token only in the Authorization header. By default it is
+	// also sent as the URL-encoded ?token= parameter, for servers that do not read
+	// headers on upgrade requests.
+	OmitQueryToken bool
+
+	// Reconnect re-establishes the connection with backoff when reading or writing fails.
+	// Messages in flight when the connection failed are lost.
+	Reconnect bool
+	// MaxReconnectAttempts is the number of consecutive dial attempts before the session
+	// gives up.
+	MaxReconnectAttempts int
+	// ReconnectInitialBackoff and ReconnectMaxBackoff bound the jittered exponential
+	// backoff between dial attempts.
+	ReconnectInitialBackoff time.Duration
+	ReconnectMaxBackoff     time.Duration
+	// OnReconnect, if set, is called after the session reconnected.
+	OnReconnect func(attempt int)
+}
+
+func (o WebsocketOptions) handshakeTimeout() time.Duration {
+	return durationOr(o.HandshakeTimeout, DefaultWebsocketHandshakeTimeout)
+}
+
+func (o WebsocketOptions) pingInterval() time.Duration {
+	return durationOr(o.PingInterval, DefaultWebsocketPingInterval)
+}
+
+func (o WebsocketOptions) pongTimeout() time.Duration {
+	return durationOr(o.PongTimeout, DefaultWebsocketPongTimeout)
+}
+
+func (o WebsocketOptions) writeTimeout() time.Duration {
+	return durationOr(o.WriteTimeout, DefaultWebsocketWriteTimeout)
+}
+
+func (o WebsocketOptions) reconnectAttempts() int {
+	if o.MaxReconnectAttempts > 0 {
+		return o.MaxReconnectAttempts
+	}
+	return DefaultWebsocketReconnectAttempts
+}
+
+func durationOr(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
+
+// DialWebsocket creates a websocket connection to the specified URL, with authentication
+// and organization headers from the client. The connection has no keepalive; use
+// DialWebsocketSession for long-lived connections.
+func (c *thalassaCloudClient) DialWebsocket(ctx context.Context, wsURL string) (*websocket.Conn, error) {
+	return c.dialWebsocket(ctx, wsURL, WebsocketOptions{})
+}
+
+// DialWebsocketSession opens a websocket session to the specified URL. The session
+// applies the client's TLS configuration, authentication and organisation headers, keeps
+// the connection alive with pings and, if opts.Reconnect is set, redials when the
+// connection fails. Values of ctx, such as WithRequestOrganisation, also apply to
+// reconnects; cancelling ctx only aborts the initial dial.
+func (c *thalassaCloudClient) DialWebsocketSession(ctx context.Context, wsURL string, opts WebsocketOptions) (*WebsocketSession, error) {
+	conn, err := c.dialWebsocket(ctx, wsURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	sessionCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	s := &WebsocketSession{client: c, url: wsURL, opts: opts, ctx: sessionCtx, cancel: cancel}
+	s.attach(conn)
+	return s, nil
+}
+
+func (c *thalassaCloudClient) dialWebsocket(ctx context.Context, wsURL string, opts WebsocketOptions) (*websocket.Conn, error) {
+	authorization, token, err := c.websocketCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate websocket: %w", err)
+	}
+	if opts.OmitQueryToken {
+		token = ""
+	}
+	parsedURL, err := websocketURL(wsURL, token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket URL: %w", err)
+	}
+
+	header := http.Header{}
+	header.Set("User-Agent", c.userAgent)
+	if authorization != "" {
+		header.Set("Authorization", authorization)
+	}
+	if orgIdentity := c.organisationFor(ctx); orgIdentity != "" {
+		header.Set("X-Organisation-Identity", orgIdentity)
+	}
+	if projectIdentity := c.projectFor(ctx); projectIdentity != "" {
+		header.Set("X-Project-Identity", projectIdentity)
+	}
+
+	dialer := c.websocketDialer(opts)
+
+	info := &RequestInfo{
+		Method:       http.MethodGet,
+		Path:         parsedURL.Path,
+		PathTemplate: pathTemplateFor(ctx, parsedURL.Path),
+		Header:       header,
+	}
+	ctx = c.observeStart(ctx, info)
+	start := time.Now()
+
+	conn, resp, err := dialer.DialContext(ctx, parsedURL.String(), header)
+	info.Duration = time.Since(start)
+	info.Err = err
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+	c.observeFinish(ctx, info)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("failed to connect to websocket: %w (status %d)", err, resp.StatusCode)
+		}
+		return nil, fmt.Errorf("failed to connect to websocket: %w", err)
+	}
+	return conn, nil
+}
+
+// websocketCredentials returns the Authorization header for a websocket handshake and
+// the bare token, if the authentication type has one. Middleware added for AuthCustom
+// does not run for websocket handshakes.
+func (c *thalassaCloudClient) websocketCredentials(ctx context.Context) (authorization, token string, err error) {
+	switch c.authType {
+	case AuthPersonalAccessToken:
+		return "Token " + c.personalToken, c.personalToken, nil
+	case AuthToken, AuthOIDC, AuthOIDCTokenExchange, AuthTokenSource:
+		tok, err := c.tokens.valid(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		return "Bearer " + tok.AccessToken, tok.AccessToken, nil
+	case AuthBasic:
+		credentials := base64.StdEncoding.EncodeToString([]byte(c.basicUsername + ":" + c.basicPassword))
+		return "Basic " + credentials, "", nil
+	}
+	return "", "", nil
+}
+
+// websocketURL parses rawURL, maps http(s) to ws(s) and adds token as the URL-encoded
+// token query parameter.
+func websocketURL(rawURL, token string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	if token != "" {
+		q := u.Query()
+		q.Set("token", token)
+		u.RawQuery = q.Encode()
+	}
+	return u, nil
+}
+
+// WebsocketSession is a websocket connection with keepalive and optional reconnects.
+//
+// As with the underlying connection, one goroutine may read and another may write at
+// the same time. Pongs are processed while reading, so a session with keepalive must
+// be read from continuously, or the read deadline expires.
+type WebsocketSession struct {
+	client *thalassaCloudClient
+	url    string
+	opts   WebsocketOptions
+
+	// ctx carries the values of the dial context for reconnects; it is cancelled by Close.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	writeMu sync.Mutex
+
+	// mu guards the fields below.
+	mu            sync.Mutex
+	conn          *websocket.Conn
+	stopKeepalive chan struct{}
+	readDeadline  time.Time
+	writeDeadline time.Time
+	closed        bool
+}
+
+// Conn returns the current underlying connection. It changes when the session reconnects.
+func (s *WebsocketSession) Conn() *websocket.Conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn
+}
+
+// ReadMessage reads the next message, reconnecting first if the connection failed and
+// reconnects are enabled.
+func (s *WebsocketSession) ReadMessage() (messageType int, p []byte, err error) {
+	for {
+		conn, err := s.current()
+		if err != nil {
+			return 0, nil, err
+		}
+		messageType, p, err = conn.ReadMessage()
+		if err == nil {
+			s.extendReadDeadline(conn)
+			return messageType, p, nil
+		}
+		if err := s.recover(conn, err, s.readDeadlineExpired); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+// WriteMessage writes a message. If the write fails and reconnects are enabled, the
+// message is written once more on the new connection.
+func (s *WebsocketSession) WriteMessage(messageType int, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	for attempt := 0; ; attempt++ {
+		conn, err := s.current()
+		if err != nil {
+			return err
+		}
+		if err := conn.SetWriteDeadline(s.writeDeadlineFor(time.Now())); err != nil {
+			return err
+		}
+		err = conn.WriteMessage(messageType, data)
+		if err == nil || attempt > 0 {
+			return err
+		}
+		if err := s.recover(conn, err, s.writeDeadlineExpired); err != nil {
+			return err
+		}
+	}
+}
+
+// SetReadDeadline sets a deadline for reads, in addition to the keepalive deadline.
+// The zero value removes it. As with any websocket connection, a read that times out
+// breaks the connection; with reconnects enabled, the next read after the deadline was
+// extended redials.
+func (s *WebsocketSession) SetReadDeadline(t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrWebsocketSessionClosed
+	}
+	s.readDeadline = t
+	s.extendReadDeadlineLocked(s.conn)
+	return nil
+}
+
+// SetWriteDeadline sets a deadline for writes, in addition to WriteTimeout. The zero
+// value removes it.
+func (s *WebsocketSession) SetWriteDeadline(t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeDeadline = t
+	return nil
+}
+
+// Close sends a close message and closes the connection. Pending reads and writes
+// return ErrWebsocketSessionClosed.
+func (s *WebsocketSession) Close() error {
+	s.cancel()
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	conn := s.conn
+	s.detach()
+	s.mu.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(s.opts.writeTimeout()))
+	return conn.Close()
+}
+
+// NetConn returns a net.Conn reading from and writing to the session. Writes are sent
+// as messages of messageType; reads return the payloads of incoming messages as one
+// stream.
+func (s *WebsocketSession) NetConn(messageType int) net.Conn {
+	return &websocketNetConn{session: s, messageType: messageType}
+}
+
+func (s *WebsocketSession) current() (*websocket.Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrWebsocketSessionClosed
+	}
+	return s.conn, nil
+}
+
+// attach makes conn the current connection and starts its keepalive. The caller must
+// hold s.mu, or have exclusive access to s.
+func (s *WebsocketSession) attach(conn *websocket.Conn) {
+	s.conn = conn
+	interval := s.opts.pingInterval()
+	if interval < 0 {
+		s.extendReadDeadlineLocked(conn)
+		return
+	}
+	conn.SetPongHandler(func(string) error {
+		s.extendReadDeadline(conn)
+		return nil
+	})
+	s.extendReadDeadlineLocked(conn)
+	stop := make(chan struct{})
+	s.stopKeepalive = stop
+	go s.keepalive(conn, interval, stop)
+}
+
+// detach stops the keepalive of the current connection. The caller must hold s.mu.
+func (s *WebsocketSession) detach() {
+	if s.stopKeepalive != nil {
+		close(s.stopKeepalive)
+		s.stopKeepalive = nil
+	}
+}
+
+func (s *WebsocketSession) keepalive(conn *websocket.Conn, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.opts.writeTimeout())); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *WebsocketSession) extendReadDeadline(conn *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.extendReadDeadlineLocked(conn)
+}
+
+// extendReadDeadlineLocked sets the read deadline of conn to the earlier of the
+// keepalive deadline and the deadline set with SetReadDeadline.
+func (s *WebsocketSession) extendReadDeadlineLocked(conn *websocket.Conn) {
+	var deadline time.Time
+	if s.opts.pingInterval() > 0 {
+		deadline = time.Now().Add(s.opts.pongTimeout())
+	}
+	if !s.readDeadline.IsZero() && (deadline.IsZero() || s.readDeadline.Before(deadline)) {
+		deadline = s.readDeadline
+	}
+	_ = conn.SetReadDeadline(deadline)
+}
+
+func (s *WebsocketSession) writeDeadlineFor(now time.Time) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadline := now.Add(s.opts.writeTimeout())
+	if !s.writeDeadline.IsZero() && s.writeDeadline.Before(deadline) {
+		deadline = s.writeDeadline
+	}
+	return deadline
+}
+
+func (s *WebsocketSession) readDeadlineExpired() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.readDeadline.IsZero() && !time.Now().Before(s.readDeadline)
+}
+
+func (s *WebsocketSession) writeDeadlineExpired() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.writeDeadline.IsZero() && !time.Now().Before(s.writeDeadline)
+}
+
+// recover handles the failure of conn with cause. It returns nil once the session has
+// a new connection, or the error to return to the caller. Failures caused by a caller's
+// deadline, a normal closure by the server or Close are not recovered from.
+func (s *WebsocketSession) recover(conn *websocket.Conn, cause error, deadlineExpired func() bool) error {
+	if s.ctx.Err() != nil {
+		return ErrWebsocketSessionClosed
+	}
+	if !s.opts.Reconnect || deadlineExpired() || websocket.IsCloseError(cause, websocket.CloseNormalClosure) {
+		return cause
+	}
+
+	attempt, err := s.reconnect(conn, cause)
+	if err == nil && attempt > 0 && s.opts.OnReconnect != nil {
+		s.opts.OnReconnect(attempt)
+	}
+	return err
+}
+
+// reconnect replaces the failed connection conn, unless another reader or writer
+// already did. It returns the number of dial attempts it took.
+func (s *WebsocketSession) reconnect(conn *websocket.Conn, cause error) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, ErrWebsocketSessionClosed
+	}
+	if s.conn != conn {
+		return 0, nil
+	}
+	s.detach()
+	_ = conn.Close()
+
+	backoff := RetryPolicy{InitialBackoff: s.opts.ReconnectInitialBackoff, MaxBackoff: s.opts.ReconnectMaxBackoff}
+	if backoff.InitialBackoff <= 0 {
+		backoff.InitialBackoff = DefaultWebsocketReconnectBackoff
+	}
+	if backoff.MaxBackoff <= 0 {
+		backoff.MaxBackoff = DefaultWebsocketReconnectMaxBackoff
+	}
+	lastErr := cause
+	for attempt := range s.opts.reconnectAttempts() {
+		if err := sleepContext(s.ctx, backoff.backoff(attempt)); err != nil {
+			return 0, ErrWebsocketSessionClosed
+		}
+		newConn, err := s.client.dialWebsocket(s.ctx, s.url, s.opts)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		s.attach(newConn)
+		return attempt + 1, nil
+	}
+	return 0, fmt.Errorf("websocket reconnect failed after %d attempts: %w", s.opts.reconnectAttempts(), lastErr)
+}
+
+// websocketNetConn adapts a WebsocketSession to net.Conn.
+type websocketNetConn struct {
+	session     *WebsocketSession
+	messageType int
+
+	readMu sync.Mutex
+	// pending is the unread remainder of the last message.
+	pending []byte
+}
+
+func (c *websocketNetConn) Read(p []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+	for len(c.pending) == 0 {
+		_, msg, err := c.session.ReadMessage()
+		if err != nil {
+			return 0, err
+		}
+		c.pending = msg
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *websocketNetConn) Write(p []byte) (int, error) {
+	if err := c.session.WriteMessage(c.messageType, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *websocketNetConn) Close() error {
+	return c.session.Close()
+}
+
+func (c *websocketNetConn) LocalAddr() net.Addr {
+	return c.session.Conn().LocalAddr()
+}
+
+func (c *websocketNetConn) RemoteAddr() net.Addr {
+	return c.session.Conn().RemoteAddr()
+}
+
+func (c *websocketNetConn) SetDeadline(t time.Time) error {
+	if err := c.session.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.session.SetWriteDeadline(t)
+}
+
+func (c *websocketNetConn) SetReadDeadline(t time.Time) error {
+	return c.session.SetReadDeadline(t)
+}
+
+func (c *websocketNetConn) SetWriteDeadline(t time.Time) error {
+	return c.session.SetWriteDeadline(t)
+}
@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer echoes websocket messages. It closes the first dropConnections
+// connections abruptly after their first message.
+type echoServer struct {
+	upgrader        websocket.Upgrader
+	dropConnections int32
+	connections     atomic.Int32
+	pings           atomic.Int32
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (s *echoServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	s.mu.Unlock()
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	n := s.connections.Add(1)
+	conn.SetPingHandler(func(data string) error {
+		s.pings.Add(1)
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+	for {
+		messageType, p, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err := conn.WriteMessage(messageType, p); err != nil {
+			return
+		}
+		if n <= s.dropConnections {
+			return
+		}
+	}
+}
+
+func (s *echoServer) lastRequest() *http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[len(s.requests)-1]
+}
+
+func wsURL(ts *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(ts.URL, "http") + "/console"
+}
+
+func TestDialWebsocketCredentials(t *testing.T) {
+	server := &echoServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		wsOpts    WebsocketOptions
+		wantAuth  string
+		wantToken string
+	}{
+		{
+			name:      "personal token is escaped",
+			opts:      []Option{WithAuthPersonalToken("a+b&c=d")},
+			wantAuth:  "Token a+b&c=d",
+			wantToken: "a+b&c=d",
+		},
+		{
+			name:     "header only",
+			opts:     []Option{WithAuthPersonalToken("secret")},
+			wsOpts:   WebsocketOptions{OmitQueryToken: true},
+			wantAuth: "Token secret",
+		},
+		{
+			name:      "bearer token",
+			opts:      []Option{WithToken("jwt")},
+			wantAuth:  "Bearer jwt",
+			wantToken: "jwt",
+		},
+		{
+			name: "no authentication",
+			opts: []Option{WithAuthNone()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(append([]Option{WithBaseURL(ts.URL), WithOrganisation("org-1")}, tt.opts...)...)
+			require.NoError(t, err)
+			s, err := c.DialWebsocketSession(context.Background(), wsURL(ts)+"?cols=80", tt.wsOpts)
+			require.NoError(t, err)
+			defer s.Close()
+
+			r := server.lastRequest()
+			assert.Equal(t, tt.wantAuth, r.Header.Get("Authorization"))
+			assert.Equal(t, tt.wantToken, r.URL.Query().Get("token"))
+			assert.Equal(t, "80", r.URL.Query().Get("cols"))
+			assert.Equal(t, "org-1", r.Header.Get("X-Organisation-Identity"))
+			assert.Equal(t, DefaultUserAgent, r.Header.Get("User-Agent"))
+		})
+	}
+}
+
+func TestDialWebsocketTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(&echoServer{})
+	defer ts.Close()
+	url := "wss" + strings.TrimPrefix(ts.URL, "https") + "/console"
+
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+	_, err = c.DialWebsocket(context.Background(), url)
+	assert.Error(t, err, "the test certificate is not trusted by default")
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+	c, err = NewClient(WithBaseURL(ts.URL), WithAuthNone(), WithRootCAs(pool))
+	require.NoError(t, err)
+	conn, err := c.DialWebsocket(context.Background(), url)
+	require.NoError(t, err)
+	conn.Close()
+}
+
+func TestWebsocketSessionKeepalive(t *testing.T) {
+	server := &echoServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	s, err := c.DialWebsocketSession(context.Background(), wsURL(ts), WebsocketOptions{PingInterval: 10 * time.Millisecond, PongTimeout: time.Second})
+	require.NoError(t, err)
+	defer s.Close()
+
+	// Pongs are handled while reading.
+	go func() {
+		for {
+			if _, _, err := s.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	assert.Eventually(t, func() bool { return server.pings.Load() >= 3 }, time.Second, 5*time.Millisecond)
+}
+
+func TestWebsocketSessionPongTimeout(t *testing.T) {
+	// The server never reads, so it never answers pings.
+	upgrader := websocket.Upgrader{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	s, err := c.DialWebsocketSession(context.Background(), wsURL(ts), WebsocketOptions{PingInterval: 10 * time.Millisecond, PongTimeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+	defer s.Close()
+	_, _, err = s.ReadMessage()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+}
+
+func TestWebsocketSessionReconnect(t *testing.T) {
+	// Every connection receives its number, and all but the last are dropped right away.
+	upgrader := websocket.Upgrader{}
+	var connections atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		n := connections.Add(1)
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(strconv.Itoa(int(n))))
+		if n < 3 {
+			return
+		}
+		for {
+			messageType, p, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			_ = conn.WriteMessage(messageType, p)
+		}
+	}))
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	var reconnects atomic.Int32
+	s, err := c.DialWebsocketSession(context.Background(), wsURL(ts), WebsocketOptions{
+		Reconnect:               true,
+		ReconnectInitialBackoff: time.Millisecond,
+		OnReconnect:             func(int) { reconnects.Add(1) },
+	})
+	require.NoError(t, err)
+	defer s.Close()
+
+	for _, want := range []string{"1", "2", "3"} {
+		_, p, err := s.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, want, string(p))
+	}
+	assert.Equal(t, int32(2), reconnects.Load())
+	require.NoError(t, s.WriteMessage(websocket.TextMessage, []byte("echo")))
+	_, p, err := s.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "echo", string(p))
+
+	require.NoError(t, s.Close())
+	_, _, err = s.ReadMessage()
+	assert.ErrorIs(t, err, ErrWebsocketSessionClosed)
+	assert.ErrorIs(t, s.WriteMessage(websocket.TextMessage, nil), ErrWebsocketSessionClosed)
+}
+
+func TestWebsocketSessionWithoutReconnect(t *testing.T) {
+	server := &echoServer{dropConnections: 1}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	s, err := c.DialWebsocketSession(context.Background(), wsURL(ts), WebsocketOptions{})
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.WriteMessage(websocket.TextMessage, []byte("one")))
+	_, _, err = s.ReadMessage()
+	require.NoError(t, err)
+	_, _, err = s.ReadMessage()
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), server.connections.Load())
+}
+
+func TestWebsocketNetConn(t *testing.T) {
+	ts := httptest.NewServer(&echoServer{})
+	defer ts.Close()
+	c, err := NewClient(WithBaseURL(ts.URL), WithAuthNone())
+	require.NoError(t, err)
+
+	s, err := c.DialWebsocketSession(context.Background(), wsURL(ts), WebsocketOptions{})
+	require.NoError(t, err)
+	conn := s.NetConn(websocket.BinaryMessage)
+	defer conn.Close()
+
+	_, err = io.WriteString(conn, "hello ")
+	require.NoError(t, err)
+	_, err = io.WriteString(conn, "world")
+	require.NoError(t, err)
+	buf := make([]byte, len("hello world"))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(buf))
+	assert.NotNil(t, conn.RemoteAddr())
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(20*time.Millisecond)))
+	_, err = conn.Read(buf)
+	assert.Error(t, err, "nothing to read before the deadline")
+}
+
+func TestWebsocketURL(t *testing.T) {
+	tests := []struct {
+		raw, token, want string
+		wantErr          bool
+	}{
+		{raw: "https://api.example.com/v1/console", want: "wss://api.example.com/v1/console"},
+		{raw: "http://localhost/ws?a=1", token: "t k", want: "ws://localhost/ws?a=1&token=t+k"},
+		{raw: "wss://api.example.com/ws", token: "x", want: "wss://api.example.com/ws?token=x"},
+		{raw: "ftp://example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			u, err := websocketURL(tt.raw, tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.String())
+		})
+	}
+}
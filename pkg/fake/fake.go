@@ -0,0 +1,70 @@
+// Package fake provides the call recording shared by the fakes of the service packages.
+//
+// Every service package has a Fake type implementing its Interface without any HTTP.
+// A Fake records each call and returns the response programmed through its
+// <Method>Func fields:
+//
+//	f := &iaas.Fake{}
+//	f.GetVpcFunc = func(ctx context.Context, identity string) (*iaas.Vpc, error) {
+//		return &iaas.Vpc{Identity: identity, Status: "ready"}, nil
+//	}
+//	controller := NewController(f)
+//	...
+//	calls := f.CallsTo("GetVpc")
+package fake
+
+import "sync"
+
+// Call is a single recorded method call.
+type Call struct {
+	// Method is the name of the called method.
+	Method string
+	// Args are the arguments of the call, in order. Variadic arguments are recorded as a single slice.
+	Args []any
+}
+
+// Recorder records method calls. It is safe for concurrent use and is embedded by
+// the fakes of the service packages.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record records a call of method with the given arguments.
+func (r *Recorder) Record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all recorded calls in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of method in the order they were made.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of recorded calls of method.
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset forgets all recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
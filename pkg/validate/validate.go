@@ -0,0 +1,215 @@
+// Package validate collects field errors for the Validate methods of request types.
+//
+//	func (r CreateVpc) Validate() error {
+//		var f validate.Fields
+//		f.Required("name", r.Name)
+//		for i, cidr := range r.VpcCidrs {
+//			f.CIDR(validate.Index("vpcCidrs", i), cidr)
+//		}
+//		return f.Err()
+//	}
+//
+// Fields are named by their JSON names, so errors read like the field errors returned
+// by the API.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// Fields collects field errors. The zero value is ready to use.
+type Fields struct {
+	errs []client.FieldError
+}
+
+// Add records an error for field.
+func (f *Fields) Add(field, format string, args ...any) {
+	f.errs = append(f.errs, client.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Check records message for field unless ok.
+func (f *Fields) Check(ok bool, field, message string) {
+	if !ok {
+		f.Add(field, "%s", message)
+	}
+}
+
+// Required checks that value is not blank.
+func (f *Fields) Required(field, value string) {
+	f.Check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+// MaxLength checks that value has at most n characters.
+func (f *Fields) MaxLength(field, value string, n int) {
+	if len([]rune(value)) > n {
+		f.Add(field, "must be at most %d characters", n)
+	}
+}
+
+// ASCII checks that value only contains ASCII characters.
+func (f *Fields) ASCII(field, value string) {
+	for _, r := range value {
+		if r > 127 {
+			f.Add(field, "must only contain ASCII characters")
+			return
+		}
+	}
+}
+
+// CIDR checks that a non-empty value is a CIDR block.
+func (f *Fields) CIDR(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := netip.ParsePrefix(value); err != nil {
+		f.Add(field, "must be a valid CIDR block, got %q", value)
+	}
+}
+
+// IP checks that a non-empty value is an IP address.
+func (f *Fields) IP(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := netip.ParseAddr(value); err != nil {
+		f.Add(field, "must be a valid IP address, got %q", value)
+	}
+}
+
+// IPOrCIDR checks that a non-empty value is an IP address or a CIDR block.
+func (f *Fields) IPOrCIDR(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := netip.ParseAddr(value); err == nil {
+		return
+	}
+	if _, err := netip.ParsePrefix(value); err != nil {
+		f.Add(field, "must be a valid IP address or CIDR block, got %q", value)
+	}
+}
+
+// Hostname checks that a non-empty value is a DNS name, optionally fully qualified.
+func (f *Fields) Hostname(field, value string) {
+	if value == "" {
+		return
+	}
+	name := strings.TrimSuffix(value, ".")
+	if _, err := netip.ParseAddr(name); err == nil || len(name) > 253 {
+		f.Add(field, "must be a valid DNS name, got %q", value)
+		return
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabel.MatchString(label) {
+			f.Add(field, "must be a valid DNS name, got %q", value)
+			return
+		}
+	}
+}
+
+var (
+	dnsLabel      = regexp.MustCompile(`^[A-Za-z0-9_]([-A-Za-z0-9_]{0,61}[A-Za-z0-9])?$`)
+	qualifiedName = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	dnsSubdomain  = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// LabelKey checks that key is a Kubernetes label key: a name of at most 63
+// characters, optionally prefixed by a DNS subdomain and a slash.
+func (f *Fields) LabelKey(field, key string) {
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		if len(prefix) > 253 || !dnsSubdomain.MatchString(prefix) {
+			f.Add(field, "key %q must have a DNS subdomain as prefix", key)
+			return
+		}
+		name = rest
+	}
+	if !qualifiedName.MatchString(name) {
+		f.Add(field, "key %q must be at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", key)
+	}
+}
+
+// LabelValue checks that value is empty or a Kubernetes label value.
+func (f *Fields) LabelValue(field, value string) {
+	if value != "" && !qualifiedName.MatchString(value) {
+		f.Add(field, "value %q must be at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", value)
+	}
+}
+
+// Labels checks that labels are valid Kubernetes labels.
+func (f *Fields) Labels(field string, labels map[string]string) {
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		f.LabelKey(Key(field, key), key)
+		f.LabelValue(Key(field, key), labels[key])
+	}
+}
+
+// Nested records the errors of a nested value under field. Field errors of a
+// *client.ValidationError are prefixed with field, unless field is empty as for
+// embedded structs; other errors are recorded as is.
+func (f *Fields) Nested(field string, err error) {
+	if err == nil {
+		return
+	}
+	var verr *client.ValidationError
+	if !errors.As(err, &verr) {
+		f.Add(field, "%v", err)
+		return
+	}
+	for _, fe := range verr.FieldErrors {
+		if field != "" {
+			fe.Field = field + "." + fe.Field
+		}
+		f.errs = append(f.errs, fe)
+	}
+}
+
+// Err returns a *client.ValidationError listing the recorded errors, or nil.
+func (f *Fields) Err() error {
+	if len(f.errs) == 0 {
+		return nil
+	}
+	return &client.ValidationError{FieldErrors: f.errs}
+}
+
+// Range checks that value is between lo and hi, inclusive.
+func Range[N ~int | ~int32 | ~int64 | ~uint | ~uint32 | ~uint64 | ~float64](f *Fields, field string, value, lo, hi N) {
+	if value < lo || value > hi {
+		f.Add(field, "must be between %v and %v, got %v", lo, hi, value)
+	}
+}
+
+// OneOf checks that a non-empty value is one of allowed.
+func OneOf[S ~string](f *Fields, field string, value S, allowed ...S) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	quoted := make([]string, len(allowed))
+	for i, a := range allowed {
+		quoted[i] = fmt.Sprintf("%q", a)
+	}
+	f.Add(field, "must be one of %s, got %q", strings.Join(quoted, ", "), value)
+}
+
+// Index returns the name of the i-th element of a list field, such as "rules[2]".
+func Index(field string, i int) string {
+	return fmt.Sprintf("%s[%d]", field, i)
+}
+
+// Key returns the name of a map entry, such as "labels[team]".
+func Key(field, key string) string {
+	return fmt.Sprintf("%s[%s]", field, key)
+}
@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(f *Fields)
+		want  []client.FieldError
+	}{
+		{
+			name:  "required",
+			check: func(f *Fields) { f.Required("name", " ") },
+			want:  []client.FieldError{{Field: "name", Message: "is required"}},
+		},
+		{
+			name:  "max length counts characters",
+			check: func(f *Fields) { f.MaxLength("name", "ééé", 3); f.MaxLength("description", "abcd", 3) },
+			want:  []client.FieldError{{Field: "description", Message: "must be at most 3 characters"}},
+		},
+		{
+			name:  "ascii",
+			check: func(f *Fields) { f.ASCII("name", "web-01"); f.ASCII("description", "café") },
+			want:  []client.FieldError{{Field: "description", Message: "must only contain ASCII characters"}},
+		},
+		{
+			name: "cidr",
+			check: func(f *Fields) {
+				f.CIDR("a", "10.0.0.0/16")
+				f.CIDR("b", "fd00::/64")
+				f.CIDR("c", "")
+				f.CIDR("d", "10.0.0.1")
+			},
+			want: []client.FieldError{{Field: "d", Message: `must be a valid CIDR block, got "10.0.0.1"`}},
+		},
+		{
+			name: "ip or cidr",
+			check: func(f *Fields) {
+				f.IPOrCIDR("a", "10.0.0.1")
+				f.IPOrCIDR("b", "10.0.0.0/8")
+				f.IPOrCIDR("c", "10.0.0.0/33")
+				f.IP("d", "10.0.0.0/8")
+			},
+			want: []client.FieldError{
+				{Field: "c", Message: `must be a valid IP address or CIDR block, got "10.0.0.0/33"`},
+				{Field: "d", Message: `must be a valid IP address, got "10.0.0.0/8"`},
+			},
+		},
+		{
+			name: "hostname",
+			check: func(f *Fields) {
+				f.Hostname("a", "example.com.")
+				f.Hostname("b", "_acme-challenge.example.com")
+				f.Hostname("c", "-bad.example.com")
+				f.Hostname("d", "10.0.0.1")
+			},
+			want: []client.FieldError{
+				{Field: "c", Message: `must be a valid DNS name, got "-bad.example.com"`},
+				{Field: "d", Message: `must be a valid DNS name, got "10.0.0.1"`},
+			},
+		},
+		{
+			name: "labels",
+			check: func(f *Fields) {
+				f.Labels("labels", map[string]string{
+					"team":                     "platform",
+					"node.thalassa.cloud/pool": "",
+					"Bad_Prefix/name":          "x",
+					"app":                      "-invalid",
+					"thalassa.cloud/" + strings.Repeat("a", 64): "x",
+				})
+			},
+			want: []client.FieldError{
+				{Field: "labels[Bad_Prefix/name]", Message: `key "Bad_Prefix/name" must have a DNS subdomain as prefix`},
+				{Field: "labels[app]", Message: `value "-invalid" must be at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character`},
+				{Field: "labels[thalassa.cloud/" + strings.Repeat("a", 64) + "]", Message: `key "thalassa.cloud/` + strings.Repeat("a", 64) + `" must be at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character`},
+			},
+		},
+		{
+			name: "range and one of",
+			check: func(f *Fields) {
+				Range(f, "priority", int32(200), 1, 199)
+				Range(f, "port", 443, 1, 65535)
+				OneOf(f, "policy", "allow", "allow", "drop")
+				OneOf(f, "protocol", "sctp", "tcp", "udp")
+				OneOf(f, "state", "", "running")
+			},
+			want: []client.FieldError{
+				{Field: "priority", Message: "must be between 1 and 199, got 200"},
+				{Field: "protocol", Message: `must be one of "tcp", "udp", got "sctp"`},
+			},
+		},
+		{
+			name: "nested",
+			check: func(f *Fields) {
+				var rule Fields
+				rule.Required("protocol", "")
+				f.Nested(Index("rules", 2), rule.Err())
+				f.Nested("", rule.Err())
+				f.Nested("target", errors.New("unsupported target"))
+				f.Nested("ok", nil)
+			},
+			want: []client.FieldError{
+				{Field: "rules[2].protocol", Message: "is required"},
+				{Field: "protocol", Message: "is required"},
+				{Field: "target", Message: "unsupported target"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f Fields
+			tt.check(&f)
+			err := f.Err()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.FieldErrors)
+		})
+	}
+}
+
+func TestFieldsErr(t *testing.T) {
+	var f Fields
+	assert.NoError(t, f.Err())
+	f.Required("name", "")
+	f.Add("size", "must be at least %d", 10)
+	err := f.Err()
+	assert.ErrorIs(t, err, client.ErrInvalidRequest)
+	assert.EqualError(t, err, "invalid request: name: is required; size: must be at least 10")
+}
@@ -0,0 +1,315 @@
+// Package wait polls resources until they reach a desired state.
+//
+// A Waiter polls a getter with an exponentially growing interval until a success
+// predicate holds, a failure predicate reports an error, or the context is done. The
+// WaitUntil* helpers of the service packages are built on it, and accept Options to
+// tune the polling:
+//
+//	err := c.IaaS().WaitUntilVpcIsReady(ctx, vpc.Identity,
+//		wait.WithInterval(2*time.Second, 20*time.Second),
+//		wait.WithStatusChange(func(from, to string) { log.Printf("vpc: %s -> %s", from, to) }))
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+const (
+	DefaultInitialInterval = 1 * time.Second
+	DefaultMaxInterval     = 30 * time.Second
+	DefaultMultiplier      = 1.5
+)
+
+var (
+	// ErrFailed is matched by errors for resources that reached a failed status.
+	ErrFailed = errors.New("failed")
+	// ErrNotDeleting is matched by errors for resources that are waited on for deletion
+	// but are not being deleted.
+	ErrNotDeleting = errors.New("not being deleted")
+)
+
+// Progress describes a poll of a Waiter.
+type Progress struct {
+	// Attempt is the one-based number of the poll.
+	Attempt int
+	// Elapsed is the time since the wait started.
+	Elapsed time.Duration
+	// Status is the status of the resource, if the waiter knows how to read it.
+	Status string
+	// PreviousStatus is the status at the previous poll.
+	PreviousStatus string
+	// Err is set when the poll failed but the wait continues, e.g. after a poll timeout.
+	Err error
+	// NextPoll is the time until the next poll.
+	NextPoll time.Duration
+}
+
+// StatusChanged reports whether the status differs from the previous poll.
+func (p Progress) StatusChanged() bool {
+	return p.Attempt > 1 && p.Status != p.PreviousStatus
+}
+
+// Options tune how a Waiter polls.
+type Options struct {
+	// InitialInterval is the time between the first and second poll.
+	InitialInterval time.Duration
+	// MaxInterval caps the time between polls.
+	MaxInterval time.Duration
+	// Multiplier grows the interval after every poll.
+	Multiplier float64
+	// PollTimeout bounds every poll. A poll that times out is retried.
+	PollTimeout time.Duration
+	// NotFoundIsDone ends the wait successfully when the resource is not found.
+	NotFoundIsDone bool
+	// OnProgress is called after every poll.
+	OnProgress func(Progress)
+}
+
+// Option modifies Options.
+type Option func(*Options)
+
+// WithInterval sets the initial and maximum interval between polls.
+func WithInterval(initial, maxInterval time.Duration) Option {
+	return func(o *Options) {
+		o.InitialInterval = initial
+		o.MaxInterval = maxInterval
+	}
+}
+
+// WithMultiplier sets the factor by which the interval grows after every poll. A
+// multiplier of 1 polls at a fixed interval.
+func WithMultiplier(multiplier float64) Option {
+	return func(o *Options) {
+		o.Multiplier = multiplier
+	}
+}
+
+// WithPollTimeout bounds every poll by d.
+func WithPollTimeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.PollTimeout = d
+	}
+}
+
+// WithNotFoundIsDone ends the wait successfully when the resource is not found.
+func WithNotFoundIsDone() Option {
+	return func(o *Options) {
+		o.NotFoundIsDone = true
+	}
+}
+
+// WithProgress calls fn after every poll.
+func WithProgress(fn func(Progress)) Option {
+	return func(o *Options) {
+		o.OnProgress = chain(o.OnProgress, fn)
+	}
+}
+
+// WithStatusChange calls fn whenever the status of the resource changes between polls.
+func WithStatusChange(fn func(from, to string)) Option {
+	return WithProgress(func(p Progress) {
+		if p.StatusChanged() {
+			fn(p.PreviousStatus, p.Status)
+		}
+	})
+}
+
+func chain(first, second func(Progress)) func(Progress) {
+	if first == nil {
+		return second
+	}
+	return func(p Progress) {
+		first(p)
+		second(p)
+	}
+}
+
+// Waiter polls a resource of type T until it is ready.
+type Waiter[T any] struct {
+	// Get fetches the resource.
+	Get func(ctx context.Context) (T, error)
+	// Ready reports whether the resource reached the desired state.
+	Ready func(T) bool
+	// Failed, if set, returns an error when the resource can no longer become ready.
+	Failed func(T) error
+	// Status, if set, returns the status of the resource for progress callbacks and errors.
+	Status func(T) string
+	// Description, such as "VPC vpc-123", prefixes errors returned by Failed and
+	// timeouts.
+	Description string
+	Options
+}
+
+// Wait polls until the resource is ready and returns it. It returns the error of Get
+// or Failed, or the context's error wrapped with the last status. opts are applied on
+// top of w.Options.
+func (w *Waiter[T]) Wait(ctx context.Context, opts ...Option) (T, error) {
+	o := w.Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	interval := o.InitialInterval
+	if interval <= 0 {
+		interval = DefaultInitialInterval
+	}
+	maxInterval := o.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = max(DefaultMaxInterval, interval)
+	}
+	multiplier := o.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultMultiplier
+	}
+
+	var zero T
+	start := time.Now()
+	status := ""
+	for attempt := 1; ; attempt++ {
+		obj, err := w.poll(ctx, o.PollTimeout)
+		progress := Progress{Attempt: attempt, Elapsed: time.Since(start), PreviousStatus: status, NextPoll: interval}
+		switch {
+		case err == nil:
+			if w.Status != nil {
+				status = w.Status(obj)
+			}
+			progress.Status = status
+			done, failErr := w.check(obj)
+			if done || failErr != nil {
+				progress.NextPoll = 0
+			}
+			notify(o.OnProgress, progress)
+			if failErr != nil {
+				return obj, w.describe(failErr)
+			}
+			if done {
+				return obj, nil
+			}
+		case o.NotFoundIsDone && client.IsNotFound(err):
+			progress.Status, progress.NextPoll = status, 0
+			notify(o.OnProgress, progress)
+			return zero, nil
+		case ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) && o.PollTimeout > 0:
+			// Only this poll timed out; try again.
+			progress.Status, progress.Err = status, err
+			notify(o.OnProgress, progress)
+		default:
+			return zero, err
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			if status != "" {
+				err = fmt.Errorf("%w (last status: %s)", err, status)
+			}
+			return zero, w.describe(err)
+		}
+		interval = min(time.Duration(float64(interval)*multiplier), maxInterval)
+	}
+}
+
+func (w *Waiter[T]) poll(ctx context.Context, timeout time.Duration) (T, error) {
+	if timeout <= 0 {
+		return w.Get(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return w.Get(ctx)
+}
+
+func (w *Waiter[T]) check(obj T) (bool, error) {
+	if w.Ready != nil && w.Ready(obj) {
+		return true, nil
+	}
+	if w.Failed != nil {
+		if err := w.Failed(obj); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func (w *Waiter[T]) describe(err error) error {
+	if w.Description == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", w.Description, err)
+}
+
+func notify(fn func(Progress), p Progress) {
+	if fn != nil {
+		fn(p)
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// UntilStatus returns a waiter that polls get until status returns want, compared
+// case-insensitively. It fails with ErrFailed when the status becomes one of failed.
+func UntilStatus[T any](description string, get func(ctx context.Context) (T, error), status func(T) string, want string, failed ...string) *Waiter[T] {
+	return &Waiter[T]{
+		Get:         get,
+		Status:      status,
+		Description: description,
+		Ready: func(obj T) bool {
+			return strings.EqualFold(status(obj), want)
+		},
+		Failed: func(obj T) error {
+			if s := status(obj); containsFold(failed, s) {
+				return fmt.Errorf("%w with status %s", ErrFailed, s)
+			}
+			return nil
+		},
+	}
+}
+
+// UntilDeleted returns a waiter that polls get until the resource is not found or its
+// status is deleted. At the first poll, its status must be deleting, or the wait fails
+// with ErrNotDeleting; the wait should therefore start after the deletion was accepted.
+// Other statuses at later polls are tolerated. A status in failed fails the wait with
+// ErrFailed.
+func UntilDeleted[T any](description string, get func(ctx context.Context) (T, error), status func(T) string, deleting, deleted string, failed ...string) *Waiter[T] {
+	var observed atomic.Bool
+	return &Waiter[T]{
+		Get:         get,
+		Status:      status,
+		Description: description,
+		Ready: func(obj T) bool {
+			return strings.EqualFold(status(obj), deleted)
+		},
+		Failed: func(obj T) error {
+			s := status(obj)
+			if containsFold(failed, s) {
+				return fmt.Errorf("%w with status %s", ErrFailed, s)
+			}
+			if !observed.Swap(true) && !strings.EqualFold(s, deleting) {
+				return fmt.Errorf("%w (status: %s)", ErrNotDeleting, s)
+			}
+			return nil
+		},
+		Options: Options{NotFoundIsDone: true},
+	}
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+type resource struct {
+	Status string
+}
+
+// sequence returns a getter that returns the statuses in order, repeating the last one.
+// An empty status returns client.ErrNotFound.
+func sequence(statuses ...string) (func(context.Context) (*resource, error), *int) {
+	calls := 0
+	return func(ctx context.Context) (*resource, error) {
+		s := statuses[min(calls, len(statuses)-1)]
+		calls++
+		if s == "" {
+			return nil, fmt.Errorf("get resource: %w", client.ErrNotFound)
+		}
+		return &resource{Status: s}, nil
+	}, &calls
+}
+
+func status(r *resource) string {
+	return r.Status
+}
+
+var fast = WithInterval(time.Millisecond, 4*time.Millisecond)
+
+func TestUntilStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []string
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "ready right away", statuses: []string{"Ready"}, wantCalls: 1},
+		{name: "becomes ready", statuses: []string{"creating", "creating", "ready"}, wantCalls: 3},
+		{name: "fails", statuses: []string{"creating", "failed"}, wantErr: ErrFailed, wantCalls: 2},
+		{name: "not found", statuses: []string{""}, wantErr: client.ErrNotFound, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			get, calls := sequence(tt.statuses...)
+			r, err := UntilStatus("resource r-1", get, status, "ready", "failed").Wait(context.Background(), fast)
+			assert.Equal(t, tt.wantCalls, *calls)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.statuses[len(tt.statuses)-1], r.Status)
+		})
+	}
+}
+
+func TestUntilDeleted(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		wantErr  error
+	}{
+		{name: "not found", statuses: []string{""}},
+		{name: "deleted", statuses: []string{"deleting", "deleted"}},
+		{name: "disappears", statuses: []string{"deleting", "deleting", ""}},
+		{name: "not deleting", statuses: []string{"ready"}, wantErr: ErrNotDeleting},
+		{name: "transient status", statuses: []string{"deleting", "updating", "deleting", "deleted"}},
+		{name: "fails", statuses: []string{"deleting", "failed"}, wantErr: ErrFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			get, _ := sequence(tt.statuses...)
+			_, err := UntilDeleted("resource r-1", get, status, "deleting", "deleted", "failed").Wait(context.Background(), fast)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, "resource r-1: ")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWaitBackoff(t *testing.T) {
+	get, _ := sequence("a", "b", "c", "d", "e", "ready")
+	var intervals []time.Duration
+	w := UntilStatus("", get, status, "ready")
+	_, err := w.Wait(context.Background(), WithInterval(time.Millisecond, 5*time.Millisecond), WithMultiplier(2),
+		WithProgress(func(p Progress) { intervals = append(intervals, p.NextPoll) }))
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{
+		time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 5 * time.Millisecond, 5 * time.Millisecond, 0,
+	}, intervals)
+}
+
+func TestWaitProgress(t *testing.T) {
+	get, _ := sequence("pending", "pending", "creating", "ready")
+	var changes []string
+	var attempts []int
+	_, err := UntilStatus("", get, status, "ready").Wait(context.Background(), fast,
+		WithStatusChange(func(from, to string) { changes = append(changes, from+" -> "+to) }),
+		WithProgress(func(p Progress) { attempts = append(attempts, p.Attempt) }))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pending -> creating", "creating -> ready"}, changes)
+	assert.Equal(t, []int{1, 2, 3, 4}, attempts)
+}
+
+func TestWaitContext(t *testing.T) {
+	get, _ := sequence("creating")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := UntilStatus("resource r-1", get, status, "ready").Wait(ctx, fast)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "resource r-1: context deadline exceeded (last status: creating)")
+}
+
+func TestWaitPollTimeout(t *testing.T) {
+	calls := 0
+	w := &Waiter[*resource]{
+		Get: func(ctx context.Context) (*resource, error) {
+			calls++
+			if calls == 1 {
+				// The first poll hangs until its deadline.
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return &resource{Status: "ready"}, nil
+		},
+		Ready: func(r *resource) bool { return r.Status == "ready" },
+	}
+	var pollErrs []error
+	_, err := w.Wait(context.Background(), fast, WithPollTimeout(10*time.Millisecond),
+		WithProgress(func(p Progress) { pollErrs = append(pollErrs, p.Err) }))
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	require.Len(t, pollErrs, 2)
+	assert.ErrorIs(t, pollErrs[0], context.DeadlineExceeded)
+	assert.NoError(t, pollErrs[1])
+
+	// Without a poll timeout, errors of the getter end the wait.
+	getErr := errors.New("boom")
+	w.Get = func(ctx context.Context) (*resource, error) { return nil, getErr }
+	_, err = w.Wait(context.Background(), fast)
+	assert.ErrorIs(t, err, getErr)
+}
+
+func TestWaitNotFoundIsDone(t *testing.T) {
+	get, _ := sequence("ready", "")
+	w := &Waiter[*resource]{Get: get, Ready: func(*resource) bool { return false }}
+	_, err := w.Wait(context.Background(), fast, WithNotFoundIsDone())
+	assert.NoError(t, err)
+}
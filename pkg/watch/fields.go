@@ -0,0 +1,84 @@
+package watch
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Identity returns the Identity field of obj, or an empty string if it has none.
+func Identity[T any](obj T) string {
+	if f, ok := field(obj, "Identity"); ok && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// Version returns the ObjectVersion and UpdatedAt fields of obj as a string that
+// changes whenever either of them changes.
+func Version[T any](obj T) string {
+	version := ""
+	if f, ok := field(obj, "ObjectVersion"); ok {
+		switch f.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			version = strconv.FormatInt(f.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			version = strconv.FormatUint(f.Uint(), 10)
+		}
+	}
+	if f, ok := field(obj, "UpdatedAt"); ok {
+		if f.Kind() == reflect.Pointer {
+			if f.IsNil() {
+				return version + "/"
+			}
+			f = f.Elem()
+		}
+		if t, ok := f.Interface().(time.Time); ok {
+			version += "/" + t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return version
+}
+
+// Labels returns the Labels field of obj, or nil if it has none.
+func Labels[T any](obj T) map[string]string {
+	f, ok := field(obj, "Labels")
+	if !ok || f.Kind() != reflect.Map || f.Type().Key().Kind() != reflect.String || f.Type().Elem().Kind() != reflect.String {
+		return nil
+	}
+	labels := make(map[string]string, f.Len())
+	iter := f.MapRange()
+	for iter.Next() {
+		labels[iter.Key().String()] = iter.Value().String()
+	}
+	return labels
+}
+
+// field returns the exported field name of obj, which is a struct or a pointer to one.
+func field(obj any, name string) (reflect.Value, bool) {
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	f := v.FieldByName(name)
+	return f, f.IsValid()
+}
+
+// hasField reports whether T, or the type it points to, is a struct with the field name.
+func hasField[T any](name string) bool {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := t.FieldByName(name)
+	return ok
+}
@@ -0,0 +1,267 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// IndexFunc returns the values under which an object is indexed.
+type IndexFunc[T any] func(T) []string
+
+// LabelIndex indexes objects by the value of their label key. Objects without the label
+// are not indexed.
+func LabelIndex[T any](key string) IndexFunc[T] {
+	return func(obj T) []string {
+		if value, ok := Labels(obj)[key]; ok {
+			return []string{value}
+		}
+		return nil
+	}
+}
+
+// LabelsIndex indexes objects by each of their labels as "key=value".
+func LabelsIndex[T any]() IndexFunc[T] {
+	return func(obj T) []string {
+		labels := Labels(obj)
+		values := make([]string, 0, len(labels))
+		for k, v := range labels {
+			values = append(values, k+"="+v)
+		}
+		return values
+	}
+}
+
+// Handler receives the changes observed by an Informer. Unset functions are skipped.
+type Handler[T any] struct {
+	OnAdd    func(obj T)
+	OnUpdate func(old, new T)
+	OnDelete func(obj T)
+}
+
+// InformerOptions configure an Informer.
+type InformerOptions[T any] struct {
+	Options[T]
+	// ResyncPeriod, if set, redelivers every cached object to the handlers as an update
+	// with identical old and new objects, so consumers can periodically reconcile.
+	ResyncPeriod time.Duration
+	// Indexers maps index names to index functions, for use with ByIndex.
+	Indexers map[string]IndexFunc[T]
+}
+
+// Informer polls a list function and keeps the results in a thread-safe cache. Changes
+// are delivered to the handlers from the polling goroutine, one at a time and in order;
+// handlers that block delay the next poll.
+type Informer[T any] struct {
+	poller   *poller[T]
+	resync   time.Duration
+	indexers map[string]IndexFunc[T]
+
+	mu      sync.RWMutex
+	items   map[string]T
+	indices map[string]map[string]map[string]struct{}
+	synced  bool
+	syncCh  chan struct{}
+
+	handlersMu sync.Mutex
+	handlers   []Handler[T]
+}
+
+// NewInformer returns an informer for list. Call Run to start it.
+func NewInformer[T any](list ListFunc[T], opts InformerOptions[T]) (*Informer[T], error) {
+	p, err := newPoller(list, opts.Options)
+	if err != nil {
+		return nil, err
+	}
+	inf := &Informer[T]{
+		poller:   p,
+		resync:   opts.ResyncPeriod,
+		indexers: opts.Indexers,
+		items:    map[string]T{},
+		indices:  map[string]map[string]map[string]struct{}{},
+		syncCh:   make(chan struct{}),
+	}
+	for name := range opts.Indexers {
+		inf.indices[name] = map[string]map[string]struct{}{}
+	}
+	return inf, nil
+}
+
+// AddHandler registers h. Objects already in the cache are delivered to h as additions.
+func (inf *Informer[T]) AddHandler(h Handler[T]) {
+	inf.handlersMu.Lock()
+	defer inf.handlersMu.Unlock()
+	inf.handlers = append(inf.handlers, h)
+	if h.OnAdd != nil {
+		for _, obj := range inf.List() {
+			h.OnAdd(obj)
+		}
+	}
+}
+
+// Run polls until ctx is done.
+func (inf *Informer[T]) Run(ctx context.Context) {
+	ticker := time.NewTicker(inf.poller.opts.Interval)
+	defer ticker.Stop()
+	var resync <-chan time.Time
+	if inf.resync > 0 {
+		t := time.NewTicker(inf.resync)
+		defer t.Stop()
+		resync = t.C
+	}
+	inf.poll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			inf.poll(ctx)
+		case <-resync:
+			inf.resyncAll()
+		}
+	}
+}
+
+func (inf *Informer[T]) poll(ctx context.Context) {
+	events, err := inf.poller.poll(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			inf.poller.reportError(err)
+		}
+		return
+	}
+	// Hold the handlers while the cache is updated, so that handlers added meanwhile
+	// see each change exactly once.
+	inf.handlersMu.Lock()
+	defer inf.handlersMu.Unlock()
+	inf.mu.Lock()
+	for _, event := range events {
+		if event.Type == Deleted {
+			inf.unindex(event.Key, event.Object)
+			delete(inf.items, event.Key)
+			continue
+		}
+		if event.Type == Modified {
+			inf.unindex(event.Key, event.Old)
+		}
+		inf.items[event.Key] = event.Object
+		inf.index(event.Key, event.Object)
+	}
+	if !inf.synced {
+		inf.synced = true
+		close(inf.syncCh)
+	}
+	inf.mu.Unlock()
+
+	for _, event := range events {
+		for _, h := range inf.handlers {
+			switch {
+			case event.Type == Added && h.OnAdd != nil:
+				h.OnAdd(event.Object)
+			case event.Type == Modified && h.OnUpdate != nil:
+				h.OnUpdate(event.Old, event.Object)
+			case event.Type == Deleted && h.OnDelete != nil:
+				h.OnDelete(event.Object)
+			}
+		}
+	}
+}
+
+func (inf *Informer[T]) resyncAll() {
+	inf.handlersMu.Lock()
+	defer inf.handlersMu.Unlock()
+	for _, obj := range inf.List() {
+		for _, h := range inf.handlers {
+			if h.OnUpdate != nil {
+				h.OnUpdate(obj, obj)
+			}
+		}
+	}
+}
+
+func (inf *Informer[T]) index(key string, obj T) {
+	for name, fn := range inf.indexers {
+		for _, value := range fn(obj) {
+			keys := inf.indices[name][value]
+			if keys == nil {
+				keys = map[string]struct{}{}
+				inf.indices[name][value] = keys
+			}
+			keys[key] = struct{}{}
+		}
+	}
+}
+
+func (inf *Informer[T]) unindex(key string, obj T) {
+	for name, fn := range inf.indexers {
+		for _, value := range fn(obj) {
+			delete(inf.indices[name][value], key)
+			if len(inf.indices[name][value]) == 0 {
+				delete(inf.indices[name], value)
+			}
+		}
+	}
+}
+
+// HasSynced reports whether the first poll has completed.
+func (inf *Informer[T]) HasSynced() bool {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	return inf.synced
+}
+
+// WaitForSync blocks until the first poll has completed or ctx is done.
+func (inf *Informer[T]) WaitForSync(ctx context.Context) error {
+	select {
+	case <-inf.syncCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Get returns the cached object with key.
+func (inf *Informer[T]) Get(key string) (T, bool) {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	obj, ok := inf.items[key]
+	return obj, ok
+}
+
+// List returns all cached objects ordered by key.
+func (inf *Informer[T]) List() []T {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	keys := make([]string, 0, len(inf.items))
+	for key := range inf.items {
+		keys = append(keys, key)
+	}
+	return inf.byKeys(keys)
+}
+
+// ByIndex returns the cached objects indexed under value by the indexer name, ordered
+// by key.
+func (inf *Informer[T]) ByIndex(name, value string) ([]T, error) {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	index, ok := inf.indices[name]
+	if !ok {
+		return nil, fmt.Errorf("watch: index %q does not exist", name)
+	}
+	keys := make([]string, 0, len(index[value]))
+	for key := range index[value] {
+		keys = append(keys, key)
+	}
+	return inf.byKeys(keys), nil
+}
+
+func (inf *Informer[T]) byKeys(keys []string) []T {
+	sort.Strings(keys)
+	objs := make([]T, 0, len(keys))
+	for _, key := range keys {
+		objs = append(objs, inf.items[key])
+	}
+	return objs
+}
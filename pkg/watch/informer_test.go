@@ -0,0 +1,151 @@
+package watch
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+	"github.com/thalassa-cloud/client-go/thalassatest"
+)
+
+// recorder records the calls of a Handler.
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (r *recorder) handler() Handler[object] {
+	record := func(call string) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.calls = append(r.calls, call)
+	}
+	return Handler[object]{
+		OnAdd:    func(obj object) { record("add " + obj.Identity) },
+		OnUpdate: func(old, new object) { record("update " + new.Identity) },
+		OnDelete: func(obj object) { record("delete " + obj.Identity) },
+	}
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.calls...)
+}
+
+func TestInformer(t *testing.T) {
+	f := &fakeList{items: []object{
+		{Identity: "a", ObjectVersion: 1, Labels: map[string]string{"env": "prod"}},
+		{Identity: "b", ObjectVersion: 1, Labels: map[string]string{"env": "dev"}},
+	}}
+	inf, err := NewInformer(f.list, InformerOptions[object]{
+		Options:  Options[object]{Interval: time.Millisecond},
+		Indexers: map[string]IndexFunc[object]{"env": LabelIndex[object]("env"), "labels": LabelsIndex[object]()},
+	})
+	require.NoError(t, err)
+	early := &recorder{}
+	inf.AddHandler(early.handler())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inf.Run(ctx)
+	require.NoError(t, inf.WaitForSync(ctx))
+	assert.True(t, inf.HasSynced())
+
+	a, ok := inf.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, a.ObjectVersion)
+	prod, err := inf.ByIndex("env", "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []object{a}, prod)
+	_, err = inf.ByIndex("missing", "x")
+	assert.Error(t, err)
+
+	// A handler added after the sync receives the cached objects.
+	late := &recorder{}
+	inf.AddHandler(late.handler())
+	assert.Equal(t, []string{"add a", "add b"}, late.get())
+
+	f.set([]object{{Identity: "b", ObjectVersion: 2, Labels: map[string]string{"env": "prod"}}}, nil)
+	want := []string{"add a", "add b", "update b", "delete a"}
+	assert.Eventually(t, func() bool { return len(early.get()) == len(want) }, time.Second, time.Millisecond)
+	assert.Equal(t, want, early.get())
+	assert.Equal(t, want, late.get())
+
+	prod, err = inf.ByIndex("env", "prod")
+	require.NoError(t, err)
+	require.Len(t, prod, 1)
+	assert.Equal(t, "b", prod[0].Identity)
+	dev, err := inf.ByIndex("labels", "env=dev")
+	require.NoError(t, err)
+	assert.Empty(t, dev)
+	assert.Len(t, inf.List(), 1)
+}
+
+func TestInformerResync(t *testing.T) {
+	f := &fakeList{items: []object{{Identity: "a"}}}
+	inf, err := NewInformer(f.list, InformerOptions[object]{
+		Options:      Options[object]{Interval: time.Hour},
+		ResyncPeriod: time.Millisecond,
+	})
+	require.NoError(t, err)
+	r := &recorder{}
+	inf.AddHandler(r.handler())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inf.Run(ctx)
+	assert.Eventually(t, func() bool { return len(r.get()) >= 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"add a", "update a", "update a"}, r.get()[:3])
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	assert.Equal(t, 1, f.calls, "resyncs do not poll")
+}
+
+func TestInformerWithFakeAPI(t *testing.T) {
+	server := thalassatest.NewServer()
+	defer server.Close()
+	c, err := thalassa.NewClient(server.ClientOptions()...)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "vpc", VpcCidrs: []string{"10.0.0.0/16"}, Labels: iaas.Labels{"team": "platform"}})
+	require.NoError(t, err)
+
+	inf, err := NewInformer(func(ctx context.Context) ([]iaas.Vpc, error) {
+		return c.IaaS().ListVpcs(ctx, nil)
+	}, InformerOptions[iaas.Vpc]{
+		Options:  Options[iaas.Vpc]{Interval: 5 * time.Millisecond},
+		Indexers: map[string]IndexFunc[iaas.Vpc]{"team": LabelIndex[iaas.Vpc]("team")},
+	})
+	require.NoError(t, err)
+	updated := make(chan iaas.Vpc, 10)
+	inf.AddHandler(Handler[iaas.Vpc]{OnUpdate: func(old, new iaas.Vpc) { updated <- new }})
+	go inf.Run(ctx)
+	require.NoError(t, inf.WaitForSync(ctx))
+
+	vpcs, err := inf.ByIndex("team", "platform")
+	require.NoError(t, err)
+	require.Len(t, vpcs, 1)
+	assert.Equal(t, vpc.Identity, vpcs[0].Identity)
+
+	_, err = c.IaaS().UpdateVpc(ctx, vpc.Identity, iaas.UpdateVpc{Name: "renamed", Labels: iaas.Labels{"team": "platform"}})
+	require.NoError(t, err)
+	for {
+		select {
+		case vpc := <-updated:
+			if vpc.Name == "renamed" {
+				return
+			}
+		case <-ctx.Done():
+			t.Fatal("no update observed")
+		}
+	}
+}
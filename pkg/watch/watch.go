@@ -0,0 +1,176 @@
+// Package watch turns list functions into streams of change events.
+//
+// The API has no server-side watch, so Watch polls a list function and compares every
+// result with the previous one: objects are keyed by their identity, and changes are
+// detected with their ObjectVersion or UpdatedAt. An Informer keeps the results in a
+// local cache with indexes, so one poll loop per resource type can feed many consumers:
+//
+//	events, err := watch.Watch(ctx, func(ctx context.Context) ([]iaas.Vpc, error) {
+//		return tc.IaaS().ListVpcs(ctx, nil)
+//	}, watch.Options[iaas.Vpc]{Interval: 10 * time.Second})
+//	for event := range events {
+//		log.Printf("%s %s", event.Type, event.Key)
+//	}
+package watch
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// DefaultInterval is the time between polls when Options.Interval is not set.
+const DefaultInterval = 30 * time.Second
+
+// ErrNoKey is returned when the key of an object cannot be determined.
+var ErrNoKey = errors.New("watch: object has no Identity field; set Options.Key")
+
+// EventType describes a change of an object.
+type EventType string
+
+const (
+	Added    EventType = "ADDED"
+	Modified EventType = "MODIFIED"
+	Deleted  EventType = "DELETED"
+)
+
+// Event is a change of an object between two polls.
+type Event[T any] struct {
+	Type EventType
+	// Key is the key of the object, by default its identity.
+	Key string
+	// Object is the object after the change, or its last known state when it was
+	// deleted.
+	Object T
+	// Old is the previous state of a modified object.
+	Old T
+}
+
+// ListFunc lists all objects of a resource type.
+type ListFunc[T any] func(ctx context.Context) ([]T, error)
+
+// Options configure how a list function is polled.
+type Options[T any] struct {
+	// Interval is the time between polls. Defaults to DefaultInterval.
+	Interval time.Duration
+	// Key returns the key of an object. Defaults to its Identity field.
+	Key func(T) string
+	// Version returns a value that changes whenever the object changes. Defaults to
+	// its ObjectVersion and UpdatedAt fields. Objects without either are compared
+	// field by field.
+	Version func(T) string
+	// OnError is called when a poll fails. The watch continues with the next poll and
+	// reports no events for the failed one.
+	OnError func(error)
+}
+
+// poller lists objects and diffs them with the result of the previous poll.
+type poller[T any] struct {
+	list    ListFunc[T]
+	opts    Options[T]
+	known   map[string]T
+	changed func(old, new T) bool
+}
+
+func newPoller[T any](list ListFunc[T], opts Options[T]) (*poller[T], error) {
+	if list == nil {
+		return nil, errors.New("watch: list function cannot be nil")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Key == nil {
+		if !hasField[T]("Identity") {
+			return nil, ErrNoKey
+		}
+		opts.Key = Identity[T]
+	}
+	p := &poller[T]{list: list, opts: opts, known: map[string]T{}}
+	switch {
+	case opts.Version != nil:
+		p.changed = func(old, new T) bool { return opts.Version(old) != opts.Version(new) }
+	case hasField[T]("ObjectVersion") || hasField[T]("UpdatedAt"):
+		p.changed = func(old, new T) bool { return Version(old) != Version(new) }
+	default:
+		p.changed = func(old, new T) bool { return !reflect.DeepEqual(old, new) }
+	}
+	return p, nil
+}
+
+// poll lists the objects and returns the events since the previous poll: additions and
+// modifications in list order, then deletions ordered by key.
+func (p *poller[T]) poll(ctx context.Context) ([]Event[T], error) {
+	items, err := p.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event[T]
+	seen := make(map[string]T, len(items))
+	for _, item := range items {
+		key := p.opts.Key(item)
+		seen[key] = item
+		old, ok := p.known[key]
+		switch {
+		case !ok:
+			events = append(events, Event[T]{Type: Added, Key: key, Object: item})
+		case p.changed(old, item):
+			events = append(events, Event[T]{Type: Modified, Key: key, Object: item, Old: old})
+		}
+	}
+	var deleted []string
+	for key := range p.known {
+		if _, ok := seen[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		events = append(events, Event[T]{Type: Deleted, Key: key, Object: p.known[key]})
+	}
+	p.known = seen
+	return events, nil
+}
+
+func (p *poller[T]) reportError(err error) {
+	if p.opts.OnError != nil {
+		p.opts.OnError(err)
+	}
+}
+
+// Watch polls list and sends an event for every object that was added, modified or
+// deleted since the previous poll. The first poll reports every listed object as added.
+// The channel is closed when ctx is done; events are not buffered, so a consumer that
+// stops reading pauses the polling.
+func Watch[T any](ctx context.Context, list ListFunc[T], opts Options[T]) (<-chan Event[T], error) {
+	p, err := newPoller(list, opts)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan Event[T])
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(p.opts.Interval)
+		defer ticker.Stop()
+		for {
+			events, err := p.poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				p.reportError(err)
+			}
+			for _, event := range events {
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
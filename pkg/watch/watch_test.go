@@ -0,0 +1,164 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+)
+
+type object struct {
+	Identity      string
+	ObjectVersion int
+	Labels        map[string]string
+	Status        string
+}
+
+// fakeList is a list function over a mutable set of objects.
+type fakeList struct {
+	mu    sync.Mutex
+	items []object
+	err   error
+	calls int
+}
+
+func (f *fakeList) list(ctx context.Context) ([]object, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return append([]object(nil), f.items...), nil
+}
+
+func (f *fakeList) set(items []object, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.items, f.err = items, err
+}
+
+func TestPoll(t *testing.T) {
+	f := &fakeList{}
+	p, err := newPoller(f.list, Options[object]{})
+	require.NoError(t, err)
+
+	steps := []struct {
+		name  string
+		items []object
+		want  []Event[object]
+	}{
+		{
+			name:  "initial objects are added",
+			items: []object{{Identity: "a", ObjectVersion: 1}, {Identity: "b", ObjectVersion: 1}},
+			want: []Event[object]{
+				{Type: Added, Key: "a", Object: object{Identity: "a", ObjectVersion: 1}},
+				{Type: Added, Key: "b", Object: object{Identity: "b", ObjectVersion: 1}},
+			},
+		},
+		{
+			name:  "unchanged versions are ignored",
+			items: []object{{Identity: "a", ObjectVersion: 1, Status: "ready"}, {Identity: "b", ObjectVersion: 1}},
+		},
+		{
+			name:  "modified and deleted",
+			items: []object{{Identity: "a", ObjectVersion: 2}, {Identity: "c", ObjectVersion: 1}},
+			want: []Event[object]{
+				{Type: Modified, Key: "a", Object: object{Identity: "a", ObjectVersion: 2}, Old: object{Identity: "a", ObjectVersion: 1, Status: "ready"}},
+				{Type: Added, Key: "c", Object: object{Identity: "c", ObjectVersion: 1}},
+				{Type: Deleted, Key: "b", Object: object{Identity: "b", ObjectVersion: 1}},
+			},
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			f.set(step.items, nil)
+			events, err := p.poll(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, step.want, events)
+		})
+	}
+}
+
+func TestPollWithoutVersion(t *testing.T) {
+	type unversioned struct {
+		Identity string
+		Status   string
+	}
+	items := []unversioned{{Identity: "a", Status: "creating"}}
+	p, err := newPoller(func(ctx context.Context) ([]unversioned, error) { return items, nil }, Options[unversioned]{})
+	require.NoError(t, err)
+	_, err = p.poll(context.Background())
+	require.NoError(t, err)
+
+	items = []unversioned{{Identity: "a", Status: "ready"}}
+	events, err := p.poll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, Modified, events[0].Type, "objects without versions are compared by value")
+}
+
+func TestNewPollerKey(t *testing.T) {
+	list := func(ctx context.Context) ([]string, error) { return nil, nil }
+	_, err := newPoller(list, Options[string]{})
+	assert.ErrorIs(t, err, ErrNoKey)
+	_, err = newPoller(list, Options[string]{Key: func(s string) string { return s }})
+	assert.NoError(t, err)
+}
+
+func TestWatch(t *testing.T) {
+	f := &fakeList{items: []object{{Identity: "a", ObjectVersion: 1}}}
+	var mu sync.Mutex
+	var errs []error
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := Watch(ctx, f.list, Options[object]{
+		Interval: time.Millisecond,
+		OnError: func(err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		},
+	})
+	require.NoError(t, err)
+
+	event := <-events
+	assert.Equal(t, Added, event.Type)
+
+	// A failed poll reports an error and no deletions.
+	listErr := errors.New("unavailable")
+	f.set(nil, listErr)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	}, time.Second, time.Millisecond)
+	f.set([]object{{Identity: "a", ObjectVersion: 2}}, nil)
+
+	event = <-events
+	assert.Equal(t, Modified, event.Type)
+	assert.Equal(t, 2, event.Object.ObjectVersion)
+	assert.ErrorIs(t, errs[0], listErr)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestFields(t *testing.T) {
+	updated := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	vpc := &iaas.Vpc{Identity: "vpc-1", ObjectVersion: 3, UpdatedAt: updated, Labels: iaas.Labels{"team": "platform"}}
+	assert.Equal(t, "vpc-1", Identity(vpc))
+	assert.Equal(t, "3/2026-01-02T03:04:05Z", Version(*vpc))
+	assert.Equal(t, map[string]string{"team": "platform"}, Labels(vpc))
+
+	var missing *iaas.Vpc
+	assert.Empty(t, Identity(missing))
+	assert.Nil(t, Labels("not a struct"))
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
 
+//go:generate go run ../internal/cmd/genservice
+
 // Client represents the Projects API client.
 type Client struct {
 	client.Client
@@ -11,6 +13,8 @@ type Client struct {
 
 // New creates a new Projects client.
 func New(c client.Client, opts ...client.Option) (*Client, error) {
-	c.WithOptions(opts...)
+	if err := client.ApplyOptions(c, opts...); err != nil {
+		return nil, err
+	}
 	return &Client{c}, nil
 }
@@ -3,6 +3,7 @@ package projects
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -39,6 +40,13 @@ func (c *Client) ListProjects(ctx context.Context, request *ListProjectsRequest)
 	return projects, nil
 }
 
+// AllProjects returns an iterator over the results of ListProjects.
+func (c *Client) AllProjects(ctx context.Context, request *ListProjectsRequest) iter.Seq2[Project, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Project, error) {
+		return c.ListProjects(ctx, request)
+	})
+}
+
 // GetProject retrieves a project by ID or slug.
 func (c *Client) GetProject(ctx context.Context, identity string) (*Project, error) {
 	if identity == "" {
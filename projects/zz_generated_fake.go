@@ -0,0 +1,102 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package projects
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllProjectsFunc, if set, handles calls to AllProjects.
+	AllProjectsFunc func(ctx context.Context, request *ListProjectsRequest) iter.Seq2[Project, error]
+	// CreateProjectFunc, if set, handles calls to CreateProject.
+	CreateProjectFunc func(ctx context.Context, create CreateProjectRequest) (*Project, error)
+	// DeleteProjectFunc, if set, handles calls to DeleteProject.
+	DeleteProjectFunc func(ctx context.Context, identity string) error
+	// GetProjectFunc, if set, handles calls to GetProject.
+	GetProjectFunc func(ctx context.Context, identity string) (*Project, error)
+	// ListProjectsFunc, if set, handles calls to ListProjects.
+	ListProjectsFunc func(ctx context.Context, request *ListProjectsRequest) ([]Project, error)
+	// UpdateProjectFunc, if set, handles calls to UpdateProject.
+	UpdateProjectFunc func(ctx context.Context, identity string, update UpdateProjectRequest) (*Project, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllProjects records the call and invokes AllProjectsFunc if set.
+func (f *Fake) AllProjects(ctx context.Context, request *ListProjectsRequest) (r0 iter.Seq2[Project, error]) {
+	f.Record("AllProjects", ctx, request)
+	if f.AllProjectsFunc != nil {
+		return f.AllProjectsFunc(ctx, request)
+	}
+	r0 = func(yield func(Project, error) bool) {
+		if f.Err != nil {
+			var zero Project
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateProject records the call and invokes CreateProjectFunc if set.
+func (f *Fake) CreateProject(ctx context.Context, create CreateProjectRequest) (r0 *Project, r1 error) {
+	f.Record("CreateProject", ctx, create)
+	if f.CreateProjectFunc != nil {
+		return f.CreateProjectFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteProject records the call and invokes DeleteProjectFunc if set.
+func (f *Fake) DeleteProject(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteProject", ctx, identity)
+	if f.DeleteProjectFunc != nil {
+		return f.DeleteProjectFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetProject records the call and invokes GetProjectFunc if set.
+func (f *Fake) GetProject(ctx context.Context, identity string) (r0 *Project, r1 error) {
+	f.Record("GetProject", ctx, identity)
+	if f.GetProjectFunc != nil {
+		return f.GetProjectFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListProjects records the call and invokes ListProjectsFunc if set.
+func (f *Fake) ListProjects(ctx context.Context, request *ListProjectsRequest) (r0 []Project, r1 error) {
+	f.Record("ListProjects", ctx, request)
+	if f.ListProjectsFunc != nil {
+		return f.ListProjectsFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateProject records the call and invokes UpdateProjectFunc if set.
+func (f *Fake) UpdateProject(ctx context.Context, identity string, update UpdateProjectRequest) (r0 *Project, r1 error) {
+	f.Record("UpdateProject", ctx, identity, update)
+	if f.UpdateProjectFunc != nil {
+		return f.UpdateProjectFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
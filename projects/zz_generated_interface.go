@@ -0,0 +1,32 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package projects
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllProjects returns an iterator over the results of ListProjects.
+	AllProjects(ctx context.Context, request *ListProjectsRequest) iter.Seq2[Project, error]
+
+	// CreateProject creates a new project in the organisation.
+	CreateProject(ctx context.Context, create CreateProjectRequest) (*Project, error)
+
+	// DeleteProject deletes a project by identity or slug.
+	DeleteProject(ctx context.Context, identity string) error
+
+	// GetProject retrieves a project by ID or slug.
+	GetProject(ctx context.Context, identity string) (*Project, error)
+
+	// ListProjects lists projects visible to the caller within the organisation.
+	ListProjects(ctx context.Context, request *ListProjectsRequest) ([]Project, error)
+
+	// UpdateProject replaces a project's fields. identity accepts identity or slug.
+	UpdateProject(ctx context.Context, identity string, update UpdateProjectRequest) (*Project, error)
+}
+
+var _ Interface = (*Client)(nil)
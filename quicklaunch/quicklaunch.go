@@ -3,6 +3,7 @@ package quicklaunch
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/filters"
 	"github.com/thalassa-cloud/client-go/pkg/client"
@@ -36,6 +37,13 @@ func (c *Client) ListQuickLaunches(ctx context.Context, listRequest *ListQuickLa
 	return out, nil
 }
 
+// AllQuickLaunches returns an iterator over the results of ListQuickLaunches.
+func (c *Client) AllQuickLaunches(ctx context.Context, listRequest *ListQuickLaunchesRequest) iter.Seq2[QuickLaunch, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]QuickLaunch, error) {
+		return c.ListQuickLaunches(ctx, listRequest)
+	})
+}
+
 // CreateQuickLaunch starts a quick launch (201 Created; provisioning continues asynchronously).
 func (c *Client) CreateQuickLaunch(ctx context.Context, body QuickLaunchRequest) (*QuickLaunch, error) {
 	if body.Name == "" {
@@ -0,0 +1,102 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package quicklaunch
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllQuickLaunchesFunc, if set, handles calls to AllQuickLaunches.
+	AllQuickLaunchesFunc func(ctx context.Context, listRequest *ListQuickLaunchesRequest) iter.Seq2[QuickLaunch, error]
+	// CreateQuickLaunchFunc, if set, handles calls to CreateQuickLaunch.
+	CreateQuickLaunchFunc func(ctx context.Context, body QuickLaunchRequest) (*QuickLaunch, error)
+	// DeleteQuickLaunchFunc, if set, handles calls to DeleteQuickLaunch.
+	DeleteQuickLaunchFunc func(ctx context.Context, identity string, cascade QuickLaunchCascade) error
+	// GetQuickLaunchFunc, if set, handles calls to GetQuickLaunch.
+	GetQuickLaunchFunc func(ctx context.Context, identity string) (*QuickLaunch, error)
+	// GetQuickLaunchLogsFunc, if set, handles calls to GetQuickLaunchLogs.
+	GetQuickLaunchLogsFunc func(ctx context.Context, identity string) (QuickLaunchLogs, error)
+	// ListQuickLaunchesFunc, if set, handles calls to ListQuickLaunches.
+	ListQuickLaunchesFunc func(ctx context.Context, listRequest *ListQuickLaunchesRequest) ([]QuickLaunch, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllQuickLaunches records the call and invokes AllQuickLaunchesFunc if set.
+func (f *Fake) AllQuickLaunches(ctx context.Context, listRequest *ListQuickLaunchesRequest) (r0 iter.Seq2[QuickLaunch, error]) {
+	f.Record("AllQuickLaunches", ctx, listRequest)
+	if f.AllQuickLaunchesFunc != nil {
+		return f.AllQuickLaunchesFunc(ctx, listRequest)
+	}
+	r0 = func(yield func(QuickLaunch, error) bool) {
+		if f.Err != nil {
+			var zero QuickLaunch
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateQuickLaunch records the call and invokes CreateQuickLaunchFunc if set.
+func (f *Fake) CreateQuickLaunch(ctx context.Context, body QuickLaunchRequest) (r0 *QuickLaunch, r1 error) {
+	f.Record("CreateQuickLaunch", ctx, body)
+	if f.CreateQuickLaunchFunc != nil {
+		return f.CreateQuickLaunchFunc(ctx, body)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteQuickLaunch records the call and invokes DeleteQuickLaunchFunc if set.
+func (f *Fake) DeleteQuickLaunch(ctx context.Context, identity string, cascade QuickLaunchCascade) (r0 error) {
+	f.Record("DeleteQuickLaunch", ctx, identity, cascade)
+	if f.DeleteQuickLaunchFunc != nil {
+		return f.DeleteQuickLaunchFunc(ctx, identity, cascade)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetQuickLaunch records the call and invokes GetQuickLaunchFunc if set.
+func (f *Fake) GetQuickLaunch(ctx context.Context, identity string) (r0 *QuickLaunch, r1 error) {
+	f.Record("GetQuickLaunch", ctx, identity)
+	if f.GetQuickLaunchFunc != nil {
+		return f.GetQuickLaunchFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetQuickLaunchLogs records the call and invokes GetQuickLaunchLogsFunc if set.
+func (f *Fake) GetQuickLaunchLogs(ctx context.Context, identity string) (r0 QuickLaunchLogs, r1 error) {
+	f.Record("GetQuickLaunchLogs", ctx, identity)
+	if f.GetQuickLaunchLogsFunc != nil {
+		return f.GetQuickLaunchLogsFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListQuickLaunches records the call and invokes ListQuickLaunchesFunc if set.
+func (f *Fake) ListQuickLaunches(ctx context.Context, listRequest *ListQuickLaunchesRequest) (r0 []QuickLaunch, r1 error) {
+	f.Record("ListQuickLaunches", ctx, listRequest)
+	if f.ListQuickLaunchesFunc != nil {
+		return f.ListQuickLaunchesFunc(ctx, listRequest)
+	}
+	r1 = f.Err
+	return
+}
@@ -0,0 +1,32 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package quicklaunch
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllQuickLaunches returns an iterator over the results of ListQuickLaunches.
+	AllQuickLaunches(ctx context.Context, listRequest *ListQuickLaunchesRequest) iter.Seq2[QuickLaunch, error]
+
+	// CreateQuickLaunch starts a quick launch (201 Created; provisioning continues asynchronously).
+	CreateQuickLaunch(ctx context.Context, body QuickLaunchRequest) (*QuickLaunch, error)
+
+	// DeleteQuickLaunch deletes a quick launch. When cascade is non-empty, it is sent as the cascade query parameter (Delete or Orphan).
+	DeleteQuickLaunch(ctx context.Context, identity string, cascade QuickLaunchCascade) error
+
+	// GetQuickLaunch returns a quick launch job by identity.
+	GetQuickLaunch(ctx context.Context, identity string) (*QuickLaunch, error)
+
+	// GetQuickLaunchLogs returns the response body from the logs endpoint (typically JSON).
+	GetQuickLaunchLogs(ctx context.Context, identity string) (QuickLaunchLogs, error)
+
+	// ListQuickLaunches lists quick launch jobs for the organisation (auth context).
+	ListQuickLaunches(ctx context.Context, listRequest *ListQuickLaunchesRequest) ([]QuickLaunch, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -3,10 +3,13 @@ package quotas
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
 
+//go:generate go run ../internal/cmd/genservice
+
 const (
 	QuotasEndpoint = "/v1/quotas"
 )
@@ -18,7 +21,9 @@ type Client struct {
 
 // New creates a new quotas client
 func New(c client.Client, opts ...client.Option) (*Client, error) {
-	c.WithOptions(opts...)
+	if err := client.ApplyOptions(c, opts...); err != nil {
+		return nil, err
+	}
 	return &Client{c}, nil
 }
 
@@ -36,6 +41,13 @@ func (c *Client) ListOrganisationQuotas(ctx context.Context) ([]OrganisationQuot
 	return quotas, nil
 }
 
+// AllOrganisationQuotas returns an iterator over the results of ListOrganisationQuotas.
+func (c *Client) AllOrganisationQuotas(ctx context.Context) iter.Seq2[OrganisationQuota, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]OrganisationQuota, error) {
+		return c.ListOrganisationQuotas(ctx)
+	})
+}
+
 // GetOrganisationQuota retrieves a specific organisation quota by name
 func (c *Client) GetOrganisationQuota(ctx context.Context, quotaName string) (*OrganisationQuota, error) {
 	var quota *OrganisationQuota
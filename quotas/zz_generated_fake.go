@@ -0,0 +1,78 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package quotas
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllOrganisationQuotasFunc, if set, handles calls to AllOrganisationQuotas.
+	AllOrganisationQuotasFunc func(ctx context.Context) iter.Seq2[OrganisationQuota, error]
+	// GetOrganisationQuotaFunc, if set, handles calls to GetOrganisationQuota.
+	GetOrganisationQuotaFunc func(ctx context.Context, quotaName string) (*OrganisationQuota, error)
+	// ListOrganisationQuotasFunc, if set, handles calls to ListOrganisationQuotas.
+	ListOrganisationQuotasFunc func(ctx context.Context) ([]OrganisationQuota, error)
+	// RequestQuotaIncreaseFunc, if set, handles calls to RequestQuotaIncrease.
+	RequestQuotaIncreaseFunc func(ctx context.Context, request RequestQuotaIncreaseRequest) error
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllOrganisationQuotas records the call and invokes AllOrganisationQuotasFunc if set.
+func (f *Fake) AllOrganisationQuotas(ctx context.Context) (r0 iter.Seq2[OrganisationQuota, error]) {
+	f.Record("AllOrganisationQuotas", ctx)
+	if f.AllOrganisationQuotasFunc != nil {
+		return f.AllOrganisationQuotasFunc(ctx)
+	}
+	r0 = func(yield func(OrganisationQuota, error) bool) {
+		if f.Err != nil {
+			var zero OrganisationQuota
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// GetOrganisationQuota records the call and invokes GetOrganisationQuotaFunc if set.
+func (f *Fake) GetOrganisationQuota(ctx context.Context, quotaName string) (r0 *OrganisationQuota, r1 error) {
+	f.Record("GetOrganisationQuota", ctx, quotaName)
+	if f.GetOrganisationQuotaFunc != nil {
+		return f.GetOrganisationQuotaFunc(ctx, quotaName)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListOrganisationQuotas records the call and invokes ListOrganisationQuotasFunc if set.
+func (f *Fake) ListOrganisationQuotas(ctx context.Context) (r0 []OrganisationQuota, r1 error) {
+	f.Record("ListOrganisationQuotas", ctx)
+	if f.ListOrganisationQuotasFunc != nil {
+		return f.ListOrganisationQuotasFunc(ctx)
+	}
+	r1 = f.Err
+	return
+}
+
+// RequestQuotaIncrease records the call and invokes RequestQuotaIncreaseFunc if set.
+func (f *Fake) RequestQuotaIncrease(ctx context.Context, request RequestQuotaIncreaseRequest) (r0 error) {
+	f.Record("RequestQuotaIncrease", ctx, request)
+	if f.RequestQuotaIncreaseFunc != nil {
+		return f.RequestQuotaIncreaseFunc(ctx, request)
+	}
+	r0 = f.Err
+	return
+}
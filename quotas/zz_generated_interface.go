@@ -0,0 +1,26 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package quotas
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllOrganisationQuotas returns an iterator over the results of ListOrganisationQuotas.
+	AllOrganisationQuotas(ctx context.Context) iter.Seq2[OrganisationQuota, error]
+
+	// GetOrganisationQuota retrieves a specific organisation quota by name
+	GetOrganisationQuota(ctx context.Context, quotaName string) (*OrganisationQuota, error)
+
+	// ListOrganisationQuotas lists all organisation quotas
+	ListOrganisationQuotas(ctx context.Context) ([]OrganisationQuota, error)
+
+	// RequestQuotaIncrease requests an increase for a specific quota
+	RequestQuotaIncrease(ctx context.Context, request RequestQuotaIncreaseRequest) error
+}
+
+var _ Interface = (*Client)(nil)
@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"context"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
 )
@@ -42,6 +43,13 @@ func (c *Client) ListSecrets(ctx context.Context, region, pathPrefix string) ([]
 	return secrets, nil
 }
 
+// AllSecrets returns an iterator over the results of ListSecrets.
+func (c *Client) AllSecrets(ctx context.Context, region, pathPrefix string) iter.Seq2[Secret, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]Secret, error) {
+		return c.ListSecrets(ctx, region, pathPrefix)
+	})
+}
+
 // CreateSecret creates a new secret.
 func (c *Client) CreateSecret(ctx context.Context, region string, create CreateSecretRequest) (*Secret, error) {
 	if _, err := NormalizePath(create.Path); err != nil {
@@ -0,0 +1,70 @@
+package secrets
+
+import (
+	"encoding/base64"
+
+	"github.com/thalassa-cloud/client-go/pkg/validate"
+)
+
+// Validate checks the fields of the request before it is sent.
+func (r CreateSecretRequest) Validate() error {
+	var f validate.Fields
+	if _, err := NormalizePath(r.Path); err != nil {
+		f.Add("path", "%v", err)
+	}
+	validateSecretValue(&f, r.SecretString, r.SecretKeyValues, r.GenerateSecret)
+	if r.AccessPolicy != nil {
+		f.Nested("accessPolicy", r.AccessPolicy.Validate())
+	}
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent. The path is set by
+// PutSecretValue, so it is only checked when present.
+func (r PutSecretValueRequest) Validate() error {
+	var f validate.Fields
+	if r.Path != "" {
+		if _, err := NormalizePath(r.Path); err != nil {
+			f.Add("path", "%v", err)
+		}
+	}
+	validateSecretValue(&f, r.SecretString, r.SecretKeyValues, r.GenerateSecret)
+	return f.Err()
+}
+
+// Validate checks the fields of the request before it is sent.
+func (r UpdateAccessPolicyRequest) Validate() error {
+	var f validate.Fields
+	f.Nested("accessPolicy", r.AccessPolicy.Validate())
+	return f.Err()
+}
+
+// Validate checks the statements of the policy.
+func (p SecretPolicy) Validate() error {
+	var f validate.Fields
+	for i, statement := range p.Statements {
+		field := validate.Index("statements", i)
+		f.Required(field+".effect", statement.Effect)
+		validate.OneOf(&f, field+".effect", statement.Effect, "allow", "deny")
+		f.Check(len(statement.Actions) > 0, field+".actions", "at least one action is required")
+	}
+	return f.Err()
+}
+
+// validateSecretValue checks that exactly one kind of secret value is set.
+func validateSecretValue(f *validate.Fields, secretString string, keyValues map[string]string, generate *GenerateSecret) {
+	set := 0
+	for _, ok := range []bool{secretString != "", len(keyValues) > 0, generate != nil} {
+		if ok {
+			set++
+		}
+	}
+	f.Check(set == 1, "secretString", "exactly one of secretString, secretKeyValues or generateSecret is required")
+	if secretString != "" {
+		_, err := base64.StdEncoding.DecodeString(secretString)
+		f.Check(err == nil, "secretString", "must be valid base64")
+	}
+	if generate != nil {
+		f.Check(generate.ByteLength > 0, "generateSecret.byteLength", "must be greater than zero")
+	}
+}
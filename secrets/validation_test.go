@@ -0,0 +1,80 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  client.Validator
+		want []string
+	}{
+		{
+			name: "valid secret",
+			req:  CreateSecretRequest{Path: "app/db", KmsKeyIdentity: "kms-abc123", SecretString: EncodeBytes([]byte("secret"))},
+		},
+		{
+			name: "generated secret",
+			req:  CreateSecretRequest{Path: "/app/token", GenerateSecret: &GenerateSecret{ByteLength: 32}},
+		},
+		{
+			name: "no value",
+			req:  CreateSecretRequest{Path: "/app/db"},
+			want: []string{"secretString"},
+		},
+		{
+			name: "several values",
+			req:  CreateSecretRequest{Path: "/app db", SecretString: "not base64!", SecretKeyValues: map[string]string{"user": "app"}},
+			want: []string{"path", "secretString", "secretString"},
+		},
+		{
+			name: "generated length",
+			req:  PutSecretValueRequest{GenerateSecret: &GenerateSecret{}},
+			want: []string{"generateSecret.byteLength"},
+		},
+		{
+			name: "access policy",
+			req: UpdateAccessPolicyRequest{AccessPolicy: SecretPolicy{Statements: []SecretPolicyStatement{
+				{Effect: "allow", Actions: []string{"secrets:get-value"}},
+				{Effect: "permit"},
+			}}},
+			want: []string{"accessPolicy.statements[1].effect", "accessPolicy.statements[1].actions"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *client.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Fields())
+		})
+	}
+}
+
+func TestCreateSecretWithRequestValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(client.WithBaseURL(server.URL), client.WithAuthCustom(), client.WithRequestValidation())
+	require.NoError(t, err)
+	secretsClient, err := New(c)
+	require.NoError(t, err)
+
+	_, err = secretsClient.CreateSecret(context.Background(), "nl-01", CreateSecretRequest{Path: "/app/db"})
+	assert.ErrorIs(t, err, client.ErrInvalidRequest)
+	assert.ErrorIs(t, err, client.ErrBadRequest)
+}
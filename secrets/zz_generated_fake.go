@@ -0,0 +1,174 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package secrets
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllSecretsFunc, if set, handles calls to AllSecrets.
+	AllSecretsFunc func(ctx context.Context, region string, pathPrefix string) iter.Seq2[Secret, error]
+	// BrowseSecretsFunc, if set, handles calls to BrowseSecrets.
+	BrowseSecretsFunc func(ctx context.Context, region string, path string) (*BrowseSecretsResponse, error)
+	// CreateSecretFunc, if set, handles calls to CreateSecret.
+	CreateSecretFunc func(ctx context.Context, region string, create CreateSecretRequest) (*Secret, error)
+	// DeleteSecretFunc, if set, handles calls to DeleteSecret.
+	DeleteSecretFunc func(ctx context.Context, region string, path string) error
+	// DestroySecretVersionFunc, if set, handles calls to DestroySecretVersion.
+	DestroySecretVersionFunc func(ctx context.Context, region string, path string, version int) error
+	// GetSecretFunc, if set, handles calls to GetSecret.
+	GetSecretFunc func(ctx context.Context, region string, path string, includeVersions bool) (*Secret, error)
+	// GetSecretStringFunc, if set, handles calls to GetSecretString.
+	GetSecretStringFunc func(ctx context.Context, region string, path string, version *int) ([]byte, int, error)
+	// GetSecretValueFunc, if set, handles calls to GetSecretValue.
+	GetSecretValueFunc func(ctx context.Context, region string, path string, version *int) (*GetSecretValueResponse, error)
+	// ListSecretsFunc, if set, handles calls to ListSecrets.
+	ListSecretsFunc func(ctx context.Context, region string, pathPrefix string) ([]Secret, error)
+	// PutSecretStringFunc, if set, handles calls to PutSecretString.
+	PutSecretStringFunc func(ctx context.Context, region string, path string, plaintext []byte) (*PutSecretValueResponse, error)
+	// PutSecretValueFunc, if set, handles calls to PutSecretValue.
+	PutSecretValueFunc func(ctx context.Context, region string, path string, put PutSecretValueRequest) (*PutSecretValueResponse, error)
+	// UpdateAccessPolicyFunc, if set, handles calls to UpdateAccessPolicy.
+	UpdateAccessPolicyFunc func(ctx context.Context, region string, path string, update UpdateAccessPolicyRequest) (*Secret, error)
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllSecrets records the call and invokes AllSecretsFunc if set.
+func (f *Fake) AllSecrets(ctx context.Context, region string, pathPrefix string) (r0 iter.Seq2[Secret, error]) {
+	f.Record("AllSecrets", ctx, region, pathPrefix)
+	if f.AllSecretsFunc != nil {
+		return f.AllSecretsFunc(ctx, region, pathPrefix)
+	}
+	r0 = func(yield func(Secret, error) bool) {
+		if f.Err != nil {
+			var zero Secret
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// BrowseSecrets records the call and invokes BrowseSecretsFunc if set.
+func (f *Fake) BrowseSecrets(ctx context.Context, region string, path string) (r0 *BrowseSecretsResponse, r1 error) {
+	f.Record("BrowseSecrets", ctx, region, path)
+	if f.BrowseSecretsFunc != nil {
+		return f.BrowseSecretsFunc(ctx, region, path)
+	}
+	r1 = f.Err
+	return
+}
+
+// CreateSecret records the call and invokes CreateSecretFunc if set.
+func (f *Fake) CreateSecret(ctx context.Context, region string, create CreateSecretRequest) (r0 *Secret, r1 error) {
+	f.Record("CreateSecret", ctx, region, create)
+	if f.CreateSecretFunc != nil {
+		return f.CreateSecretFunc(ctx, region, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteSecret records the call and invokes DeleteSecretFunc if set.
+func (f *Fake) DeleteSecret(ctx context.Context, region string, path string) (r0 error) {
+	f.Record("DeleteSecret", ctx, region, path)
+	if f.DeleteSecretFunc != nil {
+		return f.DeleteSecretFunc(ctx, region, path)
+	}
+	r0 = f.Err
+	return
+}
+
+// DestroySecretVersion records the call and invokes DestroySecretVersionFunc if set.
+func (f *Fake) DestroySecretVersion(ctx context.Context, region string, path string, version int) (r0 error) {
+	f.Record("DestroySecretVersion", ctx, region, path, version)
+	if f.DestroySecretVersionFunc != nil {
+		return f.DestroySecretVersionFunc(ctx, region, path, version)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetSecret records the call and invokes GetSecretFunc if set.
+func (f *Fake) GetSecret(ctx context.Context, region string, path string, includeVersions bool) (r0 *Secret, r1 error) {
+	f.Record("GetSecret", ctx, region, path, includeVersions)
+	if f.GetSecretFunc != nil {
+		return f.GetSecretFunc(ctx, region, path, includeVersions)
+	}
+	r1 = f.Err
+	return
+}
+
+// GetSecretString records the call and invokes GetSecretStringFunc if set.
+func (f *Fake) GetSecretString(ctx context.Context, region string, path string, version *int) (r0 []byte, r1 int, r2 error) {
+	f.Record("GetSecretString", ctx, region, path, version)
+	if f.GetSecretStringFunc != nil {
+		return f.GetSecretStringFunc(ctx, region, path, version)
+	}
+	r2 = f.Err
+	return
+}
+
+// GetSecretValue records the call and invokes GetSecretValueFunc if set.
+func (f *Fake) GetSecretValue(ctx context.Context, region string, path string, version *int) (r0 *GetSecretValueResponse, r1 error) {
+	f.Record("GetSecretValue", ctx, region, path, version)
+	if f.GetSecretValueFunc != nil {
+		return f.GetSecretValueFunc(ctx, region, path, version)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListSecrets records the call and invokes ListSecretsFunc if set.
+func (f *Fake) ListSecrets(ctx context.Context, region string, pathPrefix string) (r0 []Secret, r1 error) {
+	f.Record("ListSecrets", ctx, region, pathPrefix)
+	if f.ListSecretsFunc != nil {
+		return f.ListSecretsFunc(ctx, region, pathPrefix)
+	}
+	r1 = f.Err
+	return
+}
+
+// PutSecretString records the call and invokes PutSecretStringFunc if set.
+func (f *Fake) PutSecretString(ctx context.Context, region string, path string, plaintext []byte) (r0 *PutSecretValueResponse, r1 error) {
+	f.Record("PutSecretString", ctx, region, path, plaintext)
+	if f.PutSecretStringFunc != nil {
+		return f.PutSecretStringFunc(ctx, region, path, plaintext)
+	}
+	r1 = f.Err
+	return
+}
+
+// PutSecretValue records the call and invokes PutSecretValueFunc if set.
+func (f *Fake) PutSecretValue(ctx context.Context, region string, path string, put PutSecretValueRequest) (r0 *PutSecretValueResponse, r1 error) {
+	f.Record("PutSecretValue", ctx, region, path, put)
+	if f.PutSecretValueFunc != nil {
+		return f.PutSecretValueFunc(ctx, region, path, put)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateAccessPolicy records the call and invokes UpdateAccessPolicyFunc if set.
+func (f *Fake) UpdateAccessPolicy(ctx context.Context, region string, path string, update UpdateAccessPolicyRequest) (r0 *Secret, r1 error) {
+	f.Record("UpdateAccessPolicy", ctx, region, path, update)
+	if f.UpdateAccessPolicyFunc != nil {
+		return f.UpdateAccessPolicyFunc(ctx, region, path, update)
+	}
+	r1 = f.Err
+	return
+}
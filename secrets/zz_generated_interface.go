@@ -0,0 +1,51 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package secrets
+
+import (
+	"context"
+	"iter"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllSecrets returns an iterator over the results of ListSecrets.
+	AllSecrets(ctx context.Context, region string, pathPrefix string) iter.Seq2[Secret, error]
+
+	// BrowseSecrets lists child prefixes and secrets at a path.
+	BrowseSecrets(ctx context.Context, region string, path string) (*BrowseSecretsResponse, error)
+
+	// CreateSecret creates a new secret.
+	CreateSecret(ctx context.Context, region string, create CreateSecretRequest) (*Secret, error)
+
+	// DeleteSecret deletes a secret and all of its versions.
+	DeleteSecret(ctx context.Context, region string, path string) error
+
+	// DestroySecretVersion permanently destroys a specific secret version.
+	DestroySecretVersion(ctx context.Context, region string, path string, version int) error
+
+	// GetSecret retrieves secret metadata. Set includeVersions to include version history.
+	GetSecret(ctx context.Context, region string, path string, includeVersions bool) (*Secret, error)
+
+	// GetSecretString retrieves and decodes a string secret value.
+	GetSecretString(ctx context.Context, region string, path string, version *int) ([]byte, int, error)
+
+	// GetSecretValue retrieves a secret value.
+	// Callers must not log or persist decrypted secret material from the response.
+	GetSecretValue(ctx context.Context, region string, path string, version *int) (*GetSecretValueResponse, error)
+
+	// ListSecrets recursively lists secrets under a path prefix.
+	ListSecrets(ctx context.Context, region string, pathPrefix string) ([]Secret, error)
+
+	// PutSecretString stores a new string secret version, encoding plaintext as base64.
+	PutSecretString(ctx context.Context, region string, path string, plaintext []byte) (*PutSecretValueResponse, error)
+
+	// PutSecretValue stores a new secret version.
+	PutSecretValue(ctx context.Context, region string, path string, put PutSecretValueRequest) (*PutSecretValueResponse, error)
+
+	// UpdateAccessPolicy updates the access policy for a secret.
+	UpdateAccessPolicy(ctx context.Context, region string, path string, update UpdateAccessPolicyRequest) (*Secret, error)
+}
+
+var _ Interface = (*Client)(nil)
@@ -1,7 +1,19 @@
 package tfs
 
-import "time"
+import (
+	"time"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
 
 var (
+	// DefaultPollIntervalForWaiting is the initial interval between polls of the WaitUntil*
+	// helpers. The interval grows up to wait.DefaultMaxInterval unless changed with
+	// wait.WithInterval.
 	DefaultPollIntervalForWaiting = 1 * time.Second
 )
+
+// waitOptions prepends the default poll interval to opts.
+func waitOptions(opts []wait.Option) []wait.Option {
+	return append([]wait.Option{wait.WithInterval(DefaultPollIntervalForWaiting, 0)}, opts...)
+}
@@ -2,12 +2,11 @@ package tfs
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
-	"time"
+	"iter"
 
 	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
 )
 
 const (
@@ -37,6 +36,13 @@ func (c *Client) ListTfsInstances(ctx context.Context, request *ListTfsInstances
 	return tfsInstances, nil
 }
 
+// AllTfsInstances returns an iterator over the results of ListTfsInstances.
+func (c *Client) AllTfsInstances(ctx context.Context, request *ListTfsInstancesRequest) iter.Seq2[TfsInstance, error] {
+	return client.ListAll(ctx, func(ctx context.Context) ([]TfsInstance, error) {
+		return c.ListTfsInstances(ctx, request)
+	})
+}
+
 // GetTfsInstance retrieves a specific TFS instance by its identity.
 func (c *Client) GetTfsInstance(ctx context.Context, identity string) (*TfsInstance, error) {
 	var tfsInstance *TfsInstance
@@ -99,69 +105,33 @@ func (c *Client) DeleteTfsInstance(ctx context.Context, identity string) error {
 
 // WaitUntilTfsInstanceIsAvailable waits until a TFS instance is available.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilTfsInstanceIsAvailable(ctx context.Context, tfsIdentity string) error {
-	return c.WaitUntilTfsInstanceIsStatus(ctx, tfsIdentity, TfsStatusAvailable)
+func (c *Client) WaitUntilTfsInstanceIsAvailable(ctx context.Context, tfsIdentity string, opts ...wait.Option) error {
+	return c.WaitUntilTfsInstanceIsStatus(ctx, tfsIdentity, TfsStatusAvailable, opts...)
 }
 
 // WaitUntilTfsInstanceIsStatus waits until a TFS instance is in a specific status.
+// It returns an error if the TFS instance enters the error status instead.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilTfsInstanceIsStatus(ctx context.Context, tfsIdentity string, status TfsStatus) error {
-	tfsInstance, err := c.GetTfsInstance(ctx, tfsIdentity)
-	if err != nil {
-		return err
-	}
-	if strings.EqualFold(string(tfsInstance.Status), string(status)) {
-		return nil
-	}
-	// wait until the TFS instance is in the desired status
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-		}
-
-		tfsInstance, err = c.GetTfsInstance(ctx, tfsIdentity)
-		if err != nil {
-			return err
-		}
-		if strings.EqualFold(string(tfsInstance.Status), string(status)) {
-			return nil
-		}
-	}
+func (c *Client) WaitUntilTfsInstanceIsStatus(ctx context.Context, tfsIdentity string, status TfsStatus, opts ...wait.Option) error {
+	_, err := wait.UntilStatus("TFS instance "+tfsIdentity, c.tfsInstanceGetter(tfsIdentity), tfsInstanceStatus,
+		string(status), string(TfsStatusError)).Wait(ctx, waitOptions(opts)...)
+	return err
 }
 
 // WaitUntilTfsInstanceIsDeleted waits until a TFS instance is deleted.
 // The user is expected to provide a timeout context.
-func (c *Client) WaitUntilTfsInstanceIsDeleted(ctx context.Context, tfsIdentity string) error {
-	tfsInstance, err := c.GetTfsInstance(ctx, tfsIdentity)
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			return nil
-		}
-		return err
-	}
-	if strings.EqualFold(string(tfsInstance.Status), string(TfsStatusDeleted)) {
-		return nil
-	}
-	if !strings.EqualFold(string(tfsInstance.Status), string(TfsStatusDeleting)) {
-		return fmt.Errorf("TFS instance %s is not being deleted (status: %s)", tfsIdentity, tfsInstance.Status)
-	}
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(DefaultPollIntervalForWaiting):
-			tfsInstance, err := c.GetTfsInstance(ctx, tfsIdentity)
-			if err != nil {
-				if errors.Is(err, client.ErrNotFound) {
-					return nil
-				}
-				return err
-			}
-			if strings.EqualFold(string(tfsInstance.Status), string(TfsStatusDeleted)) {
-				return nil
-			}
-		}
+func (c *Client) WaitUntilTfsInstanceIsDeleted(ctx context.Context, tfsIdentity string, opts ...wait.Option) error {
+	_, err := wait.UntilDeleted("TFS instance "+tfsIdentity, c.tfsInstanceGetter(tfsIdentity), tfsInstanceStatus,
+		string(TfsStatusDeleting), string(TfsStatusDeleted)).Wait(ctx, waitOptions(opts)...)
+	return err
+}
+
+func (c *Client) tfsInstanceGetter(identity string) func(context.Context) (*TfsInstance, error) {
+	return func(ctx context.Context) (*TfsInstance, error) {
+		return c.GetTfsInstance(ctx, identity)
 	}
 }
+
+func tfsInstanceStatus(tfsInstance *TfsInstance) string {
+	return string(tfsInstance.Status)
+}
@@ -0,0 +1,139 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package tfs
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Fake is an in-memory implementation of Interface for unit tests. Every call is
+// recorded, and the response of a method is programmed by setting its <Method>Func
+// field. Methods without a programmed response return zero values and Err, and
+// iterators yield Err once if it is set and nothing otherwise. The fields must be
+// set before the Fake is used concurrently.
+type Fake struct {
+	fake.Recorder
+
+	// Err is returned by methods without a programmed response.
+	Err error
+
+	// AllTfsInstancesFunc, if set, handles calls to AllTfsInstances.
+	AllTfsInstancesFunc func(ctx context.Context, request *ListTfsInstancesRequest) iter.Seq2[TfsInstance, error]
+	// CreateTfsInstanceFunc, if set, handles calls to CreateTfsInstance.
+	CreateTfsInstanceFunc func(ctx context.Context, create CreateTfsInstanceRequest) (*TfsInstance, error)
+	// DeleteTfsInstanceFunc, if set, handles calls to DeleteTfsInstance.
+	DeleteTfsInstanceFunc func(ctx context.Context, identity string) error
+	// GetTfsInstanceFunc, if set, handles calls to GetTfsInstance.
+	GetTfsInstanceFunc func(ctx context.Context, identity string) (*TfsInstance, error)
+	// ListTfsInstancesFunc, if set, handles calls to ListTfsInstances.
+	ListTfsInstancesFunc func(ctx context.Context, request *ListTfsInstancesRequest) ([]TfsInstance, error)
+	// UpdateTfsInstanceFunc, if set, handles calls to UpdateTfsInstance.
+	UpdateTfsInstanceFunc func(ctx context.Context, identity string, update UpdateTfsInstanceRequest) (*TfsInstance, error)
+	// WaitUntilTfsInstanceIsAvailableFunc, if set, handles calls to WaitUntilTfsInstanceIsAvailable.
+	WaitUntilTfsInstanceIsAvailableFunc func(ctx context.Context, tfsIdentity string, opts ...wait.Option) error
+	// WaitUntilTfsInstanceIsDeletedFunc, if set, handles calls to WaitUntilTfsInstanceIsDeleted.
+	WaitUntilTfsInstanceIsDeletedFunc func(ctx context.Context, tfsIdentity string, opts ...wait.Option) error
+	// WaitUntilTfsInstanceIsStatusFunc, if set, handles calls to WaitUntilTfsInstanceIsStatus.
+	WaitUntilTfsInstanceIsStatusFunc func(ctx context.Context, tfsIdentity string, status TfsStatus, opts ...wait.Option) error
+}
+
+var _ Interface = (*Fake)(nil)
+
+// AllTfsInstances records the call and invokes AllTfsInstancesFunc if set.
+func (f *Fake) AllTfsInstances(ctx context.Context, request *ListTfsInstancesRequest) (r0 iter.Seq2[TfsInstance, error]) {
+	f.Record("AllTfsInstances", ctx, request)
+	if f.AllTfsInstancesFunc != nil {
+		return f.AllTfsInstancesFunc(ctx, request)
+	}
+	r0 = func(yield func(TfsInstance, error) bool) {
+		if f.Err != nil {
+			var zero TfsInstance
+			yield(zero, f.Err)
+		}
+	}
+	return
+}
+
+// CreateTfsInstance records the call and invokes CreateTfsInstanceFunc if set.
+func (f *Fake) CreateTfsInstance(ctx context.Context, create CreateTfsInstanceRequest) (r0 *TfsInstance, r1 error) {
+	f.Record("CreateTfsInstance", ctx, create)
+	if f.CreateTfsInstanceFunc != nil {
+		return f.CreateTfsInstanceFunc(ctx, create)
+	}
+	r1 = f.Err
+	return
+}
+
+// DeleteTfsInstance records the call and invokes DeleteTfsInstanceFunc if set.
+func (f *Fake) DeleteTfsInstance(ctx context.Context, identity string) (r0 error) {
+	f.Record("DeleteTfsInstance", ctx, identity)
+	if f.DeleteTfsInstanceFunc != nil {
+		return f.DeleteTfsInstanceFunc(ctx, identity)
+	}
+	r0 = f.Err
+	return
+}
+
+// GetTfsInstance records the call and invokes GetTfsInstanceFunc if set.
+func (f *Fake) GetTfsInstance(ctx context.Context, identity string) (r0 *TfsInstance, r1 error) {
+	f.Record("GetTfsInstance", ctx, identity)
+	if f.GetTfsInstanceFunc != nil {
+		return f.GetTfsInstanceFunc(ctx, identity)
+	}
+	r1 = f.Err
+	return
+}
+
+// ListTfsInstances records the call and invokes ListTfsInstancesFunc if set.
+func (f *Fake) ListTfsInstances(ctx context.Context, request *ListTfsInstancesRequest) (r0 []TfsInstance, r1 error) {
+	f.Record("ListTfsInstances", ctx, request)
+	if f.ListTfsInstancesFunc != nil {
+		return f.ListTfsInstancesFunc(ctx, request)
+	}
+	r1 = f.Err
+	return
+}
+
+// UpdateTfsInstance records the call and invokes UpdateTfsInstanceFunc if set.
+func (f *Fake) UpdateTfsInstance(ctx context.Context, identity string, update UpdateTfsInstanceRequest) (r0 *TfsInstance, r1 error) {
+	f.Record("UpdateTfsInstance", ctx, identity, update)
+	if f.UpdateTfsInstanceFunc != nil {
+		return f.UpdateTfsInstanceFunc(ctx, identity, update)
+	}
+	r1 = f.Err
+	return
+}
+
+// WaitUntilTfsInstanceIsAvailable records the call and invokes WaitUntilTfsInstanceIsAvailableFunc if set.
+func (f *Fake) WaitUntilTfsInstanceIsAvailable(ctx context.Context, tfsIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilTfsInstanceIsAvailable", ctx, tfsIdentity, opts)
+	if f.WaitUntilTfsInstanceIsAvailableFunc != nil {
+		return f.WaitUntilTfsInstanceIsAvailableFunc(ctx, tfsIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilTfsInstanceIsDeleted records the call and invokes WaitUntilTfsInstanceIsDeletedFunc if set.
+func (f *Fake) WaitUntilTfsInstanceIsDeleted(ctx context.Context, tfsIdentity string, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilTfsInstanceIsDeleted", ctx, tfsIdentity, opts)
+	if f.WaitUntilTfsInstanceIsDeletedFunc != nil {
+		return f.WaitUntilTfsInstanceIsDeletedFunc(ctx, tfsIdentity, opts...)
+	}
+	r0 = f.Err
+	return
+}
+
+// WaitUntilTfsInstanceIsStatus records the call and invokes WaitUntilTfsInstanceIsStatusFunc if set.
+func (f *Fake) WaitUntilTfsInstanceIsStatus(ctx context.Context, tfsIdentity string, status TfsStatus, opts ...wait.Option) (r0 error) {
+	f.Record("WaitUntilTfsInstanceIsStatus", ctx, tfsIdentity, status, opts)
+	if f.WaitUntilTfsInstanceIsStatusFunc != nil {
+		return f.WaitUntilTfsInstanceIsStatusFunc(ctx, tfsIdentity, status, opts...)
+	}
+	r0 = f.Err
+	return
+}
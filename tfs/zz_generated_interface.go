@@ -0,0 +1,47 @@
+// Code generated by genservice. DO NOT EDIT.
+
+package tfs
+
+import (
+	"context"
+	"iter"
+
+	"github.com/thalassa-cloud/client-go/pkg/wait"
+)
+
+// Interface is implemented by Client and Fake. It covers every exported method of
+// Client except those of the embedded client.Client.
+type Interface interface {
+	// AllTfsInstances returns an iterator over the results of ListTfsInstances.
+	AllTfsInstances(ctx context.Context, request *ListTfsInstancesRequest) iter.Seq2[TfsInstance, error]
+
+	// CreateTfsInstance creates a new TFS instance.
+	CreateTfsInstance(ctx context.Context, create CreateTfsInstanceRequest) (*TfsInstance, error)
+
+	// DeleteTfsInstance deletes a specific TFS instance by its identity.
+	DeleteTfsInstance(ctx context.Context, identity string) error
+
+	// GetTfsInstance retrieves a specific TFS instance by its identity.
+	GetTfsInstance(ctx context.Context, identity string) (*TfsInstance, error)
+
+	// ListTfsInstances lists all TFS instances for a given organisation.
+	ListTfsInstances(ctx context.Context, request *ListTfsInstancesRequest) ([]TfsInstance, error)
+
+	// UpdateTfsInstance updates an existing TFS instance.
+	UpdateTfsInstance(ctx context.Context, identity string, update UpdateTfsInstanceRequest) (*TfsInstance, error)
+
+	// WaitUntilTfsInstanceIsAvailable waits until a TFS instance is available.
+	// The user is expected to provide a timeout context.
+	WaitUntilTfsInstanceIsAvailable(ctx context.Context, tfsIdentity string, opts ...wait.Option) error
+
+	// WaitUntilTfsInstanceIsDeleted waits until a TFS instance is deleted.
+	// The user is expected to provide a timeout context.
+	WaitUntilTfsInstanceIsDeleted(ctx context.Context, tfsIdentity string, opts ...wait.Option) error
+
+	// WaitUntilTfsInstanceIsStatus waits until a TFS instance is in a specific status.
+	// It returns an error if the TFS instance enters the error status instead.
+	// The user is expected to provide a timeout context.
+	WaitUntilTfsInstanceIsStatus(ctx context.Context, tfsIdentity string, status TfsStatus, opts ...wait.Option) error
+}
+
+var _ Interface = (*Client)(nil)
@@ -1,6 +1,10 @@
 package thalassa
 
 import (
+	"errors"
+	"fmt"
+	"sync"
+
 	"github.com/thalassa-cloud/client-go/audit"
 	"github.com/thalassa-cloud/client-go/containerregistry"
 	"github.com/thalassa-cloud/client-go/dbaas"
@@ -20,181 +24,413 @@ import (
 	"github.com/thalassa-cloud/client-go/tfs"
 )
 
+// Client gives access to the service clients of the Thalassa Cloud API. Service
+// clients are created on first use and reused afterwards.
+//
+// The Get* methods return an error if the service client cannot be created. The
+// other accessors never panic: if the service client cannot be created, they return
+// a client whose methods all fail with that error.
 type Client interface {
-	Audit() *audit.Client
-	DBaaS() *dbaas.Client
-	IaaS() *iaas.Client
-	IAM() *iam.Client
-	Kubernetes() *kubernetes.Client
-	Me() *me.Client
-	ObjectStorage() *objectstorage.Client
-	Quotas() *quotas.Client
-	QuickLaunch() *quicklaunch.Client
-	Tfs() *tfs.Client
-	ObservabilityPrometheus() *prometheus.Client
-	ContainerRegistry() *containerregistry.Client
+	Audit() audit.Interface
+	GetAudit() (audit.Interface, error)
+	DBaaS() dbaas.Interface
+	GetDBaaS() (dbaas.Interface, error)
+	IaaS() iaas.Interface
+	GetIaaS() (iaas.Interface, error)
+	IAM() iam.Interface
+	GetIAM() (iam.Interface, error)
+	Kubernetes() kubernetes.Interface
+	GetKubernetes() (kubernetes.Interface, error)
+	Me() me.Interface
+	GetMe() (me.Interface, error)
+	ObjectStorage() objectstorage.Interface
+	GetObjectStorage() (objectstorage.Interface, error)
+	Quotas() quotas.Interface
+	GetQuotas() (quotas.Interface, error)
+	QuickLaunch() quicklaunch.Interface
+	GetQuickLaunch() (quicklaunch.Interface, error)
+	Tfs() tfs.Interface
+	GetTfs() (tfs.Interface, error)
+	ObservabilityPrometheus() prometheus.Interface
+	GetObservabilityPrometheus() (prometheus.Interface, error)
+	ContainerRegistry() containerregistry.Interface
+	GetContainerRegistry() (containerregistry.Interface, error)
 	// KMS returns a client for the Key Management Service.
-	KMS() *kms.Client
+	KMS() kms.Interface
+	GetKMS() (kms.Interface, error)
 	// Secrets returns a client for the Secrets Manager.
-	Secrets() *secrets.Client
+	Secrets() secrets.Interface
+	GetSecrets() (secrets.Interface, error)
 	// DNS returns a client for DNS zones and records.
-	DNS() *dns.Client
+	DNS() dns.Interface
+	GetDNS() (dns.Interface, error)
 	// Projects returns a client for organisation-scoped project management.
-	Projects() *projects.Client
-	// SetOrganisation sets the organisation for the client
+	Projects() projects.Interface
+	GetProjects() (projects.Interface, error)
+	// SetOrganisation sets the organisation for the client and all of its service clients
 	SetOrganisation(organisation string)
 	GetClient() client.Client
 }
 
-type thalassaCloudClient struct {
-	client client.Client
-}
+// Service names a service client of Client, for WithServiceOptions.
+type Service string
 
-// Option is a function that modifies the Client.
-type Option func(*Client) error
+const (
+	ServiceAudit                   Service = "audit"
+	ServiceContainerRegistry       Service = "containerregistry"
+	ServiceDBaaS                   Service = "dbaas"
+	ServiceDNS                     Service = "dns"
+	ServiceIaaS                    Service = "iaas"
+	ServiceIAM                     Service = "iam"
+	ServiceKMS                     Service = "kms"
+	ServiceKubernetes              Service = "kubernetes"
+	ServiceMe                      Service = "me"
+	ServiceObjectStorage           Service = "objectstorage"
+	ServiceObservabilityPrometheus Service = "prometheus"
+	ServiceProjects                Service = "projects"
+	ServiceQuickLaunch             Service = "quicklaunch"
+	ServiceQuotas                  Service = "quotas"
+	ServiceSecrets                 Service = "secrets"
+	ServiceTfs                     Service = "tfs"
+)
 
-// NewClient applies all options, configures authentication, and returns the client.
-func NewClient(opts ...client.Option) (Client, error) {
-	c, err := client.NewClient(opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &thalassaCloudClient{
-		client: c,
-	}, nil
+var services = []Service{
+	ServiceAudit, ServiceContainerRegistry, ServiceDBaaS, ServiceDNS, ServiceIaaS, ServiceIAM, ServiceKMS, ServiceKubernetes,
+	ServiceMe, ServiceObjectStorage, ServiceObservabilityPrometheus, ServiceProjects, ServiceQuickLaunch, ServiceQuotas, ServiceSecrets, ServiceTfs,
 }
 
-func (c *thalassaCloudClient) SetOrganisation(organisation string) {
-	c.client.SetOrganisation(organisation)
+var ErrClientAndProfile = errors.New("WithClient cannot be combined with a profile")
+
+// Option configures the Client built by New.
+type Option func(*options) error
+
+type options struct {
+	base           client.Client
+	clientOpts     []client.Option
+	serviceOptions map[Service][]client.Option
 }
 
-func (c *thalassaCloudClient) IaaS() *iaas.Client {
-	iaasClient, err := iaas.New(c.client)
-	if err != nil {
-		panic(err)
+// WithClient builds the Client on top of an existing base client.
+func WithClient(c client.Client) Option {
+	return func(o *options) error {
+		if c == nil {
+			return errors.New("client cannot be nil")
+		}
+		o.base = c
+		return nil
 	}
-	return iaasClient
 }
 
-func (c *thalassaCloudClient) Kubernetes() *kubernetes.Client {
-	kubernetesClient, err := kubernetes.New(c.client)
-	if err != nil {
-		panic(err)
+// WithClientOptions applies opts to the base client shared by all services.
+func WithClientOptions(opts ...client.Option) Option {
+	return func(o *options) error {
+		o.clientOpts = append(o.clientOpts, opts...)
+		return nil
 	}
-	return kubernetesClient
 }
 
-func (c *thalassaCloudClient) Me() *me.Client {
-	meClient, err := me.New(c.client)
-	if err != nil {
-		panic(err)
+// WithServiceOptions applies opts on top of the base client for a single service,
+// for example a longer timeout for object storage:
+//
+//	thalassa.WithServiceOptions(thalassa.ServiceObjectStorage, client.WithTimeout(10*time.Minute))
+//
+// The service gets its own clone of the base client; see client.Client.Clone.
+func WithServiceOptions(service Service, opts ...client.Option) Option {
+	return func(o *options) error {
+		if !isService(service) {
+			return fmt.Errorf("unknown service %q", service)
+		}
+		if o.serviceOptions == nil {
+			o.serviceOptions = map[Service][]client.Option{}
+		}
+		o.serviceOptions[service] = append(o.serviceOptions[service], opts...)
+		return nil
 	}
-	return meClient
 }
 
-func (c *thalassaCloudClient) DBaaS() *dbaas.Client {
-	dbaasClient, err := dbaas.New(c.client)
-	if err != nil {
-		panic(err)
+func isService(service Service) bool {
+	for _, s := range services {
+		if s == service {
+			return true
+		}
 	}
-	return dbaasClient
+	return false
 }
 
-func (c *thalassaCloudClient) IAM() *iam.Client {
-	iamClient, err := iam.New(c.client)
-	if err != nil {
-		panic(err)
+// lazy holds a service client that is created on first use.
+type lazy[T any] struct {
+	once sync.Once
+	svc  T
+	err  error
+
+	unavailableOnce sync.Once
+	unavailable     T
+}
+
+func (l *lazy[T]) get(build func() (T, error)) (T, error) {
+	l.once.Do(func() {
+		l.svc, l.err = build()
+	})
+	return l.svc, l.err
+}
+
+// service returns the service client, or if it cannot be created, a service client
+// on top of client.Unavailable whose calls all fail with the error.
+func (l *lazy[T]) service(get func() (T, error), wrap func(client.Client) T) T {
+	svc, err := get()
+	if err == nil {
+		return svc
 	}
-	return iamClient
+	l.unavailableOnce.Do(func() {
+		l.unavailable = wrap(client.Unavailable(err))
+	})
+	return l.unavailable
 }
 
-func (c *thalassaCloudClient) ObjectStorage() *objectstorage.Client {
-	objectStorageClient, err := objectstorage.New(c.client)
+type thalassaCloudClient struct {
+	client client.Client
+
+	// serviceClients are the clones of client for services with their own options.
+	serviceClients map[Service]client.Client
+
+	audit                   lazy[audit.Interface]
+	containerRegistry       lazy[containerregistry.Interface]
+	dbaas                   lazy[dbaas.Interface]
+	dns                     lazy[dns.Interface]
+	iaas                    lazy[iaas.Interface]
+	iam                     lazy[iam.Interface]
+	kms                     lazy[kms.Interface]
+	kubernetes              lazy[kubernetes.Interface]
+	me                      lazy[me.Interface]
+	objectStorage           lazy[objectstorage.Interface]
+	observabilityPrometheus lazy[prometheus.Interface]
+	projects                lazy[projects.Interface]
+	quickLaunch             lazy[quicklaunch.Interface]
+	quotas                  lazy[quotas.Interface]
+	secrets                 lazy[secrets.Interface]
+	tfs                     lazy[tfs.Interface]
+}
+
+// NewClient applies all options, configures authentication, and returns the client.
+func NewClient(opts ...client.Option) (Client, error) {
+	return New(WithClientOptions(opts...))
+}
+
+// NewClientFromProfile creates a client from the named profile of the configuration
+// file; see client.NewClientFromProfile. Options given through WithClientOptions take
+// precedence over the profile.
+func NewClientFromProfile(name string, opts ...Option) (Client, error) {
+	o, err := applyOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if o.base != nil {
+		return nil, ErrClientAndProfile
+	}
+	base, err := client.NewClientFromProfile(name, o.clientOpts...)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return objectStorageClient
+	return newFacade(base, o)
 }
 
-func (c *thalassaCloudClient) Quotas() *quotas.Client {
-	quotasClient, err := quotas.New(c.client)
+// New creates a client from opts. Without WithClient, a new base client is created
+// from the options given through WithClientOptions.
+func New(opts ...Option) (Client, error) {
+	o, err := applyOptions(opts)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return quotasClient
+	base := o.base
+	if base == nil {
+		if base, err = client.NewClient(o.clientOpts...); err != nil {
+			return nil, err
+		}
+	} else if len(o.clientOpts) > 0 {
+		if base, err = base.Clone(o.clientOpts...); err != nil {
+			return nil, err
+		}
+	}
+	return newFacade(base, o)
 }
 
-func (c *thalassaCloudClient) QuickLaunch() *quicklaunch.Client {
-	ql, err := quicklaunch.New(c.client)
-	if err != nil {
-		panic(err)
+func applyOptions(opts []Option) (*options, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
 	}
-	return ql
+	return o, nil
 }
 
-func (c *thalassaCloudClient) Audit() *audit.Client {
-	auditClient, err := audit.New(c.client)
-	if err != nil {
-		panic(err)
+// newFacade creates the clones for services with their own options up front, so that
+// invalid options are reported by the constructor.
+func newFacade(base client.Client, o *options) (*thalassaCloudClient, error) {
+	c := &thalassaCloudClient{
+		client:         base,
+		serviceClients: map[Service]client.Client{},
 	}
-	return auditClient
+	for service, opts := range o.serviceOptions {
+		svcClient, err := base.Clone(opts...)
+		if err != nil {
+			return nil, fmt.Errorf("options for service %s: %w", service, err)
+		}
+		c.serviceClients[service] = svcClient
+	}
+	return c, nil
 }
 
-func (c *thalassaCloudClient) Tfs() *tfs.Client {
-	tfsClient, err := tfs.New(c.client)
-	if err != nil {
-		panic(err)
+// clientFor returns the base client of service.
+func (c *thalassaCloudClient) clientFor(service Service) client.Client {
+	if svcClient, ok := c.serviceClients[service]; ok {
+		return svcClient
 	}
-	return tfsClient
+	return c.client
 }
 
-func (c *thalassaCloudClient) ObservabilityPrometheus() *prometheus.Client {
-	prometheusClient, err := prometheus.New(c.client)
-	if err != nil {
-		panic(err)
+func (c *thalassaCloudClient) SetOrganisation(organisation string) {
+	c.client.SetOrganisation(organisation)
+	for _, svcClient := range c.serviceClients {
+		svcClient.SetOrganisation(organisation)
 	}
-	return prometheusClient
+}
+
+func (c *thalassaCloudClient) GetIaaS() (iaas.Interface, error) {
+	return c.iaas.get(func() (iaas.Interface, error) { return iaas.New(c.clientFor(ServiceIaaS)) })
+}
+
+func (c *thalassaCloudClient) IaaS() iaas.Interface {
+	return c.iaas.service(c.GetIaaS, func(base client.Client) iaas.Interface { return &iaas.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetKubernetes() (kubernetes.Interface, error) {
+	return c.kubernetes.get(func() (kubernetes.Interface, error) { return kubernetes.New(c.clientFor(ServiceKubernetes)) })
+}
+
+func (c *thalassaCloudClient) Kubernetes() kubernetes.Interface {
+	return c.kubernetes.service(c.GetKubernetes, func(base client.Client) kubernetes.Interface { return &kubernetes.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetMe() (me.Interface, error) {
+	return c.me.get(func() (me.Interface, error) { return me.New(c.clientFor(ServiceMe)) })
+}
+
+func (c *thalassaCloudClient) Me() me.Interface {
+	return c.me.service(c.GetMe, func(base client.Client) me.Interface { return &me.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetDBaaS() (dbaas.Interface, error) {
+	return c.dbaas.get(func() (dbaas.Interface, error) { return dbaas.New(c.clientFor(ServiceDBaaS)) })
+}
+
+func (c *thalassaCloudClient) DBaaS() dbaas.Interface {
+	return c.dbaas.service(c.GetDBaaS, func(base client.Client) dbaas.Interface { return &dbaas.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetIAM() (iam.Interface, error) {
+	return c.iam.get(func() (iam.Interface, error) { return iam.New(c.clientFor(ServiceIAM)) })
+}
+
+func (c *thalassaCloudClient) IAM() iam.Interface {
+	return c.iam.service(c.GetIAM, func(base client.Client) iam.Interface { return &iam.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetObjectStorage() (objectstorage.Interface, error) {
+	return c.objectStorage.get(func() (objectstorage.Interface, error) {
+		return objectstorage.New(c.clientFor(ServiceObjectStorage))
+	})
+}
+
+func (c *thalassaCloudClient) ObjectStorage() objectstorage.Interface {
+	return c.objectStorage.service(c.GetObjectStorage, func(base client.Client) objectstorage.Interface { return &objectstorage.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetQuotas() (quotas.Interface, error) {
+	return c.quotas.get(func() (quotas.Interface, error) { return quotas.New(c.clientFor(ServiceQuotas)) })
+}
+
+func (c *thalassaCloudClient) Quotas() quotas.Interface {
+	return c.quotas.service(c.GetQuotas, func(base client.Client) quotas.Interface { return &quotas.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetQuickLaunch() (quicklaunch.Interface, error) {
+	return c.quickLaunch.get(func() (quicklaunch.Interface, error) {
+		return quicklaunch.New(c.clientFor(ServiceQuickLaunch))
+	})
+}
+
+func (c *thalassaCloudClient) QuickLaunch() quicklaunch.Interface {
+	return c.quickLaunch.service(c.GetQuickLaunch, func(base client.Client) quicklaunch.Interface { return &quicklaunch.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetAudit() (audit.Interface, error) {
+	return c.audit.get(func() (audit.Interface, error) { return audit.New(c.clientFor(ServiceAudit)) })
+}
+
+func (c *thalassaCloudClient) Audit() audit.Interface {
+	return c.audit.service(c.GetAudit, func(base client.Client) audit.Interface { return &audit.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetTfs() (tfs.Interface, error) {
+	return c.tfs.get(func() (tfs.Interface, error) { return tfs.New(c.clientFor(ServiceTfs)) })
+}
+
+func (c *thalassaCloudClient) Tfs() tfs.Interface {
+	return c.tfs.service(c.GetTfs, func(base client.Client) tfs.Interface { return &tfs.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetObservabilityPrometheus() (prometheus.Interface, error) {
+	return c.observabilityPrometheus.get(func() (prometheus.Interface, error) {
+		return prometheus.New(c.clientFor(ServiceObservabilityPrometheus))
+	})
+}
+
+func (c *thalassaCloudClient) ObservabilityPrometheus() prometheus.Interface {
+	return c.observabilityPrometheus.service(c.GetObservabilityPrometheus, func(base client.Client) prometheus.Interface { return &prometheus.Client{Client: base} })
 }
 
 // container registry
-func (c *thalassaCloudClient) ContainerRegistry() *containerregistry.Client {
-	containerRegistryClient, err := containerregistry.New(c.client)
-	if err != nil {
-		panic(err)
-	}
-	return containerRegistryClient
+func (c *thalassaCloudClient) GetContainerRegistry() (containerregistry.Interface, error) {
+	return c.containerRegistry.get(func() (containerregistry.Interface, error) {
+		return containerregistry.New(c.clientFor(ServiceContainerRegistry))
+	})
 }
 
-func (c *thalassaCloudClient) KMS() *kms.Client {
-	kmsClient, err := kms.New(c.client)
-	if err != nil {
-		panic(err)
-	}
-	return kmsClient
+func (c *thalassaCloudClient) ContainerRegistry() containerregistry.Interface {
+	return c.containerRegistry.service(c.GetContainerRegistry, func(base client.Client) containerregistry.Interface { return &containerregistry.Client{Client: base} })
 }
 
-func (c *thalassaCloudClient) Secrets() *secrets.Client {
-	secretsClient, err := secrets.New(c.client)
-	if err != nil {
-		panic(err)
-	}
-	return secretsClient
+func (c *thalassaCloudClient) GetKMS() (kms.Interface, error) {
+	return c.kms.get(func() (kms.Interface, error) { return kms.New(c.clientFor(ServiceKMS)) })
 }
 
-func (c *thalassaCloudClient) DNS() *dns.Client {
-	dnsClient, err := dns.New(c.client)
-	if err != nil {
-		panic(err)
-	}
-	return dnsClient
+func (c *thalassaCloudClient) KMS() kms.Interface {
+	return c.kms.service(c.GetKMS, func(base client.Client) kms.Interface { return &kms.Client{Client: base} })
 }
 
-func (c *thalassaCloudClient) Projects() *projects.Client {
-	projectsClient, err := projects.New(c.client)
-	if err != nil {
-		panic(err)
-	}
-	return projectsClient
+func (c *thalassaCloudClient) GetSecrets() (secrets.Interface, error) {
+	return c.secrets.get(func() (secrets.Interface, error) { return secrets.New(c.clientFor(ServiceSecrets)) })
+}
+
+func (c *thalassaCloudClient) Secrets() secrets.Interface {
+	return c.secrets.service(c.GetSecrets, func(base client.Client) secrets.Interface { return &secrets.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetDNS() (dns.Interface, error) {
+	return c.dns.get(func() (dns.Interface, error) { return dns.New(c.clientFor(ServiceDNS)) })
+}
+
+func (c *thalassaCloudClient) DNS() dns.Interface {
+	return c.dns.service(c.GetDNS, func(base client.Client) dns.Interface { return &dns.Client{Client: base} })
+}
+
+func (c *thalassaCloudClient) GetProjects() (projects.Interface, error) {
+	return c.projects.get(func() (projects.Interface, error) { return projects.New(c.clientFor(ServiceProjects)) })
+}
+
+func (c *thalassaCloudClient) Projects() projects.Interface {
+	return c.projects.service(c.GetProjects, func(base client.Client) projects.Interface { return &projects.Client{Client: base} })
 }
 
 func (c *thalassaCloudClient) GetClient() client.Client {
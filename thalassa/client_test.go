@@ -0,0 +1,105 @@
+package thalassa
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassatest"
+)
+
+func TestServiceClientsAreCached(t *testing.T) {
+	c, err := NewClient(client.WithBaseURL("https://api.example.com"), client.WithAuthNone())
+	require.NoError(t, err)
+
+	assert.Same(t, c.IaaS(), c.IaaS())
+	assert.Same(t, c.Kubernetes(), c.Kubernetes())
+	svc, err := c.GetIaaS()
+	require.NoError(t, err)
+	assert.Same(t, c.IaaS(), svc)
+}
+
+func TestFailedServiceClientDoesNotPanic(t *testing.T) {
+	boom := errors.New("boom")
+	c := &thalassaCloudClient{}
+	c.iaas.get(func() (iaas.Interface, error) { return nil, boom })
+
+	_, err := c.GetIaaS()
+	assert.ErrorIs(t, err, boom)
+	_, err = c.IaaS().GetVpc(context.Background(), "vpc-1")
+	assert.ErrorIs(t, err, boom)
+	for _, err := range c.IaaS().AllVpcs(context.Background(), nil) {
+		assert.ErrorIs(t, err, boom)
+	}
+	assert.Same(t, c.IaaS(), c.IaaS())
+	assert.IsType(t, &iaas.Client{}, c.IaaS())
+}
+
+func TestServiceOptions(t *testing.T) {
+	server := thalassatest.NewServer()
+	defer server.Close()
+	server.InjectFault(thalassatest.Fault{Latency: 200 * time.Millisecond})
+
+	c, err := New(
+		WithClientOptions(server.ClientOptions()...),
+		WithServiceOptions(ServiceObjectStorage, client.WithTimeout(20*time.Millisecond)),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = c.ObjectStorage().ListBuckets(ctx)
+	assert.Error(t, err, "object storage uses its own timeout")
+	_, err = c.IaaS().ListVpcs(ctx, nil)
+	assert.NoError(t, err, "other services use the base client")
+
+	// SetOrganisation reaches the clones of services with their own options.
+	c.SetOrganisation("org-other")
+	_, _ = c.ObjectStorage().ListBuckets(ctx)
+	requests := server.Requests()
+	assert.Equal(t, "org-other", requests[len(requests)-1].Header.Get("X-Organisation-Identity"))
+
+	_, err = New(WithServiceOptions("compute"))
+	assert.ErrorContains(t, err, "unknown service")
+	_, err = New(
+		WithClientOptions(server.ClientOptions()...),
+		WithServiceOptions(ServiceIaaS, client.WithRootCAs(nil)),
+	)
+	assert.ErrorContains(t, err, "options for service iaas")
+}
+
+func TestNewWithClient(t *testing.T) {
+	base, err := client.NewClient(client.WithBaseURL("https://api.example.com"), client.WithAuthNone(), client.WithOrganisation("org-1"))
+	require.NoError(t, err)
+
+	c, err := New(WithClient(base))
+	require.NoError(t, err)
+	assert.Same(t, base, c.GetClient())
+
+	c, err = New(WithClient(base), WithClientOptions(client.WithOrganisation("org-2")))
+	require.NoError(t, err)
+	assert.Equal(t, "org-2", c.GetClient().GetOrganisationIdentity())
+	assert.Equal(t, "org-1", base.GetOrganisationIdentity())
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	t.Setenv(client.EnvConfig, filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv(client.EnvContext, "")
+	t.Setenv(client.EnvAPIURL, "https://api.example.com")
+	t.Setenv(client.EnvOrganisation, "org-env")
+	t.Setenv(client.EnvToken, "pat-env")
+
+	c, err := NewClientFromProfile("", WithClientOptions(client.WithOrganisation("org-override")))
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com", c.GetClient().GetBaseURL())
+	assert.Equal(t, "org-override", c.GetClient().GetOrganisationIdentity())
+
+	_, err = NewClientFromProfile("", WithClient(c.GetClient()))
+	assert.ErrorIs(t, err, ErrClientAndProfile)
+}
@@ -0,0 +1,122 @@
+package thalassa
+
+import (
+	"github.com/thalassa-cloud/client-go/audit"
+	"github.com/thalassa-cloud/client-go/containerregistry"
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/dns"
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/iam"
+	"github.com/thalassa-cloud/client-go/kms"
+	"github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/me"
+	"github.com/thalassa-cloud/client-go/objectstorage"
+	"github.com/thalassa-cloud/client-go/observability/prometheus"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+	"github.com/thalassa-cloud/client-go/projects"
+	"github.com/thalassa-cloud/client-go/quicklaunch"
+	"github.com/thalassa-cloud/client-go/quotas"
+	"github.com/thalassa-cloud/client-go/secrets"
+	"github.com/thalassa-cloud/client-go/tfs"
+)
+
+// Fake is an implementation of Client backed by the fakes of the service packages,
+// for unit tests that should not make HTTP requests. Program the responses through
+// the service fakes, e.g. f.FakeIaaS.GetVpcFunc, and inspect their recorded calls.
+// Calls of SetOrganisation are recorded by the Fake itself.
+type Fake struct {
+	fake.Recorder
+
+	FakeAudit                   *audit.Fake
+	FakeContainerRegistry       *containerregistry.Fake
+	FakeDBaaS                   *dbaas.Fake
+	FakeDNS                     *dns.Fake
+	FakeIaaS                    *iaas.Fake
+	FakeIAM                     *iam.Fake
+	FakeKMS                     *kms.Fake
+	FakeKubernetes              *kubernetes.Fake
+	FakeMe                      *me.Fake
+	FakeObjectStorage           *objectstorage.Fake
+	FakeObservabilityPrometheus *prometheus.Fake
+	FakeProjects                *projects.Fake
+	FakeQuickLaunch             *quicklaunch.Fake
+	FakeQuotas                  *quotas.Fake
+	FakeSecrets                 *secrets.Fake
+	FakeTfs                     *tfs.Fake
+
+	// BaseClient is returned by GetClient. It is nil unless set by the test.
+	BaseClient client.Client
+}
+
+var _ Client = (*Fake)(nil)
+
+// NewFake returns a Fake with a fresh fake for every service.
+func NewFake() *Fake {
+	return &Fake{
+		FakeAudit:                   &audit.Fake{},
+		FakeContainerRegistry:       &containerregistry.Fake{},
+		FakeDBaaS:                   &dbaas.Fake{},
+		FakeDNS:                     &dns.Fake{},
+		FakeIaaS:                    &iaas.Fake{},
+		FakeIAM:                     &iam.Fake{},
+		FakeKMS:                     &kms.Fake{},
+		FakeKubernetes:              &kubernetes.Fake{},
+		FakeMe:                      &me.Fake{},
+		FakeObjectStorage:           &objectstorage.Fake{},
+		FakeObservabilityPrometheus: &prometheus.Fake{},
+		FakeProjects:                &projects.Fake{},
+		FakeQuickLaunch:             &quicklaunch.Fake{},
+		FakeQuotas:                  &quotas.Fake{},
+		FakeSecrets:                 &secrets.Fake{},
+		FakeTfs:                     &tfs.Fake{},
+	}
+}
+
+func (f *Fake) Audit() audit.Interface                         { return f.FakeAudit }
+func (f *Fake) ContainerRegistry() containerregistry.Interface { return f.FakeContainerRegistry }
+func (f *Fake) DBaaS() dbaas.Interface                         { return f.FakeDBaaS }
+func (f *Fake) DNS() dns.Interface                             { return f.FakeDNS }
+func (f *Fake) IaaS() iaas.Interface                           { return f.FakeIaaS }
+func (f *Fake) IAM() iam.Interface                             { return f.FakeIAM }
+func (f *Fake) KMS() kms.Interface                             { return f.FakeKMS }
+func (f *Fake) Kubernetes() kubernetes.Interface               { return f.FakeKubernetes }
+func (f *Fake) Me() me.Interface                               { return f.FakeMe }
+func (f *Fake) ObjectStorage() objectstorage.Interface         { return f.FakeObjectStorage }
+func (f *Fake) ObservabilityPrometheus() prometheus.Interface  { return f.FakeObservabilityPrometheus }
+func (f *Fake) Projects() projects.Interface                   { return f.FakeProjects }
+func (f *Fake) QuickLaunch() quicklaunch.Interface             { return f.FakeQuickLaunch }
+func (f *Fake) Quotas() quotas.Interface                       { return f.FakeQuotas }
+func (f *Fake) Secrets() secrets.Interface                     { return f.FakeSecrets }
+func (f *Fake) Tfs() tfs.Interface                             { return f.FakeTfs }
+
+func (f *Fake) GetAudit() (audit.Interface, error) { return f.FakeAudit, nil }
+func (f *Fake) GetContainerRegistry() (containerregistry.Interface, error) {
+	return f.FakeContainerRegistry, nil
+}
+func (f *Fake) GetDBaaS() (dbaas.Interface, error)                 { return f.FakeDBaaS, nil }
+func (f *Fake) GetDNS() (dns.Interface, error)                     { return f.FakeDNS, nil }
+func (f *Fake) GetIaaS() (iaas.Interface, error)                   { return f.FakeIaaS, nil }
+func (f *Fake) GetIAM() (iam.Interface, error)                     { return f.FakeIAM, nil }
+func (f *Fake) GetKMS() (kms.Interface, error)                     { return f.FakeKMS, nil }
+func (f *Fake) GetKubernetes() (kubernetes.Interface, error)       { return f.FakeKubernetes, nil }
+func (f *Fake) GetMe() (me.Interface, error)                       { return f.FakeMe, nil }
+func (f *Fake) GetObjectStorage() (objectstorage.Interface, error) { return f.FakeObjectStorage, nil }
+func (f *Fake) GetObservabilityPrometheus() (prometheus.Interface, error) {
+	return f.FakeObservabilityPrometheus, nil
+}
+func (f *Fake) GetProjects() (projects.Interface, error)       { return f.FakeProjects, nil }
+func (f *Fake) GetQuickLaunch() (quicklaunch.Interface, error) { return f.FakeQuickLaunch, nil }
+func (f *Fake) GetQuotas() (quotas.Interface, error)           { return f.FakeQuotas, nil }
+func (f *Fake) GetSecrets() (secrets.Interface, error)         { return f.FakeSecrets, nil }
+func (f *Fake) GetTfs() (tfs.Interface, error)                 { return f.FakeTfs, nil }
+
+// SetOrganisation records the call.
+func (f *Fake) SetOrganisation(organisation string) {
+	f.Record("SetOrganisation", organisation)
+}
+
+// GetClient returns BaseClient.
+func (f *Fake) GetClient() client.Client {
+	return f.BaseClient
+}
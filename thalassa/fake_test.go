@@ -0,0 +1,59 @@
+package thalassa
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/pkg/fake"
+)
+
+// vpcStatus is a stand-in for controller code that only depends on Client.
+func vpcStatus(ctx context.Context, c Client, identity string) (string, error) {
+	vpc, err := c.IaaS().GetVpc(ctx, identity)
+	if err != nil {
+		return "", err
+	}
+	return vpc.Status, nil
+}
+
+func TestFake(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+	f.FakeIaaS.GetVpcFunc = func(ctx context.Context, identity string) (*iaas.Vpc, error) {
+		if identity == "vpc-missing" {
+			return nil, errors.New("not found")
+		}
+		return &iaas.Vpc{Identity: identity, Status: "ready"}, nil
+	}
+
+	status, err := vpcStatus(ctx, f, "vpc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ready", status)
+	_, err = vpcStatus(ctx, f, "vpc-missing")
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, f.FakeIaaS.CallCount("GetVpc"))
+	assert.Equal(t, []fake.Call{
+		{Method: "GetVpc", Args: []any{ctx, "vpc-1"}},
+		{Method: "GetVpc", Args: []any{ctx, "vpc-missing"}},
+	}, f.FakeIaaS.Calls())
+
+	// Unprogrammed methods return zero values and empty iterators.
+	vpcs, err := f.IaaS().ListVpcs(ctx, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, vpcs)
+	for range f.IaaS().AllVpcs(ctx, nil) {
+		t.Fatal("empty iterator yielded")
+	}
+
+	f.SetOrganisation("org-1")
+	assert.Equal(t, []fake.Call{{Method: "SetOrganisation", Args: []any{"org-1"}}}, f.CallsTo("SetOrganisation"))
+
+	f.FakeIaaS.Reset()
+	assert.Empty(t, f.FakeIaaS.Calls())
+}
@@ -0,0 +1,201 @@
+package thalassa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/thalassa-cloud/client-go/pkg/base"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// DefaultFanOutParallelism is the number of organisations FanOut queries concurrently
+// when FanOutOptions.Parallelism is not set.
+const DefaultFanOutParallelism = 4
+
+// FanOutOptions configures FanOut.
+type FanOutOptions struct {
+	// Organisations are the identities or slugs of the organisations to query. Slugs
+	// are resolved through the memberships of the client. When empty, all
+	// organisations the client is a member of are queried.
+	Organisations []string
+	// Parallelism is the maximum number of organisations queried concurrently.
+	Parallelism int
+}
+
+// OrganisationResult is an item returned by a FanOut operation, tagged with the
+// organisation it was returned for.
+type OrganisationResult[T any] struct {
+	Organisation base.Organisation
+	Item         T
+}
+
+// OrganisationError is the failure of a FanOut operation for one organisation.
+type OrganisationError struct {
+	Organisation base.Organisation
+	Err          error
+}
+
+func (e *OrganisationError) Error() string {
+	name := e.Organisation.Identity
+	if name == "" {
+		name = e.Organisation.Slug
+	}
+	return fmt.Sprintf("organisation %s: %v", name, e.Err)
+}
+
+func (e *OrganisationError) Unwrap() error {
+	return e.Err
+}
+
+// FanOutError aggregates the organisations for which a FanOut operation failed.
+// errors.Is and errors.As match the errors of the individual organisations.
+type FanOutError struct {
+	Failures []*OrganisationError
+}
+
+func (e *FanOutError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		msgs[i] = failure.Error()
+	}
+	return fmt.Sprintf("%d organisation(s) failed: %s", len(e.Failures), strings.Join(msgs, "; "))
+}
+
+func (e *FanOutError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure
+	}
+	return errs
+}
+
+// FanOut runs op for each organisation, concurrently but with at most
+// opts.Parallelism operations in flight, and returns the items of all organisations
+// in the order of the organisations. op receives a client and a context scoped to
+// the organisation; the shared client c is not modified.
+//
+// If op fails for some organisations, FanOut returns the items of the others
+// together with a *FanOutError listing the failures.
+//
+//	vpcs, err := thalassa.FanOut(ctx, tc, thalassa.FanOutOptions{}, func(ctx context.Context, c thalassa.Client) ([]iaas.Vpc, error) {
+//		return c.IaaS().ListVpcs(ctx, nil)
+//	})
+func FanOut[T any](ctx context.Context, c Client, opts FanOutOptions, op func(ctx context.Context, c Client) ([]T, error)) ([]OrganisationResult[T], error) {
+	organisations, errs, err := fanOutOrganisations(ctx, c, opts.Organisations)
+	if err != nil {
+		return nil, err
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultFanOutParallelism
+	}
+
+	items := make([][]T, len(organisations))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, organisation := range organisations {
+		if errs[i] != nil {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			items[i], errs[i] = runForOrganisation(ctx, c, organisation.Identity, op)
+		}()
+	}
+	wg.Wait()
+
+	var results []OrganisationResult[T]
+	var fanOutErr FanOutError
+	for i, organisation := range organisations {
+		if errs[i] != nil {
+			fanOutErr.Failures = append(fanOutErr.Failures, &OrganisationError{Organisation: organisation, Err: errs[i]})
+			continue
+		}
+		for _, item := range items[i] {
+			results = append(results, OrganisationResult[T]{Organisation: organisation, Item: item})
+		}
+	}
+	if len(fanOutErr.Failures) > 0 {
+		return results, &fanOutErr
+	}
+	return results, nil
+}
+
+func runForOrganisation[T any](ctx context.Context, c Client, organisation string, op func(ctx context.Context, c Client) ([]T, error)) ([]T, error) {
+	orgClient := c
+	if facade, ok := c.(*thalassaCloudClient); ok {
+		var err error
+		if orgClient, err = facade.forOrganisation(organisation); err != nil {
+			return nil, err
+		}
+	}
+	return op(client.WithRequestOrganisation(ctx, organisation), orgClient)
+}
+
+// organisationIdentity matches organisation identities, which are "org-" followed by
+// an xid. Other references are slugs.
+var organisationIdentity = regexp.MustCompile(`^org-[0-9a-v]{20}$`)
+
+// fanOutOrganisations resolves the organisations to query. Requested organisations
+// are matched against the memberships by identity or slug, and the memberships are
+// only listed when a requested organisation is not an identity. A slug that does not
+// match a membership fails with ErrNotFound rather than being sent as an identity.
+func fanOutOrganisations(ctx context.Context, c Client, requested []string) ([]base.Organisation, []error, error) {
+	if len(requested) > 0 && !slices.ContainsFunc(requested, func(ref string) bool { return !organisationIdentity.MatchString(ref) }) {
+		organisations := make([]base.Organisation, len(requested))
+		for i, ref := range requested {
+			organisations[i] = base.Organisation{Identity: ref}
+		}
+		return organisations, make([]error, len(requested)), nil
+	}
+	memberships, err := c.Me().ListMyOrganisations(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list organisations: %w", err)
+	}
+	if len(requested) == 0 {
+		return memberships, make([]error, len(memberships)), nil
+	}
+	organisations := make([]base.Organisation, len(requested))
+	errs := make([]error, len(requested))
+	for i, ref := range requested {
+		idx := slices.IndexFunc(memberships, func(m base.Organisation) bool { return m.Identity == ref || m.Slug == ref })
+		switch {
+		case idx >= 0:
+			organisations[i] = memberships[idx]
+		case organisationIdentity.MatchString(ref):
+			organisations[i] = base.Organisation{Identity: ref}
+		default:
+			organisations[i] = base.Organisation{Slug: ref}
+			errs[i] = fmt.Errorf("%w: no membership of organisation %q", client.ErrNotFound, ref)
+		}
+	}
+	return organisations, errs, nil
+}
+
+// forOrganisation returns a copy of the client, including its per-service clients,
+// bound to organisation.
+func (c *thalassaCloudClient) forOrganisation(organisation string) (*thalassaCloudClient, error) {
+	baseClient, err := c.client.Clone(client.WithOrganisation(organisation))
+	if err != nil {
+		return nil, err
+	}
+	clone := &thalassaCloudClient{client: baseClient, serviceClients: map[Service]client.Client{}}
+	for service, svcClient := range c.serviceClients {
+		if clone.serviceClients[service], err = svcClient.Clone(client.WithOrganisation(organisation)); err != nil {
+			return nil, fmt.Errorf("options for service %s: %w", service, err)
+		}
+	}
+	return clone, nil
+}
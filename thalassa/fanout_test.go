@@ -0,0 +1,148 @@
+package thalassa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/pkg/base"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// multiOrgServer serves memberships of three organisations and a VPC per
+// organisation. Listing VPCs is forbidden in org-b.
+type multiOrgServer struct {
+	mu           sync.Mutex
+	inFlight     int
+	peak         int
+	lookups      int
+	lookupStatus int
+}
+
+func (s *multiOrgServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/v1/me/organisation-memberships":
+		s.mu.Lock()
+		s.lookups++
+		s.mu.Unlock()
+		if s.lookupStatus != 0 {
+			w.WriteHeader(s.lookupStatus)
+			_, _ = w.Write([]byte(`{"message":"unavailable"}`))
+			return
+		}
+		var memberships []base.OrganisationMember
+		for _, slug := range []string{"a", "b", "c"} {
+			memberships = append(memberships, base.OrganisationMember{
+				Organisation: &base.Organisation{Identity: "org-" + slug, Slug: "slug-" + slug},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(memberships)
+	case "/v1/vpcs":
+		s.mu.Lock()
+		s.inFlight++
+		s.peak = max(s.peak, s.inFlight)
+		s.mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+
+		organisation := r.Header.Get("X-Organisation-Identity")
+		if organisation == "org-b" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"forbidden"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]iaas.Vpc{{Identity: "vpc-" + organisation}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func listVpcs(ctx context.Context, c Client) ([]iaas.Vpc, error) {
+	return c.IaaS().ListVpcs(ctx, nil)
+}
+
+func TestFanOut(t *testing.T) {
+	server := &multiOrgServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	c, err := NewClient(client.WithBaseURL(ts.URL), client.WithAuthNone(), client.WithOrganisation("org-home"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	results, err := FanOut(ctx, c, FanOutOptions{Parallelism: 2}, listVpcs)
+	var fanOutErr *FanOutError
+	require.True(t, errors.As(err, &fanOutErr))
+	require.Len(t, fanOutErr.Failures, 1)
+	assert.Equal(t, "org-b", fanOutErr.Failures[0].Organisation.Identity)
+	assert.ErrorIs(t, err, client.ErrForbidden)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "org-a", results[0].Organisation.Identity)
+	assert.Equal(t, "vpc-org-a", results[0].Item.Identity)
+	assert.Equal(t, "org-c", results[1].Organisation.Identity)
+	assert.Equal(t, "vpc-org-c", results[1].Item.Identity)
+	assert.LessOrEqual(t, server.peak, 2)
+
+	// The shared client is not modified.
+	assert.Equal(t, "org-home", c.GetClient().GetOrganisationIdentity())
+
+	t.Run("selected organisations", func(t *testing.T) {
+		results, err := FanOut(ctx, c, FanOutOptions{Organisations: []string{"slug-c", "org-a"}, Parallelism: 1}, listVpcs)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "slug-c", results[0].Organisation.Slug)
+		assert.Equal(t, "vpc-org-c", results[0].Item.Identity)
+		assert.Equal(t, "vpc-org-a", results[1].Item.Identity)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := FanOut(ctx, c, FanOutOptions{Organisations: []string{"org-a"}}, listVpcs)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("identities skip the lookup", func(t *testing.T) {
+		const identity = "org-d0s6p0k5b7jc73aq5p2g"
+		lookups := server.lookups
+		results, err := FanOut(ctx, c, FanOutOptions{Organisations: []string{identity}}, listVpcs)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, identity, results[0].Organisation.Identity)
+		assert.Equal(t, "vpc-"+identity, results[0].Item.Identity)
+		assert.Equal(t, lookups, server.lookups)
+	})
+
+	t.Run("unknown slug", func(t *testing.T) {
+		results, err := FanOut(ctx, c, FanOutOptions{Organisations: []string{"slug-a", "slug-unknown"}}, listVpcs)
+		var fanOutErr *FanOutError
+		require.True(t, errors.As(err, &fanOutErr))
+		require.Len(t, fanOutErr.Failures, 1)
+		assert.Equal(t, "slug-unknown", fanOutErr.Failures[0].Organisation.Slug)
+		assert.ErrorIs(t, err, client.ErrNotFound)
+		require.Len(t, results, 1)
+		assert.Equal(t, "vpc-org-a", results[0].Item.Identity)
+	})
+
+	t.Run("lookup fails", func(t *testing.T) {
+		server.lookupStatus = http.StatusServiceUnavailable
+		defer func() { server.lookupStatus = 0 }()
+		results, err := FanOut(ctx, c, FanOutOptions{Organisations: []string{"slug-a"}}, listVpcs)
+		require.Error(t, err)
+		assert.Empty(t, results)
+		var fanOutErr *FanOutError
+		assert.False(t, errors.As(err, &fanOutErr))
+	})
+}
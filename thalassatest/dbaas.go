@@ -0,0 +1,121 @@
+package thalassatest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+)
+
+func (s *Server) registerDBaaS(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+dbaas.DbClusterEndpoint, s.listDbClusters)
+	mux.HandleFunc("POST "+dbaas.DbClusterEndpoint, s.createDbCluster)
+	mux.HandleFunc("GET "+dbaas.DbClusterEndpoint+"/{identity}", s.getDbCluster)
+	mux.HandleFunc("PUT "+dbaas.DbClusterEndpoint+"/{identity}", s.updateDbCluster)
+	mux.HandleFunc("DELETE "+dbaas.DbClusterEndpoint+"/{identity}", s.deleteDbCluster)
+}
+
+func (s *Server) listDbClusters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.dbClusters.list("")))
+}
+
+func (s *Server) getDbCluster(w http.ResponseWriter, r *http.Request) {
+	if cluster, ok := s.dbClusters.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, cluster)
+	}
+}
+
+func (s *Server) createDbCluster(w http.ResponseWriter, r *http.Request) {
+	var create dbaas.CreateDbClusterRequest
+	if !decode(w, r, &create) {
+		return
+	}
+	subnet, ok := s.subnets.get(create.SubnetIdentity)
+	if !ok {
+		fieldError(w, "subnetIdentity", fmt.Sprintf("subnet %q not found", create.SubnetIdentity))
+		return
+	}
+	now := time.Now().UTC()
+	subnetCopy := *subnet
+	cluster := &dbaas.DbCluster{
+		Identity:                s.newIdentity("dbcluster"),
+		Name:                    create.Name,
+		Slug:                    slugify(create.Name),
+		Description:             create.Description,
+		CreatedAt:               now,
+		UpdatedAt:               now,
+		ObjectVersion:           1,
+		Labels:                  create.Labels,
+		Annotations:             create.Annotations,
+		Organisation:            s.organisation(),
+		Vpc:                     subnet.Vpc,
+		Subnet:                  &subnetCopy,
+		Replicas:                create.Replicas,
+		Engine:                  create.Engine,
+		EngineVersion:           create.EngineVersion,
+		Parameters:              create.Parameters,
+		AllocatedStorage:        create.AllocatedStorage,
+		AutoMinorVersionUpgrade: create.AutoMinorVersionUpgrade,
+		DeleteProtection:        create.DeleteProtection,
+		Status:                  dbaas.DbClusterStatusCreating,
+		Port:                    5432,
+	}
+	s.dbClusters.put(cluster.Identity, cluster)
+	s.schedule("dbcluster/"+cluster.Identity, func() { cluster.Status = dbaas.DbClusterStatusReady })
+	writeJSON(w, http.StatusCreated, cluster)
+}
+
+func (s *Server) updateDbCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.dbClusters.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, cluster.ObjectVersion) {
+		return
+	}
+	var update dbaas.UpdateDbClusterRequest
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.AllocatedStorage != 0 && update.AllocatedStorage < cluster.AllocatedStorage {
+		fieldError(w, "allocatedStorage", "storage cannot be shrunk")
+		return
+	}
+	cluster.Name = update.Name
+	cluster.Description = update.Description
+	cluster.Labels = update.Labels
+	cluster.Annotations = update.Annotations
+	cluster.DeleteProtection = update.DeleteProtection
+	if update.EngineVersion != nil {
+		cluster.EngineVersion = *update.EngineVersion
+	}
+	if update.Parameters != nil {
+		cluster.Parameters = update.Parameters
+	}
+	if update.AllocatedStorage != 0 {
+		cluster.AllocatedStorage = update.AllocatedStorage
+	}
+	if update.Replicas != 0 {
+		cluster.Replicas = update.Replicas
+	}
+	cluster.ObjectVersion++
+	cluster.UpdatedAt = time.Now().UTC()
+	cluster.Status = dbaas.DbClusterStatusUpdating
+	s.schedule("dbcluster/"+cluster.Identity, func() { cluster.Status = dbaas.DbClusterStatusReady })
+	writeJSON(w, http.StatusOK, cluster)
+}
+
+func (s *Server) deleteDbCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.dbClusters.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if cluster.DeleteProtection {
+		writeError(w, http.StatusConflict, fmt.Sprintf("database cluster %s has delete protection enabled", cluster.Identity))
+		return
+	}
+	cluster.Status = dbaas.DbClusterStatusDeleting
+	s.schedule("dbcluster/"+cluster.Identity, func() { s.dbClusters.remove(cluster.Identity) })
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,202 @@
+package thalassatest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/dns"
+)
+
+const defaultRecordTTL = 3600
+
+func (s *Server) registerDNS(mux *http.ServeMux) {
+	zones := dns.DnsEndpoint + "/zones"
+	mux.HandleFunc("GET "+zones, s.listZones)
+	mux.HandleFunc("POST "+zones, s.createZone)
+	mux.HandleFunc("GET "+zones+"/{zone}", s.getZone)
+	mux.HandleFunc("PUT "+zones+"/{zone}", s.updateZone)
+	mux.HandleFunc("DELETE "+zones+"/{zone}", s.deleteZone)
+
+	records := zones + "/{zone}/records"
+	mux.HandleFunc("GET "+records, s.listRecords)
+	mux.HandleFunc("POST "+records, s.createRecord)
+	mux.HandleFunc("GET "+records+"/{identity}", s.getRecord)
+	mux.HandleFunc("PUT "+records+"/{identity}", s.updateRecord)
+	mux.HandleFunc("DELETE "+records+"/{identity}", s.deleteRecord)
+}
+
+// Zones
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.zones.list("")))
+}
+
+func (s *Server) getZone(w http.ResponseWriter, r *http.Request) {
+	if zone, ok := s.zones.lookup(w, r.PathValue("zone")); ok {
+		writeJSON(w, http.StatusOK, zone)
+	}
+}
+
+func (s *Server) createZone(w http.ResponseWriter, r *http.Request) {
+	var create dns.CreateDnsZoneRequest
+	if !decode(w, r, &create) {
+		return
+	}
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(create.ZoneName)), ".")
+	if name == "" {
+		fieldError(w, "zoneName", "is required")
+		return
+	}
+	for _, zone := range s.zones.list("") {
+		if zone.Name == name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("zone %s already exists", name))
+			return
+		}
+	}
+	zone := &dns.DnsZone{
+		Identity:      s.newIdentity("zone"),
+		Name:          name,
+		Slug:          slugify(name),
+		Description:   create.Description,
+		Labels:        create.Labels,
+		Annotations:   create.Annotations,
+		CreatedAt:     time.Now().UTC(),
+		ObjectVersion: 1,
+	}
+	s.zones.put(zone.Identity, zone)
+	writeJSON(w, http.StatusCreated, zone)
+}
+
+func (s *Server) updateZone(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, int(zone.ObjectVersion)) {
+		return
+	}
+	var update dns.UpdateDnsZoneRequest
+	if !decode(w, r, &update) {
+		return
+	}
+	zone.Description = update.Description
+	zone.Labels = update.Labels
+	zone.Annotations = update.Annotations
+	s.touchZone(zone)
+	writeJSON(w, http.StatusOK, zone)
+}
+
+// touchZone bumps the object version of a zone after it or one of its records changed.
+func (s *Server) touchZone(zone *dns.DnsZone) {
+	now := time.Now().UTC()
+	zone.UpdatedAt = &now
+	zone.ObjectVersion++
+}
+
+func (s *Server) deleteZone(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	s.zones.remove(zone.Identity)
+	for _, record := range s.records.list(zone.Identity + "/") {
+		s.records.remove(zone.Identity + "/" + record.Identity)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Records
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, filterList(r, s.records.list(zone.Identity+"/")))
+}
+
+func (s *Server) getRecord(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.zones.lookup(w, r.PathValue("zone")); !ok {
+		return
+	}
+	if record, ok := s.records.lookup(w, r.PathValue("zone")+"/"+r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, record)
+	}
+}
+
+func (s *Server) createRecord(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	var create dns.CreateDnsRecordRequest
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Type == "" {
+		fieldError(w, "type", "is required")
+		return
+	}
+	if len(create.Values) == 0 {
+		fieldError(w, "values", "at least one value is required")
+		return
+	}
+	ttl := create.TTL
+	if ttl == 0 {
+		ttl = defaultRecordTTL
+	}
+	record := &dns.DnsRecord{
+		Identity:  s.newIdentity("record"),
+		Name:      create.Name,
+		Type:      create.Type,
+		TTL:       ttl,
+		Values:    create.Values,
+		CreatedAt: time.Now().UTC(),
+	}
+	s.records.put(zone.Identity+"/"+record.Identity, record)
+	s.touchZone(zone)
+	writeJSON(w, http.StatusCreated, record)
+}
+
+func (s *Server) updateRecord(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	record, ok := s.records.lookup(w, zone.Identity+"/"+r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	var update dns.UpdateDnsRecordRequest
+	if !decode(w, r, &update) {
+		return
+	}
+	if len(update.Values) == 0 {
+		fieldError(w, "values", "at least one value is required")
+		return
+	}
+	if update.TTL != 0 {
+		record.TTL = update.TTL
+	}
+	record.Values = update.Values
+	now := time.Now().UTC()
+	record.UpdatedAt = &now
+	s.touchZone(zone)
+	writeJSON(w, http.StatusOK, record)
+}
+
+func (s *Server) deleteRecord(w http.ResponseWriter, r *http.Request) {
+	zone, ok := s.zones.lookup(w, r.PathValue("zone"))
+	if !ok {
+		return
+	}
+	key := zone.Identity + "/" + r.PathValue("identity")
+	if _, ok := s.records.lookup(w, key); !ok {
+		return
+	}
+	s.records.remove(key)
+	s.touchZone(zone)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,507 @@
+package thalassatest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+)
+
+func (s *Server) registerIaaS(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+iaas.VpcEndpoint, s.listVpcs)
+	mux.HandleFunc("POST "+iaas.VpcEndpoint, s.createVpc)
+	mux.HandleFunc("GET "+iaas.VpcEndpoint+"/{identity}", s.getVpc)
+	mux.HandleFunc("PUT "+iaas.VpcEndpoint+"/{identity}", s.updateVpc)
+	mux.HandleFunc("DELETE "+iaas.VpcEndpoint+"/{identity}", s.deleteVpc)
+
+	mux.HandleFunc("GET "+iaas.SubnetEndpoint, s.listSubnets)
+	mux.HandleFunc("POST "+iaas.SubnetEndpoint, s.createSubnet)
+	mux.HandleFunc("GET "+iaas.SubnetEndpoint+"/{identity}", s.getSubnet)
+	mux.HandleFunc("PUT "+iaas.SubnetEndpoint+"/{identity}", s.updateSubnet)
+	mux.HandleFunc("DELETE "+iaas.SubnetEndpoint+"/{identity}", s.deleteSubnet)
+
+	mux.HandleFunc("GET "+iaas.MachineEndpoint, s.listMachines)
+	mux.HandleFunc("POST "+iaas.MachineEndpoint, s.createMachine)
+	mux.HandleFunc("GET "+iaas.MachineEndpoint+"/{identity}", s.getMachine)
+	mux.HandleFunc("PUT "+iaas.MachineEndpoint+"/{identity}", s.updateMachine)
+	mux.HandleFunc("DELETE "+iaas.MachineEndpoint+"/{identity}", s.deleteMachine)
+	mux.HandleFunc("POST "+iaas.MachineEndpoint+"/{identity}/start", s.setMachineState(iaas.MachineStateRunning))
+	mux.HandleFunc("POST "+iaas.MachineEndpoint+"/{identity}/stop", s.setMachineState(iaas.MachineStateStopped))
+	mux.HandleFunc("POST "+iaas.MachineEndpoint+"/{identity}/restart", s.setMachineState(iaas.MachineStateRunning))
+
+	mux.HandleFunc("GET "+iaas.VolumeEndpoint, s.listVolumes)
+	mux.HandleFunc("POST "+iaas.VolumeEndpoint, s.createVolume)
+	mux.HandleFunc("GET "+iaas.VolumeEndpoint+"/{identity}", s.getVolume)
+	mux.HandleFunc("PUT "+iaas.VolumeEndpoint+"/{identity}", s.updateVolume)
+	mux.HandleFunc("DELETE "+iaas.VolumeEndpoint+"/{identity}", s.deleteVolume)
+	mux.HandleFunc("POST "+iaas.VolumeEndpoint+"/{identity}/attach", s.attachVolume)
+	mux.HandleFunc("POST "+iaas.VolumeEndpoint+"/{identity}/detach", s.detachVolume)
+}
+
+// VPCs
+
+func (s *Server) listVpcs(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.vpcs.list("")))
+}
+
+func (s *Server) getVpc(w http.ResponseWriter, r *http.Request) {
+	if vpc, ok := s.vpcs.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, vpc)
+	}
+}
+
+func (s *Server) createVpc(w http.ResponseWriter, r *http.Request) {
+	var create iaas.CreateVpc
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	now := time.Now().UTC()
+	vpc := &iaas.Vpc{
+		Identity:      s.newIdentity("vpc"),
+		Name:          create.Name,
+		Slug:          slugify(create.Name),
+		Description:   create.Description,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		ObjectVersion: 1,
+		Status:        "provisioning",
+		Labels:        create.Labels,
+		Annotations:   create.Annotations,
+		CIDRs:         create.VpcCidrs,
+		Organisation:  s.organisation(),
+		CloudRegion:   &iaas.Region{Identity: create.CloudRegionIdentity, Slug: create.CloudRegionIdentity},
+	}
+	s.vpcs.put(vpc.Identity, vpc)
+	s.schedule("vpc/"+vpc.Identity, func() { vpc.Status = "ready" })
+	writeJSON(w, http.StatusCreated, vpc)
+}
+
+func (s *Server) updateVpc(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.vpcs.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, vpc.ObjectVersion) {
+		return
+	}
+	var update iaas.UpdateVpc
+	if !decode(w, r, &update) {
+		return
+	}
+	vpc.Name = update.Name
+	vpc.Description = update.Description
+	vpc.Labels = update.Labels
+	vpc.Annotations = update.Annotations
+	if len(update.VpcCidrs) > 0 {
+		vpc.CIDRs = update.VpcCidrs
+	}
+	vpc.ObjectVersion++
+	vpc.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, vpc)
+}
+
+func (s *Server) deleteVpc(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.vpcs.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	for _, subnet := range s.subnets.list("") {
+		if subnet.VpcIdentity == vpc.Identity {
+			writeError(w, http.StatusConflict, fmt.Sprintf("vpc %s still has subnets", vpc.Identity))
+			return
+		}
+	}
+	vpc.Status = "deleting"
+	s.schedule("vpc/"+vpc.Identity, func() { s.vpcs.remove(vpc.Identity) })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Subnets
+
+func (s *Server) listSubnets(w http.ResponseWriter, r *http.Request) {
+	items := s.subnets.list("")
+	if vpc := r.URL.Query().Get("vpc"); vpc != "" {
+		filtered := items[:0:0]
+		for _, subnet := range items {
+			if subnet.VpcIdentity == vpc {
+				filtered = append(filtered, subnet)
+			}
+		}
+		items = filtered
+	}
+	writeJSON(w, http.StatusOK, filterList(r, items))
+}
+
+func (s *Server) getSubnet(w http.ResponseWriter, r *http.Request) {
+	if subnet, ok := s.subnets.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, subnet)
+	}
+}
+
+func (s *Server) createSubnet(w http.ResponseWriter, r *http.Request) {
+	var create iaas.CreateSubnet
+	if !decode(w, r, &create) {
+		return
+	}
+	vpc, ok := s.vpcs.get(create.VpcIdentity)
+	if !ok {
+		fieldError(w, "vpcIdentity", fmt.Sprintf("vpc %q not found", create.VpcIdentity))
+		return
+	}
+	if create.Cidr == "" {
+		fieldError(w, "cidr", "is required")
+		return
+	}
+	now := time.Now().UTC()
+	vpcCopy := *vpc
+	subnet := &iaas.Subnet{
+		Identity:      s.newIdentity("subnet"),
+		Name:          create.Name,
+		Slug:          slugify(create.Name),
+		Description:   create.Description,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		ObjectVersion: 1,
+		Labels:        create.Labels,
+		Annotations:   create.Annotations,
+		Type:          iaas.SubnetTypeIPv4,
+		VpcIdentity:   vpc.Identity,
+		Vpc:           &vpcCopy,
+		Cidr:          create.Cidr,
+		Status:        iaas.SubnetStatusCreating,
+	}
+	s.subnets.put(subnet.Identity, subnet)
+	s.schedule("subnet/"+subnet.Identity, func() { subnet.Status = iaas.SubnetStatusReady })
+	writeJSON(w, http.StatusCreated, subnet)
+}
+
+func (s *Server) updateSubnet(w http.ResponseWriter, r *http.Request) {
+	subnet, ok := s.subnets.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, subnet.ObjectVersion) {
+		return
+	}
+	var update iaas.UpdateSubnet
+	if !decode(w, r, &update) {
+		return
+	}
+	subnet.Name = update.Name
+	subnet.Description = update.Description
+	subnet.Labels = update.Labels
+	subnet.Annotations = update.Annotations
+	subnet.ObjectVersion++
+	subnet.UpdatedAt = time.Now().UTC()
+	subnet.Status = iaas.SubnetStatusUpdating
+	s.schedule("subnet/"+subnet.Identity, func() { subnet.Status = iaas.SubnetStatusReady })
+	writeJSON(w, http.StatusOK, subnet)
+}
+
+func (s *Server) deleteSubnet(w http.ResponseWriter, r *http.Request) {
+	subnet, ok := s.subnets.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	subnet.Status = iaas.SubnetStatusDeleting
+	s.schedule("subnet/"+subnet.Identity, func() { s.subnets.remove(subnet.Identity) })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Machines
+
+func (s *Server) listMachines(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.machines.list("")))
+}
+
+func (s *Server) getMachine(w http.ResponseWriter, r *http.Request) {
+	if machine, ok := s.machines.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, machine)
+	}
+}
+
+func (s *Server) createMachine(w http.ResponseWriter, r *http.Request) {
+	var create iaas.CreateMachine
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	subnet, ok := s.subnets.get(create.Subnet)
+	if !ok {
+		fieldError(w, "subnet", fmt.Sprintf("subnet %q not found", create.Subnet))
+		return
+	}
+	state := iaas.MachineStateRunning
+	if create.State != nil {
+		state = *create.State
+	}
+	now := time.Now().UTC()
+	subnetCopy := *subnet
+	machine := &iaas.Machine{
+		Identity:                 s.newIdentity("machine"),
+		Name:                     create.Name,
+		Slug:                     slugify(create.Name),
+		CreatedAt:                now,
+		Description:              &create.Description,
+		Labels:                   create.Labels,
+		Annotations:              create.Annotations,
+		State:                    state,
+		DeleteProtection:         create.DeleteProtection,
+		Organisation:             s.organisation(),
+		MachineType:              &iaas.MachineType{Identity: create.MachineType, Slug: create.MachineType},
+		MachineImage:             &iaas.MachineImage{Identity: create.MachineImage, Slug: create.MachineImage},
+		Vpc:                      subnet.Vpc,
+		Subnet:                   &subnetCopy,
+		Status:                   iaas.ResourceStatus{Status: "provisioning", LastTransitionTime: now},
+		AvailabilityZone:         create.AvailabilityZone,
+		SecurityGroupAttachments: create.SecurityGroupAttachments,
+	}
+	if create.CloudInit != "" {
+		machine.CloudInit = &create.CloudInit
+	}
+	s.machines.put(machine.Identity, machine)
+	s.schedule("machine/"+machine.Identity, func() { s.settleMachine(machine) })
+	writeJSON(w, http.StatusCreated, machine)
+}
+
+func (s *Server) settleMachine(machine *iaas.Machine) {
+	machine.Status = iaas.ResourceStatus{Status: string(machine.State), LastTransitionTime: time.Now().UTC()}
+}
+
+func (s *Server) updateMachine(w http.ResponseWriter, r *http.Request) {
+	machine, ok := s.machines.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	var update iaas.UpdateMachine
+	if !decode(w, r, &update) {
+		return
+	}
+	machine.Name = update.Name
+	machine.Description = &update.Description
+	machine.Labels = update.Labels
+	machine.Annotations = update.Annotations
+	if update.State != nil {
+		machine.State = *update.State
+	}
+	if update.DeleteProtection != nil {
+		machine.DeleteProtection = *update.DeleteProtection
+	}
+	if update.MachineType != nil {
+		machine.MachineType = &iaas.MachineType{Identity: *update.MachineType, Slug: *update.MachineType}
+	}
+	if update.SecurityGroupAttachments != nil {
+		machine.SecurityGroupAttachments = update.SecurityGroupAttachments
+	}
+	now := time.Now().UTC()
+	machine.UpdatedAt = &now
+	s.schedule("machine/"+machine.Identity, func() { s.settleMachine(machine) })
+	writeJSON(w, http.StatusOK, machine)
+}
+
+func (s *Server) setMachineState(state iaas.MachineState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		machine, ok := s.machines.lookup(w, r.PathValue("identity"))
+		if !ok {
+			return
+		}
+		machine.State = state
+		now := time.Now().UTC()
+		machine.UpdatedAt = &now
+		s.schedule("machine/"+machine.Identity, func() { s.settleMachine(machine) })
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (s *Server) deleteMachine(w http.ResponseWriter, r *http.Request) {
+	machine, ok := s.machines.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if machine.DeleteProtection {
+		writeError(w, http.StatusConflict, fmt.Sprintf("machine %s has delete protection enabled", machine.Identity))
+		return
+	}
+	machine.State = iaas.MachineStateDeleting
+	machine.Status = iaas.ResourceStatus{Status: string(iaas.MachineStateDeleting), LastTransitionTime: time.Now().UTC()}
+	s.schedule("machine/"+machine.Identity, func() {
+		s.machines.remove(machine.Identity)
+		for _, volume := range s.volumes.list("") {
+			s.detach(volume, machine.Identity)
+		}
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Volumes
+
+func (s *Server) listVolumes(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.volumes.list("")))
+}
+
+func (s *Server) getVolume(w http.ResponseWriter, r *http.Request) {
+	if volume, ok := s.volumes.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, volume)
+	}
+}
+
+func (s *Server) createVolume(w http.ResponseWriter, r *http.Request) {
+	var create iaas.CreateVolume
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	if create.Size <= 0 {
+		fieldError(w, "size", "must be greater than zero")
+		return
+	}
+	now := time.Now().UTC()
+	volume := &iaas.Volume{
+		Identity:         s.newIdentity("volume"),
+		Name:             create.Name,
+		Slug:             slugify(create.Name),
+		Description:      create.Description,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		ObjectVersion:    1,
+		Status:           "creating",
+		Labels:           create.Labels,
+		Annotations:      create.Annotations,
+		VolumeType:       &iaas.VolumeType{Identity: create.VolumeTypeIdentity},
+		Attachments:      []iaas.VolumeAttachment{},
+		Organisation:     s.organisation(),
+		Region:           &iaas.Region{Identity: create.CloudRegionIdentity, Slug: create.CloudRegionIdentity},
+		Size:             create.Size,
+		DeleteProtection: create.DeleteProtection,
+	}
+	s.volumes.put(volume.Identity, volume)
+	s.schedule("volume/"+volume.Identity, func() { volume.Status = "available" })
+	writeJSON(w, http.StatusCreated, volume)
+}
+
+func (s *Server) updateVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := s.volumes.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, volume.ObjectVersion) {
+		return
+	}
+	var update iaas.UpdateVolume
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.Size != 0 && update.Size < volume.Size {
+		fieldError(w, "size", "volumes cannot be shrunk")
+		return
+	}
+	volume.Name = update.Name
+	volume.Description = update.Description
+	volume.Labels = update.Labels
+	volume.Annotations = update.Annotations
+	if update.Size != 0 {
+		volume.Size = update.Size
+	}
+	volume.DeleteProtection = update.DeleteProtection
+	volume.ObjectVersion++
+	volume.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *Server) deleteVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := s.volumes.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if volume.DeleteProtection {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s has delete protection enabled", volume.Identity))
+		return
+	}
+	if len(volume.Attachments) > 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is attached", volume.Identity))
+		return
+	}
+	volume.Status = "deleting"
+	s.schedule("volume/"+volume.Identity, func() { s.volumes.remove(volume.Identity) })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) attachVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := s.volumes.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	var attach iaas.AttachVolumeRequest
+	if !decode(w, r, &attach) {
+		return
+	}
+	if _, ok := s.machines.get(attach.ResourceIdentity); !ok {
+		fieldError(w, "resourceIdentity", fmt.Sprintf("machine %q not found", attach.ResourceIdentity))
+		return
+	}
+	if len(volume.Attachments) > 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is already attached", volume.Identity))
+		return
+	}
+	attachment := iaas.VolumeAttachment{
+		Identity:               s.newIdentity("attachment"),
+		CreatedAt:              time.Now().UTC(),
+		Description:            attach.Description,
+		AttachedToIdentity:     attach.ResourceIdentity,
+		AttachedToResourceType: attach.ResourceType,
+		CanDetach:              true,
+	}
+	volume.Attachments = append(volume.Attachments, attachment)
+	volume.Status = "attaching"
+	volume.ObjectVersion++
+	s.schedule("volume/"+volume.Identity, func() { volume.Status = "attached" })
+	writeJSON(w, http.StatusOK, attachment)
+}
+
+func (s *Server) detachVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := s.volumes.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	var detach iaas.DetachVolumeRequest
+	if !decode(w, r, &detach) {
+		return
+	}
+	attached := false
+	for _, a := range volume.Attachments {
+		attached = attached || a.AttachedToIdentity == detach.ResourceIdentity
+	}
+	if !attached {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is not attached to %s", volume.Identity, detach.ResourceIdentity))
+		return
+	}
+	volume.Status = "detaching"
+	volume.ObjectVersion++
+	s.schedule("volume/"+volume.Identity, func() { s.detach(volume, detach.ResourceIdentity) })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// detach removes the attachments of volume to resource.
+func (s *Server) detach(volume *iaas.Volume, resource string) {
+	attachments := volume.Attachments[:0]
+	for _, a := range volume.Attachments {
+		if a.AttachedToIdentity != resource {
+			attachments = append(attachments, a)
+		}
+	}
+	volume.Attachments = attachments
+	if len(attachments) == 0 && volume.Status != "deleting" {
+		volume.Status = "available"
+	}
+}
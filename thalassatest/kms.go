@@ -0,0 +1,229 @@
+package thalassatest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/kms"
+)
+
+// ciphertextPrefix marks ciphertexts produced by the fake. Ciphertexts are not
+// encrypted; they only bind the plaintext to the key and key version.
+const ciphertextPrefix = "thalassatest"
+
+func (s *Server) registerKMS(mux *http.ServeMux) {
+	keys := kms.KmsEndpoint + "/{region}/keys"
+	mux.HandleFunc("GET "+keys, s.listKeys)
+	mux.HandleFunc("POST "+keys, s.createKey)
+	mux.HandleFunc("GET "+keys+"/{identity}", s.getKey)
+	mux.HandleFunc("DELETE "+keys+"/{identity}", s.deleteKey)
+	mux.HandleFunc("DELETE "+keys+"/{identity}/cancel-deletion", s.cancelKeyDeletion)
+	mux.HandleFunc("PATCH "+keys+"/{identity}/rotation", s.updateKeyRotation)
+	mux.HandleFunc("POST "+keys+"/{identity}/rotate", s.rotateKey)
+	mux.HandleFunc("POST "+keys+"/{identity}/disable", s.setKeyStatus(kms.KmsKeyStatusDisabled))
+	mux.HandleFunc("POST "+keys+"/{identity}/enable", s.setKeyStatus(kms.KmsKeyStatusActive))
+	mux.HandleFunc("POST "+keys+"/{identity}/encrypt", s.encrypt)
+	mux.HandleFunc("POST "+keys+"/{identity}/decrypt", s.decrypt)
+}
+
+func keyKey(region, identity string) string {
+	return region + "/" + identity
+}
+
+func (s *Server) lookupKey(w http.ResponseWriter, r *http.Request) (*kms.KmsKey, bool) {
+	return s.keys.lookup(w, keyKey(r.PathValue("region"), r.PathValue("identity")))
+}
+
+func (s *Server) listKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.keys.list(r.PathValue("region")+"/")))
+}
+
+func (s *Server) getKey(w http.ResponseWriter, r *http.Request) {
+	if key, ok := s.lookupKey(w, r); ok {
+		writeJSON(w, http.StatusOK, key)
+	}
+}
+
+func (s *Server) createKey(w http.ResponseWriter, r *http.Request) {
+	var create kms.CreateKmsKeyRequest
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	keyType := create.KeyType
+	if keyType == "" {
+		keyType = kms.KmsKeyTypeAES256GCM96
+	}
+	if !keyType.IsValid() {
+		fieldError(w, "keyType", fmt.Sprintf("unsupported key type %q", keyType))
+		return
+	}
+	now := time.Now().UTC()
+	key := &kms.KmsKey{
+		Identity:             s.newIdentity("key"),
+		Name:                 create.Name,
+		Slug:                 slugify(create.Name),
+		Description:          create.Description,
+		Labels:               create.Labels,
+		Annotations:          create.Annotations,
+		KeyType:              keyType,
+		Status:               kms.KmsKeyStatusActive,
+		ExportAllowed:        create.ExportAllowed,
+		Imported:             create.ImportKeyMaterial != "",
+		KeyRotationEnabled:   create.KeyRotationEnabled,
+		RotationPeriodInDays: create.RotationPeriodInDays,
+		LatestVersion:        1,
+		Versions:             []kms.KmsKeyVersion{{Version: 1, Status: "enabled", CreatedAt: now}},
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		ObjectVersion:        1,
+		Organisation:         s.organisation(),
+	}
+	s.keys.put(keyKey(r.PathValue("region"), key.Identity), key)
+	writeJSON(w, http.StatusCreated, key)
+}
+
+func touchKey(key *kms.KmsKey) {
+	key.ObjectVersion++
+	key.UpdatedAt = time.Now().UTC()
+}
+
+// deleteKey schedules the key for deletion; like the real service, the key remains
+// visible with status pending_deletion until the deletion is carried out.
+func (s *Server) deleteKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.lookupKey(w, r)
+	if !ok {
+		return
+	}
+	key.Status = kms.KmsKeyStatusPendingDeletion
+	touchKey(key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) cancelKeyDeletion(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.lookupKey(w, r)
+	if !ok {
+		return
+	}
+	if key.Status != kms.KmsKeyStatusPendingDeletion {
+		writeError(w, http.StatusConflict, fmt.Sprintf("key %s is not pending deletion", key.Identity))
+		return
+	}
+	key.Status = kms.KmsKeyStatusActive
+	touchKey(key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateKeyRotation(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.lookupKey(w, r)
+	if !ok {
+		return
+	}
+	var update kms.UpdateRotationRequest
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.KeyRotationEnabled != nil {
+		key.KeyRotationEnabled = *update.KeyRotationEnabled
+	}
+	if update.RotationPeriodInDays != nil {
+		key.RotationPeriodInDays = update.RotationPeriodInDays
+	}
+	touchKey(key)
+	writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) rotateKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.lookupKey(w, r)
+	if !ok {
+		return
+	}
+	if key.Status != kms.KmsKeyStatusActive {
+		writeError(w, http.StatusConflict, fmt.Sprintf("key %s is %s", key.Identity, key.Status))
+		return
+	}
+	key.LatestVersion++
+	key.Versions = append(key.Versions, kms.KmsKeyVersion{Version: key.LatestVersion, Status: "enabled", CreatedAt: time.Now().UTC()})
+	touchKey(key)
+	writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) setKeyStatus(status kms.KmsKeyStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key, ok := s.lookupKey(w, r)
+		if !ok {
+			return
+		}
+		if key.Status == kms.KmsKeyStatusPendingDeletion {
+			writeError(w, http.StatusConflict, fmt.Sprintf("key %s is pending deletion", key.Identity))
+			return
+		}
+		key.Status = status
+		touchKey(key)
+		writeJSON(w, http.StatusOK, key)
+	}
+}
+
+// activeKey returns the key if it can be used for cryptographic operations.
+func (s *Server) activeKey(w http.ResponseWriter, r *http.Request) (*kms.KmsKey, bool) {
+	key, ok := s.lookupKey(w, r)
+	if !ok {
+		return nil, false
+	}
+	if key.Status != kms.KmsKeyStatusActive {
+		writeError(w, http.StatusConflict, fmt.Sprintf("key %s is %s", key.Identity, key.Status))
+		return nil, false
+	}
+	return key, true
+}
+
+func (s *Server) encrypt(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.activeKey(w, r)
+	if !ok {
+		return
+	}
+	var req kms.EncryptRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, err := base64.StdEncoding.DecodeString(req.Plaintext); err != nil {
+		fieldError(w, "plaintext", "must be valid base64")
+		return
+	}
+	version := strconv.Itoa(key.LatestVersion)
+	payload := base64.StdEncoding.EncodeToString([]byte(key.Identity + ":" + req.Plaintext))
+	writeJSON(w, http.StatusOK, kms.EncryptResponse{
+		Ciphertext: fmt.Sprintf("%s:v%s:%s", ciphertextPrefix, version, payload),
+		KeyVersion: version,
+	})
+}
+
+func (s *Server) decrypt(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.activeKey(w, r)
+	if !ok {
+		return
+	}
+	var req kms.DecryptRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	parts := strings.SplitN(req.Ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != ciphertextPrefix || !strings.HasPrefix(parts[1], "v") {
+		fieldError(w, "ciphertext", "invalid ciphertext")
+		return
+	}
+	payload, err := base64.StdEncoding.DecodeString(parts[2])
+	identity, plaintext, found := strings.Cut(string(payload), ":")
+	if err != nil || !found || identity != key.Identity {
+		fieldError(w, "ciphertext", "ciphertext was not encrypted with this key")
+		return
+	}
+	writeJSON(w, http.StatusOK, kms.DecryptResponse{Plaintext: plaintext, KeyVersion: strings.TrimPrefix(parts[1], "v")})
+}
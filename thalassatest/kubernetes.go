@@ -0,0 +1,290 @@
+package thalassatest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/kubernetes"
+)
+
+func (s *Server) registerKubernetes(mux *http.ServeMux) {
+	clusters := kubernetes.KubernetesClusterEndpoint
+	mux.HandleFunc("GET "+clusters, s.listKubernetesClusters)
+	mux.HandleFunc("POST "+clusters, s.createKubernetesCluster)
+	mux.HandleFunc("GET "+clusters+"/{identity}", s.getKubernetesCluster)
+	mux.HandleFunc("PUT "+clusters+"/{identity}", s.updateKubernetesCluster)
+	mux.HandleFunc("DELETE "+clusters+"/{identity}", s.deleteKubernetesCluster)
+
+	nodePools := clusters + "/{cluster}/" + kubernetes.KubernetesNodePoolEndpoint
+	mux.HandleFunc("GET "+nodePools, s.listKubernetesNodePools)
+	mux.HandleFunc("POST "+nodePools, s.createKubernetesNodePool)
+	mux.HandleFunc("GET "+nodePools+"/{identity}", s.getKubernetesNodePool)
+	mux.HandleFunc("PUT "+nodePools+"/{identity}", s.updateKubernetesNodePool)
+	mux.HandleFunc("DELETE "+nodePools+"/{identity}", s.deleteKubernetesNodePool)
+}
+
+// Clusters
+
+func (s *Server) listKubernetesClusters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, filterList(r, s.clusters.list("")))
+}
+
+func (s *Server) getKubernetesCluster(w http.ResponseWriter, r *http.Request) {
+	if cluster, ok := s.clusters.lookup(w, r.PathValue("identity")); ok {
+		writeJSON(w, http.StatusOK, cluster)
+	}
+}
+
+func (s *Server) createKubernetesCluster(w http.ResponseWriter, r *http.Request) {
+	var create kubernetes.CreateKubernetesCluster
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	now := time.Now().UTC()
+	cluster := &kubernetes.KubernetesCluster{
+		Identity:                    s.newIdentity("k8s"),
+		Name:                        create.Name,
+		Slug:                        slugify(create.Name),
+		Description:                 create.Description,
+		Labels:                      create.Labels,
+		Annotations:                 create.Annotations,
+		CreatedAt:                   now,
+		ObjectVersion:               1,
+		Organisation:                s.organisation(),
+		Status:                      "provisioning",
+		LastStatusTransitionedAt:    now,
+		ClusterType:                 create.ClusterType,
+		ClusterVersion:              kubernetes.KubernetesVersion{Identity: create.KubernetesVersionIdentity},
+		Region:                      &iaas.Region{Identity: create.RegionIdentity, Slug: create.RegionIdentity},
+		PodSecurityStandardsProfile: create.PodSecurityStandardsProfile,
+		AuditLogProfile:             create.AuditLogProfile,
+		DefaultNetworkPolicy:        create.DefaultNetworkPolicy,
+		DeleteProtection:            create.DeleteProtection,
+		ApiServerACLs:               create.ApiServerACLs,
+		DisablePublicEndpoint:       create.DisablePublicEndpoint,
+		AutoUpgradePolicy:           create.AutoUpgradePolicy,
+	}
+	if create.Subnet != "" {
+		subnet, ok := s.subnets.get(create.Subnet)
+		if !ok {
+			fieldError(w, "subnet", fmt.Sprintf("subnet %q not found", create.Subnet))
+			return
+		}
+		subnetCopy := *subnet
+		cluster.Subnet = &subnetCopy
+		cluster.VPC = subnet.Vpc
+	}
+	cluster.APIServerURL = fmt.Sprintf("https://%s.k8s.thalassatest.invalid:6443", cluster.Identity)
+	s.clusters.put(cluster.Identity, cluster)
+	s.scheduleClusterStatus(cluster, "ready")
+	writeJSON(w, http.StatusCreated, cluster)
+}
+
+func (s *Server) scheduleClusterStatus(cluster *kubernetes.KubernetesCluster, status string) {
+	s.schedule("k8s/"+cluster.Identity, func() {
+		cluster.Status = status
+		cluster.LastStatusTransitionedAt = time.Now().UTC()
+	})
+}
+
+func (s *Server) updateKubernetesCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, cluster.ObjectVersion) {
+		return
+	}
+	var update kubernetes.UpdateKubernetesCluster
+	if !decode(w, r, &update) {
+		return
+	}
+	if update.Name != nil {
+		cluster.Name = *update.Name
+	}
+	if update.Description != nil {
+		cluster.Description = *update.Description
+	}
+	if update.Labels != nil {
+		cluster.Labels = update.Labels
+	}
+	if update.Annotations != nil {
+		cluster.Annotations = update.Annotations
+	}
+	if update.KubernetesVersionIdentity != nil {
+		cluster.ClusterVersion = kubernetes.KubernetesVersion{Identity: *update.KubernetesVersionIdentity}
+	}
+	if update.DeleteProtection != nil {
+		cluster.DeleteProtection = *update.DeleteProtection
+	}
+	if update.DisablePublicEndpoint != nil {
+		cluster.DisablePublicEndpoint = *update.DisablePublicEndpoint
+	}
+	cluster.ApiServerACLs = update.ApiServerACLs
+	cluster.AutoUpgradePolicy = update.AutoUpgradePolicy
+	cluster.ObjectVersion++
+	cluster.Status = "updating"
+	cluster.LastStatusTransitionedAt = time.Now().UTC()
+	s.scheduleClusterStatus(cluster, "ready")
+	writeJSON(w, http.StatusOK, cluster)
+}
+
+func (s *Server) deleteKubernetesCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.lookup(w, r.PathValue("identity"))
+	if !ok {
+		return
+	}
+	if cluster.DeleteProtection {
+		writeError(w, http.StatusConflict, fmt.Sprintf("kubernetes cluster %s has delete protection enabled", cluster.Identity))
+		return
+	}
+	cluster.Status = "deleting"
+	cluster.LastStatusTransitionedAt = time.Now().UTC()
+	s.schedule("k8s/"+cluster.Identity, func() {
+		s.clusters.remove(cluster.Identity)
+		for _, pool := range s.nodePools.list(cluster.Identity + "/") {
+			s.nodePools.remove(nodePoolKey(cluster.Identity, pool.Identity))
+			s.cancel("nodepool/" + pool.Identity)
+		}
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Node pools
+
+func nodePoolKey(cluster, identity string) string {
+	return cluster + "/" + identity
+}
+
+func (s *Server) listKubernetesNodePools(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.lookup(w, r.PathValue("cluster"))
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, filterList(r, s.nodePools.list(cluster.Identity+"/")))
+}
+
+func (s *Server) getKubernetesNodePool(w http.ResponseWriter, r *http.Request) {
+	if pool, ok := s.nodePools.lookup(w, nodePoolKey(r.PathValue("cluster"), r.PathValue("identity"))); ok {
+		writeJSON(w, http.StatusOK, pool)
+	}
+}
+
+func (s *Server) createKubernetesNodePool(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.clusters.lookup(w, r.PathValue("cluster"))
+	if !ok {
+		return
+	}
+	var create kubernetes.CreateKubernetesNodePool
+	if !decode(w, r, &create) {
+		return
+	}
+	if create.Name == "" {
+		fieldError(w, "name", "is required")
+		return
+	}
+	now := time.Now().UTC()
+	pool := &kubernetes.KubernetesNodePool{
+		Identity:              s.newIdentity("nodepool"),
+		Name:                  create.Name,
+		Slug:                  slugify(create.Name),
+		Description:           create.Description,
+		CreatedAt:             now,
+		UpdatedAt:             &now,
+		ObjectVersion:         1,
+		Labels:                create.Labels,
+		Annotations:           create.Annotations,
+		AvailabilityZone:      create.AvailabilityZone,
+		Status:                kubernetes.KubernetesNodePoolStatusProvisioning,
+		Vpc:                   cluster.VPC,
+		Subnet:                cluster.Subnet,
+		EnableAutoscaling:     create.EnableAutoscaling,
+		EnableAutoHealing:     create.EnableAutoHealing,
+		Replicas:              create.Replicas,
+		MinReplicas:           create.MinReplicas,
+		MaxReplicas:           create.MaxReplicas,
+		ManageNodeAllocatable: create.ManageNodeAllocatable,
+		MachineType:           iaas.MachineType{Identity: create.MachineType, Slug: create.MachineType},
+		NodeSettings:          create.NodeSettings,
+	}
+	if create.UpgradeStrategy != nil {
+		pool.UpgradeStrategy = *create.UpgradeStrategy
+	}
+	if create.KubernetesVersionIdentity != nil {
+		pool.KubernetesVersion = &kubernetes.KubernetesVersion{Identity: *create.KubernetesVersionIdentity}
+	}
+	s.nodePools.put(nodePoolKey(cluster.Identity, pool.Identity), pool)
+	s.schedule("nodepool/"+pool.Identity, func() { pool.Status = kubernetes.KubernetesNodePoolStatusReady })
+	writeJSON(w, http.StatusCreated, pool)
+}
+
+func (s *Server) updateKubernetesNodePool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.nodePools.lookup(w, nodePoolKey(r.PathValue("cluster"), r.PathValue("identity")))
+	if !ok {
+		return
+	}
+	if !checkVersion(w, r, pool.ObjectVersion) {
+		return
+	}
+	var update kubernetes.UpdateKubernetesNodePool
+	if !decode(w, r, &update) {
+		return
+	}
+	pool.Description = update.Description
+	pool.Labels = update.Labels
+	pool.Annotations = update.Annotations
+	if update.MachineType != "" {
+		pool.MachineType = iaas.MachineType{Identity: update.MachineType, Slug: update.MachineType}
+	}
+	if update.Replicas != nil {
+		pool.Replicas = *update.Replicas
+	}
+	if update.MinReplicas != nil {
+		pool.MinReplicas = *update.MinReplicas
+	}
+	if update.MaxReplicas != nil {
+		pool.MaxReplicas = *update.MaxReplicas
+	}
+	if update.KubernetesVersionIdentity != nil {
+		pool.KubernetesVersion = &kubernetes.KubernetesVersion{Identity: *update.KubernetesVersionIdentity}
+	}
+	if update.AvailabilityZone != "" {
+		pool.AvailabilityZone = update.AvailabilityZone
+	}
+	if update.UpgradeStrategy != nil {
+		pool.UpgradeStrategy = *update.UpgradeStrategy
+	}
+	if update.EnableAutoHealing != nil {
+		pool.EnableAutoHealing = *update.EnableAutoHealing
+	}
+	if update.EnableAutoscaling != nil {
+		pool.EnableAutoscaling = *update.EnableAutoscaling
+	}
+	pool.ManageNodeAllocatable = update.ManageNodeAllocatable
+	if update.NodeSettings != nil {
+		pool.NodeSettings = *update.NodeSettings
+	}
+	now := time.Now().UTC()
+	pool.UpdatedAt = &now
+	pool.ObjectVersion++
+	pool.Status = kubernetes.KubernetesNodePoolStatusUpdating
+	s.schedule("nodepool/"+pool.Identity, func() { pool.Status = kubernetes.KubernetesNodePoolStatusReady })
+	writeJSON(w, http.StatusOK, pool)
+}
+
+func (s *Server) deleteKubernetesNodePool(w http.ResponseWriter, r *http.Request) {
+	key := nodePoolKey(r.PathValue("cluster"), r.PathValue("identity"))
+	pool, ok := s.nodePools.lookup(w, key)
+	if !ok {
+		return
+	}
+	pool.Status = kubernetes.KubernetesNodePoolStatusDeleting
+	s.schedule("nodepool/"+pool.Identity, func() { s.nodePools.remove(key) })
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,274 @@
+package thalassatest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/kms"
+	"github.com/thalassa-cloud/client-go/secrets"
+)
+
+// secretEntry is a secret with its version values, which the API never lists.
+type secretEntry struct {
+	secrets.Secret
+	values map[int]secretValue
+}
+
+type secretValue struct {
+	secretString    string
+	secretKeyValues map[string]string
+}
+
+func (s *Server) registerSecrets(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+secrets.SecretsEndpoint+"/{region}/secrets", s.listSecrets)
+	mux.HandleFunc("POST "+secrets.SecretsEndpoint+"/{region}/secrets", s.createSecret)
+	mux.HandleFunc(secrets.SecretsEndpoint+"/{region}/secret/{path...}", s.secretResource)
+}
+
+func secretKey(region, path string) string {
+	return region + "/" + path
+}
+
+// secretResource dispatches requests for /v1/secrets/{region}/secret{path}[/suffix].
+func (s *Server) secretResource(w http.ResponseWriter, r *http.Request) {
+	region := r.PathValue("region")
+	path := "/" + r.PathValue("path")
+	suffix := ""
+	for _, candidate := range []string{"/versions", "/value", "/policy"} {
+		if strings.HasSuffix(path, candidate) {
+			path, suffix = strings.TrimSuffix(path, candidate), candidate
+			break
+		}
+	}
+	entry, ok := s.secrets.lookup(w, secretKey(region, path))
+	if !ok {
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && suffix == "":
+		secret := entry.Secret
+		if r.URL.Query().Get("includeVersions") != "true" {
+			secret.Versions = nil
+		}
+		writeJSON(w, http.StatusOK, secret)
+	case r.Method == http.MethodDelete && suffix == "":
+		s.secrets.remove(secretKey(region, path))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && suffix == "/versions":
+		s.putSecretValue(w, r, entry)
+	case r.Method == http.MethodDelete && suffix == "/versions":
+		s.destroySecretVersion(w, r, entry)
+	case r.Method == http.MethodPost && suffix == "/value":
+		s.getSecretValue(w, r, entry)
+	case r.Method == http.MethodPut && suffix == "/policy":
+		var update secrets.UpdateAccessPolicyRequest
+		if !decode(w, r, &update) {
+			return
+		}
+		entry.AccessPolicy = &update.AccessPolicy
+		touchSecret(entry)
+		writeJSON(w, http.StatusOK, entry.Secret)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s not allowed", r.Method))
+	}
+}
+
+func touchSecret(entry *secretEntry) {
+	entry.ObjectVersion++
+	entry.UpdatedAt = time.Now().UTC()
+}
+
+func (s *Server) listSecrets(w http.ResponseWriter, r *http.Request) {
+	region := r.PathValue("region")
+	query := r.URL.Query()
+	all := s.secrets.list(region + "/")
+	if browse := query.Get("path"); browse != "" {
+		s.browseSecrets(w, browse, all)
+		return
+	}
+	prefix := query.Get("pathPrefix")
+	out := []secrets.Secret{}
+	for _, entry := range all {
+		if strings.HasPrefix(entry.Path, prefix) {
+			secret := entry.Secret
+			secret.Versions = nil
+			out = append(out, secret)
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) browseSecrets(w http.ResponseWriter, path string, all []*secretEntry) {
+	dir := strings.TrimSuffix(path, "/") + "/"
+	result := secrets.BrowseSecretsResponse{Path: path}
+	prefixes := map[string]bool{}
+	for _, entry := range all {
+		rest, ok := strings.CutPrefix(entry.Path, dir)
+		if !ok {
+			continue
+		}
+		if child, _, nested := strings.Cut(rest, "/"); nested {
+			prefixes[dir+child+"/"] = true
+			continue
+		}
+		secret := entry.Secret
+		secret.Versions = nil
+		result.Secrets = append(result.Secrets, secret)
+	}
+	for prefix := range prefixes {
+		result.Prefixes = append(result.Prefixes, prefix)
+	}
+	sort.Strings(result.Prefixes)
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) createSecret(w http.ResponseWriter, r *http.Request) {
+	region := r.PathValue("region")
+	var create secrets.CreateSecretRequest
+	if !decode(w, r, &create) {
+		return
+	}
+	path, err := secrets.NormalizePath(create.Path)
+	if err != nil {
+		fieldError(w, "path", err.Error())
+		return
+	}
+	if _, exists := s.secrets.get(secretKey(region, path)); exists {
+		writeError(w, http.StatusConflict, fmt.Sprintf("secret %s already exists", path))
+		return
+	}
+	var kmsKey *kms.KmsKey
+	if create.KmsKeyIdentity != "" {
+		key, ok := s.keys.get(keyKey(region, create.KmsKeyIdentity))
+		if !ok {
+			fieldError(w, "kmsKeyIdentity", fmt.Sprintf("key %q not found", create.KmsKeyIdentity))
+			return
+		}
+		keyCopy := *key
+		kmsKey = &keyCopy
+	}
+	now := time.Now().UTC()
+	entry := &secretEntry{
+		Secret: secrets.Secret{
+			Path:          path,
+			Description:   create.Description,
+			Labels:        create.Labels,
+			Annotations:   create.Annotations,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			KmsKey:        kmsKey,
+			ObjectVersion: 1,
+			Region:        &iaas.Region{Identity: region, Slug: region},
+			AccessPolicy:  create.AccessPolicy,
+		},
+		values: map[int]secretValue{},
+	}
+	if create.SecretString != "" || create.SecretKeyValues != nil || create.GenerateSecret != nil {
+		value, ok := newSecretValue(w, create.SecretString, create.SecretKeyValues, create.GenerateSecret)
+		if !ok {
+			return
+		}
+		addSecretVersion(entry, value)
+	}
+	s.secrets.put(secretKey(region, path), entry)
+	writeJSON(w, http.StatusCreated, entry.Secret)
+}
+
+func newSecretValue(w http.ResponseWriter, secretString string, keyValues map[string]string, generate *secrets.GenerateSecret) (secretValue, bool) {
+	if generate != nil {
+		if generate.ByteLength <= 0 {
+			fieldError(w, "generateSecret.byteLength", "must be greater than zero")
+			return secretValue{}, false
+		}
+		b := make([]byte, generate.ByteLength)
+		_, _ = rand.Read(b)
+		return secretValue{secretString: base64.StdEncoding.EncodeToString(b)}, true
+	}
+	if secretString != "" {
+		if _, err := base64.StdEncoding.DecodeString(secretString); err != nil {
+			fieldError(w, "secretString", "must be valid base64")
+			return secretValue{}, false
+		}
+	}
+	return secretValue{secretString: secretString, secretKeyValues: keyValues}, true
+}
+
+func addSecretVersion(entry *secretEntry, value secretValue) int {
+	version := entry.CurrentVersion + 1
+	entry.values[version] = value
+	entry.CurrentVersion = version
+	entry.Versions = append(entry.Versions, secrets.SecretVersion{Version: version, Status: "enabled", CreatedAt: time.Now().UTC()})
+	return version
+}
+
+func (s *Server) putSecretValue(w http.ResponseWriter, r *http.Request, entry *secretEntry) {
+	var put secrets.PutSecretValueRequest
+	if !decode(w, r, &put) {
+		return
+	}
+	value, ok := newSecretValue(w, put.SecretString, put.SecretKeyValues, put.GenerateSecret)
+	if !ok {
+		return
+	}
+	version := addSecretVersion(entry, value)
+	touchSecret(entry)
+	writeJSON(w, http.StatusOK, secrets.PutSecretValueResponse{Path: entry.Path, Version: version})
+}
+
+func (s *Server) getSecretValue(w http.ResponseWriter, r *http.Request, entry *secretEntry) {
+	var get secrets.GetSecretValueRequest
+	if !decode(w, r, &get) {
+		return
+	}
+	version := entry.CurrentVersion
+	if get.Version != nil {
+		version = *get.Version
+	}
+	value, ok := entry.values[version]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("version %d of secret %s not found", version, entry.Path))
+		return
+	}
+	now := time.Now().UTC()
+	entry.LastAccessedAt = &now
+	resp := secrets.GetSecretValueResponse{
+		Path:            entry.Path,
+		Version:         version,
+		SecretString:    value.secretString,
+		SecretKeyValues: value.secretKeyValues,
+	}
+	if entry.KmsKey != nil {
+		resp.KmsKeyIdentity = entry.KmsKey.Identity
+		resp.KmsKeyVersion = strconv.Itoa(entry.KmsKey.LatestVersion)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) destroySecretVersion(w http.ResponseWriter, r *http.Request, entry *secretEntry) {
+	version, err := strconv.Atoi(r.URL.Query().Get("version"))
+	if err != nil {
+		fieldError(w, "version", "must be a number")
+		return
+	}
+	if _, ok := entry.values[version]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("version %d of secret %s not found", version, entry.Path))
+		return
+	}
+	delete(entry.values, version)
+	now := time.Now().UTC()
+	for i := range entry.Versions {
+		if entry.Versions[i].Version == version {
+			entry.Versions[i].Status = "destroyed"
+			entry.Versions[i].DestroyedAt = &now
+		}
+	}
+	touchSecret(entry)
+	w.WriteHeader(http.StatusNoContent)
+}
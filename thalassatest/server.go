@@ -0,0 +1,488 @@
+// Package thalassatest provides a stateful, in-memory fake of the Thalassa Cloud API
+// for tests.
+//
+// The fake implements the create, read, update and delete semantics of VPCs, subnets,
+// machines, volumes, Kubernetes clusters and node pools, database clusters, DNS zones
+// and records, secrets and KMS keys. Resources move through their transitional
+// statuses (e.g. "provisioning" to "ready", "deleting" to gone) so that the WaitUntil*
+// helpers work, object versions are bumped on every update and checked against
+// If-Match headers, unknown resources return 404 Not Found, and faults such as
+// latency and error responses can be injected.
+//
+//	server := thalassatest.NewServer()
+//	defer server.Close()
+//
+//	c, err := thalassa.NewClient(server.ClientOptions()...)
+//	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "test"})
+//	err = c.IaaS().WaitUntilVpcIsReady(ctx, vpc.Identity)
+package thalassatest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/dns"
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/kms"
+	"github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/pkg/base"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+// DefaultOrganisation is the organisation identity used by ClientOptions.
+const DefaultOrganisation = "org-thalassatest"
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithTransitionDelay sets how long resources stay in transitional statuses such as
+// "provisioning", "updating" and "deleting". With the default of zero, a resource
+// reports its transitional status in the response to the mutating request and has
+// settled by the next request.
+func WithTransitionDelay(d time.Duration) Option {
+	return func(s *Server) {
+		s.transitionDelay = d
+	}
+}
+
+// WithLatency delays every response by d.
+func WithLatency(d time.Duration) Option {
+	return func(s *Server) {
+		s.latency = d
+	}
+}
+
+// WithToken makes the server reject requests that do not carry token as bearer token.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// Fault makes the server misbehave for matching requests.
+type Fault struct {
+	// Method and Path select the requests to affect. An empty Method matches any method;
+	// Path matches the request path by prefix, and an empty Path matches every request.
+	Method string
+	Path   string
+	// Latency delays the response.
+	Latency time.Duration
+	// StatusCode, if set, is returned instead of handling the request.
+	StatusCode int
+	// Message is the error message of the response. Defaults to the status text.
+	Message string
+	// Header is added to the response, e.g. Retry-After.
+	Header http.Header
+	// Times limits the number of requests affected. Zero affects all matching requests.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && !strings.EqualFold(f.Method, r.Method) {
+		return false
+	}
+	return strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// RecordedRequest is a request received by the server.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// Server is an in-memory fake of the Thalassa Cloud API. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	transitionDelay time.Duration
+	latency         time.Duration
+	token           string
+
+	mu       sync.Mutex
+	seq      int
+	faults   []*Fault
+	requests []RecordedRequest
+	pending  map[string]transition
+
+	vpcs       *store[iaas.Vpc]
+	subnets    *store[iaas.Subnet]
+	machines   *store[iaas.Machine]
+	volumes    *store[iaas.Volume]
+	clusters   *store[kubernetes.KubernetesCluster]
+	nodePools  *store[kubernetes.KubernetesNodePool]
+	dbClusters *store[dbaas.DbCluster]
+	zones      *store[dns.DnsZone]
+	records    *store[dns.DnsRecord]
+	secrets    *store[secretEntry]
+	keys       *store[kms.KmsKey]
+}
+
+// transition is a pending status change of a resource.
+type transition struct {
+	at    time.Time
+	apply func()
+}
+
+// NewServer starts a fake API server. Callers should call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		pending:    map[string]transition{},
+		vpcs:       newStore[iaas.Vpc]("vpc"),
+		subnets:    newStore[iaas.Subnet]("subnet"),
+		machines:   newStore[iaas.Machine]("machine"),
+		volumes:    newStore[iaas.Volume]("volume"),
+		clusters:   newStore[kubernetes.KubernetesCluster]("kubernetes cluster"),
+		nodePools:  newStore[kubernetes.KubernetesNodePool]("node pool"),
+		dbClusters: newStore[dbaas.DbCluster]("database cluster"),
+		zones:      newStore[dns.DnsZone]("zone"),
+		records:    newStore[dns.DnsRecord]("record"),
+		secrets:    newStore[secretEntry]("secret"),
+		keys:       newStore[kms.KmsKey]("key"),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	s.registerIaaS(mux)
+	s.registerKubernetes(mux)
+	s.registerDBaaS(mux)
+	s.registerDNS(mux)
+	s.registerSecrets(mux)
+	s.registerKMS(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	})
+	s.Server = httptest.NewServer(s.handler(mux))
+	return s
+}
+
+// ClientOptions returns the options that point a client at the server, followed by opts.
+// The result can be passed to client.NewClient as well as thalassa.NewClient.
+func (s *Server) ClientOptions(opts ...client.Option) []client.Option {
+	auth := client.WithAuthNone()
+	if s.token != "" {
+		auth = client.WithToken(s.token)
+	}
+	return append([]client.Option{
+		client.WithBaseURL(s.URL),
+		auth,
+		client.WithOrganisation(DefaultOrganisation),
+	}, opts...)
+}
+
+// InjectFault adds a fault and returns a function that removes it again.
+func (s *Server) InjectFault(f Fault) (remove func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fault := &f
+	s.faults = append(s.faults, fault)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.removeFault(fault)
+	}
+}
+
+func (s *Server) removeFault(fault *Fault) {
+	for i, f := range s.faults {
+		if f == fault {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			return
+		}
+	}
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// Settle applies all pending status transitions immediately, regardless of the
+// transition delay.
+func (s *Server) Settle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, t := range s.pending {
+		delete(s.pending, key)
+		t.apply()
+	}
+}
+
+func (s *Server) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, RecordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		var fault *Fault
+		for _, f := range s.faults {
+			if f.matches(r) {
+				fault = f
+				if f.Times > 0 {
+					if f.Times--; f.Times == 0 {
+						s.removeFault(f)
+					}
+				}
+				break
+			}
+		}
+		s.mu.Unlock()
+
+		delay := s.latency
+		if fault != nil {
+			delay += fault.Latency
+		}
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault != nil && fault.StatusCode != 0 {
+			for k, v := range fault.Header {
+				w.Header()[k] = v
+			}
+			msg := fault.Message
+			if msg == "" {
+				msg = http.StatusText(fault.StatusCode)
+			}
+			writeError(w, fault.StatusCode, msg)
+			return
+		}
+		if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+			writeError(w, http.StatusUnauthorized, "invalid or missing token")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.settle()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// settle applies the transitions that are due. The caller must hold s.mu.
+func (s *Server) settle() {
+	now := time.Now()
+	for key, t := range s.pending {
+		if !now.Before(t.at) {
+			delete(s.pending, key)
+			t.apply()
+		}
+	}
+}
+
+// schedule replaces the pending transition of the resource identified by key.
+// The caller must hold s.mu.
+func (s *Server) schedule(key string, apply func()) {
+	s.pending[key] = transition{at: time.Now().Add(s.transitionDelay), apply: apply}
+}
+
+// cancel drops the pending transition of a resource. The caller must hold s.mu.
+func (s *Server) cancel(key string) {
+	delete(s.pending, key)
+}
+
+// newIdentity returns a new unique identity. The caller must hold s.mu.
+func (s *Server) newIdentity(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s-%06d", prefix, s.seq)
+}
+
+func (s *Server) organisation() *base.Organisation {
+	return &base.Organisation{Identity: DefaultOrganisation, Name: DefaultOrganisation, Slug: DefaultOrganisation}
+}
+
+// store holds the resources of one kind in creation order.
+type store[T any] struct {
+	kind  string
+	items map[string]*T
+	order []string
+}
+
+func newStore[T any](kind string) *store[T] {
+	return &store[T]{kind: kind, items: map[string]*T{}}
+}
+
+func (s *store[T]) get(key string) (*T, bool) {
+	v, ok := s.items[key]
+	return v, ok
+}
+
+func (s *store[T]) put(key string, v *T) {
+	if _, ok := s.items[key]; !ok {
+		s.order = append(s.order, key)
+	}
+	s.items[key] = v
+}
+
+func (s *store[T]) remove(key string) {
+	if _, ok := s.items[key]; !ok {
+		return
+	}
+	delete(s.items, key)
+	for i, k := range s.order {
+		if k == key {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns the resources whose key starts with prefix.
+func (s *store[T]) list(prefix string) []*T {
+	out := []*T{}
+	for _, k := range s.order {
+		if strings.HasPrefix(k, prefix) {
+			out = append(out, s.items[k])
+		}
+	}
+	return out
+}
+
+// lookup returns the resource for key, or writes a 404 response.
+func (s *store[T]) lookup(w http.ResponseWriter, key string) (*T, bool) {
+	v, ok := s.items[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", s.kind))
+	}
+	return v, ok
+}
+
+// filterList applies the label and key/value filters of the filters package to items.
+// Filters are matched against the JSON representation of the resources.
+func filterList[T any](r *http.Request, items []*T) []T {
+	out := []T{}
+	query := r.URL.Query()
+	for _, item := range items {
+		if matchesQuery(query, item) {
+			out = append(out, *item)
+		}
+	}
+	return out
+}
+
+var matchLabelsParam = regexp.MustCompile(`^matchLabels\[(.+)\]$`)
+
+func matchesQuery(query map[string][]string, item any) bool {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return false
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return false
+	}
+	for param, values := range query {
+		want := values[0]
+		if m := matchLabelsParam.FindStringSubmatch(param); m != nil {
+			labels, _ := fields["labels"].(map[string]any)
+			if labels[m[1]] != want {
+				return false
+			}
+			continue
+		}
+		switch param {
+		case "name", "identity", "slug":
+			if fields[param] != want {
+				return false
+			}
+		case "status":
+			status := fields["status"]
+			if nested, ok := status.(map[string]any); ok {
+				status = nested["status"]
+			}
+			if s, _ := status.(string); !strings.EqualFold(s, want) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+var slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slugify(name string) string {
+	return strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	b, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// decode reads the JSON request body into v, or writes a 400 response.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+// checkVersion writes a 412 response and returns false if the request carries an
+// If-Match header for a version other than current.
+func checkVersion(w http.ResponseWriter, r *http.Request, current int) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	if expected := strings.Trim(ifMatch, `"`); expected != strconv.Itoa(current) {
+		writeError(w, http.StatusPreconditionFailed, fmt.Sprintf("object version is %d, not %s", current, expected))
+		return false
+	}
+	return true
+}
+
+// fieldError writes a 400 response with a single field error.
+func fieldError(w http.ResponseWriter, field, message string) {
+	writeJSON(w, http.StatusBadRequest, map[string]any{
+		"message": "validation failed",
+		"errors":  []map[string]string{{"field": field, "message": message}},
+	})
+}
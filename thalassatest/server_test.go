@@ -0,0 +1,294 @@
+package thalassatest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/dns"
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/kms"
+	"github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/secrets"
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+func newTestClient(t *testing.T, opts ...Option) (*Server, thalassa.Client) {
+	server := NewServer(opts...)
+	t.Cleanup(server.Close)
+	c, err := thalassa.NewClient(server.ClientOptions()...)
+	require.NoError(t, err)
+	return server, c
+}
+
+func TestNetworkLifecycle(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "My VPC", VpcCidrs: []string{"10.0.0.0/16"}})
+	require.NoError(t, err)
+	assert.Equal(t, "provisioning", vpc.Status)
+	assert.Equal(t, "my-vpc", vpc.Slug)
+	require.NoError(t, c.IaaS().WaitUntilVpcIsReady(ctx, vpc.Identity))
+
+	updated, err := c.IaaS().UpdateVpc(ctx, vpc.Identity, iaas.UpdateVpc{Name: "renamed"})
+	require.NoError(t, err)
+	assert.Equal(t, vpc.ObjectVersion+1, updated.ObjectVersion)
+
+	subnet, err := c.IaaS().CreateSubnet(ctx, iaas.CreateSubnet{Name: "subnet", VpcIdentity: vpc.Identity, Cidr: "10.0.1.0/24"})
+	require.NoError(t, err)
+	ready, err := c.IaaS().WaitUntilSubnetReady(ctx, subnet.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, iaas.SubnetStatusReady, ready.Status)
+
+	_, err = c.IaaS().CreateSubnet(ctx, iaas.CreateSubnet{Name: "orphan", VpcIdentity: "vpc-missing", Cidr: "10.0.2.0/24"})
+	assert.True(t, client.IsBadRequest(err))
+
+	err = c.IaaS().DeleteVpc(ctx, vpc.Identity)
+	assert.True(t, client.IsConflict(err), "vpc with subnets cannot be deleted")
+
+	require.NoError(t, c.IaaS().DeleteSubnet(ctx, subnet.Identity))
+	require.NoError(t, c.IaaS().WaitUntilSubnetDeleted(ctx, subnet.Identity))
+	require.NoError(t, c.IaaS().DeleteVpc(ctx, vpc.Identity))
+	require.NoError(t, c.IaaS().WaitUntilVpcIsDeleted(ctx, vpc.Identity))
+
+	_, err = c.IaaS().GetVpc(ctx, vpc.Identity)
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestMachinesAndVolumes(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "vpc"})
+	require.NoError(t, err)
+	subnet, err := c.IaaS().CreateSubnet(ctx, iaas.CreateSubnet{Name: "subnet", VpcIdentity: vpc.Identity, Cidr: "10.0.1.0/24"})
+	require.NoError(t, err)
+	machine, err := c.IaaS().CreateMachine(ctx, iaas.CreateMachine{Name: "vm", Subnet: subnet.Identity, Labels: iaas.Labels{"app": "web"}})
+	require.NoError(t, err)
+	assert.Equal(t, iaas.MachineStateRunning, machine.State)
+
+	machines, err := c.IaaS().ListMachines(ctx, &iaas.ListMachinesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, machines, 1)
+
+	volume, err := c.IaaS().CreateVolume(ctx, iaas.CreateVolume{Name: "data", Size: 10})
+	require.NoError(t, err)
+	require.NoError(t, c.IaaS().WaitUntilVolumeIsAvailable(ctx, volume.Identity))
+	require.NoError(t, c.IaaS().AttachVolumeAndWaitUntilAttached(ctx, volume.Identity, iaas.AttachVolumeRequest{
+		ResourceType: "cloud_virtual_machine", ResourceIdentity: machine.Identity,
+	}))
+	assert.True(t, client.IsConflict(c.IaaS().DeleteVolume(ctx, volume.Identity)))
+	require.NoError(t, c.IaaS().DetachVolumeAndWaitUntilAvailable(ctx, volume.Identity, iaas.DetachVolumeRequest{
+		ResourceType: "cloud_virtual_machine", ResourceIdentity: machine.Identity,
+	}))
+
+	require.NoError(t, c.IaaS().MachineStop(ctx, machine.Identity))
+	stopped, err := c.IaaS().GetMachine(ctx, machine.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, iaas.MachineStateStopped, stopped.State)
+
+	require.NoError(t, c.IaaS().DeleteMachine(ctx, machine.Identity))
+	require.NoError(t, c.IaaS().WaitUntilMachineDeleted(ctx, machine.Identity))
+	require.NoError(t, c.IaaS().DeleteVolume(ctx, volume.Identity))
+	require.NoError(t, c.IaaS().WaitUntilVolumeIsDeleted(ctx, volume.Identity))
+}
+
+func TestKubernetesAndDatabaseClusters(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "vpc"})
+	require.NoError(t, err)
+	subnet, err := c.IaaS().CreateSubnet(ctx, iaas.CreateSubnet{Name: "subnet", VpcIdentity: vpc.Identity, Cidr: "10.0.1.0/24"})
+	require.NoError(t, err)
+
+	cluster, err := c.Kubernetes().CreateKubernetesCluster(ctx, kubernetes.CreateKubernetesCluster{Name: "k8s", Subnet: subnet.Identity})
+	require.NoError(t, err)
+	cluster, err = c.Kubernetes().WaitUntilKubernetesClusterReady(ctx, cluster.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, vpc.Identity, cluster.VPC.Identity)
+
+	pool, err := c.Kubernetes().CreateKubernetesNodePool(ctx, cluster.Identity, kubernetes.CreateKubernetesNodePool{Name: "pool", Replicas: 3})
+	require.NoError(t, err)
+	_, err = c.Kubernetes().WaitUntilKubernetesNodePoolReady(ctx, cluster.Identity, pool.Identity)
+	require.NoError(t, err)
+
+	replicas := 5
+	pool, err = c.Kubernetes().UpdateKubernetesNodePool(ctx, cluster.Identity, pool.Identity, kubernetes.UpdateKubernetesNodePool{Replicas: &replicas})
+	require.NoError(t, err)
+	assert.Equal(t, 5, pool.Replicas)
+	assert.Equal(t, kubernetes.KubernetesNodePoolStatusUpdating, pool.Status)
+
+	require.NoError(t, c.Kubernetes().DeleteKubernetesCluster(ctx, cluster.Identity))
+	_, err = c.Kubernetes().GetKubernetesNodePool(ctx, cluster.Identity, pool.Identity)
+	assert.True(t, client.IsNotFound(err), "node pools are deleted with their cluster")
+
+	db, err := c.DBaaS().CreateDbCluster(ctx, dbaas.CreateDbClusterRequest{Name: "db", SubnetIdentity: subnet.Identity, DeleteProtection: true})
+	require.NoError(t, err)
+	assert.Equal(t, dbaas.DbClusterStatusCreating, db.Status)
+	db, err = c.DBaaS().GetDbCluster(ctx, db.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, dbaas.DbClusterStatusReady, db.Status)
+	assert.True(t, client.IsConflict(c.DBaaS().DeleteDbCluster(ctx, db.Identity)))
+}
+
+func TestDNS(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	zone, err := c.DNS().CreateZone(ctx, dns.CreateDnsZoneRequest{ZoneName: "example.com."})
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", zone.Name)
+	_, err = c.DNS().CreateZone(ctx, dns.CreateDnsZoneRequest{ZoneName: "example.com"})
+	assert.True(t, client.IsConflict(err))
+
+	record, err := c.DNS().CreateRecord(ctx, zone.Identity, dns.CreateDnsRecordRequest{Name: "www", Type: "A", Values: []string{"192.0.2.1"}})
+	require.NoError(t, err)
+	assert.Equal(t, defaultRecordTTL, record.TTL)
+
+	records, err := c.DNS().ListRecords(ctx, zone.Identity, nil)
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	zone2, err := c.DNS().GetZone(ctx, zone.Identity)
+	require.NoError(t, err)
+	assert.Greater(t, zone2.ObjectVersion, zone.ObjectVersion)
+
+	require.NoError(t, c.DNS().DeleteZone(ctx, zone.Identity))
+	_, err = c.DNS().GetRecord(ctx, zone.Identity, record.Identity)
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestSecretsAndKMS(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	const region = "nl-01"
+
+	key, err := c.KMS().CreateKey(ctx, region, kms.CreateKmsKeyRequest{Name: "key"})
+	require.NoError(t, err)
+	enc, err := c.KMS().EncryptBytes(ctx, region, key.Identity, []byte("hello"))
+	require.NoError(t, err)
+	plaintext, err := c.KMS().DecryptBytes(ctx, region, key.Identity, enc.Ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(plaintext))
+
+	_, err = c.KMS().DisableKey(ctx, region, key.Identity)
+	require.NoError(t, err)
+	_, err = c.KMS().EncryptBytes(ctx, region, key.Identity, []byte("hello"))
+	assert.True(t, client.IsConflict(err))
+	_, err = c.KMS().EnableKey(ctx, region, key.Identity)
+	require.NoError(t, err)
+
+	_, err = c.Secrets().CreateSecret(ctx, region, secrets.CreateSecretRequest{Path: "/app/db", KmsKeyIdentity: key.Identity, SecretString: secrets.EncodeBytes([]byte("v1"))})
+	require.NoError(t, err)
+	_, err = c.Secrets().PutSecretString(ctx, region, "/app/db", []byte("v2"))
+	require.NoError(t, err)
+
+	value, version, err := c.Secrets().GetSecretString(ctx, region, "/app/db", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(value))
+	assert.Equal(t, 2, version)
+
+	first := 1
+	value, _, err = c.Secrets().GetSecretString(ctx, region, "/app/db", &first)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(value))
+
+	browse, err := c.Secrets().BrowseSecrets(ctx, region, "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/app/"}, browse.Prefixes)
+
+	require.NoError(t, c.KMS().DeleteKey(ctx, region, key.Identity))
+	key, err = c.KMS().GetKey(ctx, region, key.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, kms.KmsKeyStatusPendingDeletion, key.Status)
+
+	require.NoError(t, c.Secrets().DeleteSecret(ctx, region, "/app/db"))
+	_, err = c.Secrets().GetSecret(ctx, region, "/app/db", false)
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestFaultInjection(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+
+	remove := server.InjectFault(Fault{Method: http.MethodGet, Path: iaas.VpcEndpoint, StatusCode: http.StatusServiceUnavailable, Times: 1})
+	_, err := c.IaaS().ListVpcs(ctx, nil)
+	assert.True(t, client.IsServerError(err))
+	_, err = c.IaaS().ListVpcs(ctx, nil)
+	assert.NoError(t, err, "fault only applies once")
+	remove()
+
+	server.InjectFault(Fault{Path: iaas.VpcEndpoint, Latency: time.Second})
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = c.IaaS().ListVpcs(timeout, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTransitionDelayAndToken(t *testing.T) {
+	server, c := newTestClient(t, WithTransitionDelay(time.Hour), WithToken("secret"))
+	ctx := context.Background()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "vpc"})
+	require.NoError(t, err)
+	vpc, err = c.IaaS().GetVpc(ctx, vpc.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, "provisioning", vpc.Status)
+
+	server.Settle()
+	vpc, err = c.IaaS().GetVpc(ctx, vpc.Identity)
+	require.NoError(t, err)
+	assert.Equal(t, "ready", vpc.Status)
+
+	anonymous, err := thalassa.NewClient(client.WithBaseURL(server.URL), client.WithAuthNone())
+	require.NoError(t, err)
+	_, err = anonymous.IaaS().GetVpc(ctx, vpc.Identity)
+	assert.True(t, client.IsUnauthorized(err))
+
+	for _, req := range server.Requests() {
+		if req.Header.Get("Authorization") != "" {
+			assert.Equal(t, DefaultOrganisation, req.Header.Get("X-Organisation-Identity"))
+		}
+	}
+}
+
+func TestConditionalUpdates(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	vpc, err := c.IaaS().CreateVpc(ctx, iaas.CreateVpc{Name: "vpc"})
+	require.NoError(t, err)
+	subnet, err := c.IaaS().CreateSubnet(ctx, iaas.CreateSubnet{Name: "subnet", VpcIdentity: vpc.Identity, Cidr: "10.0.1.0/24"})
+	require.NoError(t, err)
+	cluster, err := c.Kubernetes().CreateKubernetesCluster(ctx, kubernetes.CreateKubernetesCluster{Name: "k8s", Subnet: subnet.Identity})
+	require.NoError(t, err)
+
+	// Another writer changes the cluster after it was read.
+	stale := cluster.ObjectVersion
+	_, err = c.Kubernetes().UpdateKubernetesCluster(ctx, cluster.Identity, kubernetes.UpdateKubernetesCluster{Labels: map[string]string{"owner": "other"}})
+	require.NoError(t, err)
+	_, err = c.Kubernetes().UpdateKubernetesCluster(client.WithExpectedVersion(ctx, stale), cluster.Identity, kubernetes.UpdateKubernetesCluster{Labels: map[string]string{"team": "platform"}})
+	assert.True(t, client.IsVersionConflict(err))
+
+	updated, err := client.UpdateWithRetry(ctx,
+		func(ctx context.Context) (*kubernetes.KubernetesCluster, error) {
+			return c.Kubernetes().GetKubernetesCluster(ctx, cluster.Identity)
+		},
+		func(cluster *kubernetes.KubernetesCluster) error {
+			cluster.Labels["team"] = "platform"
+			return nil
+		},
+		func(ctx context.Context, cluster *kubernetes.KubernetesCluster) (*kubernetes.KubernetesCluster, error) {
+			return c.Kubernetes().UpdateKubernetesCluster(ctx, cluster.Identity, kubernetes.UpdateKubernetesCluster{Labels: cluster.Labels})
+		})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "other", "team": "platform"}, map[string]string(updated.Labels))
+}